@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
 
 	"github.com/aerospike/aerostation/pkg/auth"
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/authz"
+	"github.com/aerospike/aerostation/pkg/tlsconfig"
 
 	"github.com/gorilla/handlers"
 
@@ -15,16 +19,21 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/examples/data"
 
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
 )
 
 var (
 	tls                string
 	caFile             string
+	certFile           string
+	keyFile            string
 	serverAddr         string
 	serverHostOverride string
+	authConfigFile     string
+	authzPolicyFile    string
+	auditLogFile       string
 )
 
 func rootCmd() *cobra.Command {
@@ -36,26 +45,44 @@ func rootCmd() *cobra.Command {
 		to quickly create a Cobra application.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			fmt.Println("Starting the aerostation api server")
-			routes.SetupGoGuardian()
+			authConfig, err := authn.Load(authConfigFile)
+			if err != nil {
+				log.Fatalf("Failed to load auth config %v", err)
+			}
+			if err := routes.SetupGoGuardian(context.Background(), authConfig); err != nil {
+				log.Fatalf("Failed to set up authentication %v", err)
+			}
+			authorizer, err := authz.Load(authzPolicyFile, auditLogFile)
+			if err != nil {
+				log.Fatalf("Failed to set up authorization %v", err)
+			}
+			routes.SetupAuthorization(authorizer)
 
 			fmt.Println("Parsing Flags")
 			flag.Parse()
 			var opts []grpc.DialOption
 			if tls != "" {
-				fmt.Println("tls?")
-				if caFile == "" {
-					caFile = data.Path("x509/ca_cert.pem")
-				}
-
-				creds, err := credentials.NewClientTLSFromFile(caFile, serverHostOverride)
+				// the certificate of capi-api is verified with ca_file, the system roots if empty, and the api-server
+				// presents cert_file to capi-api when set
+				config, err := tlsconfig.Client(tlsconfig.Options{
+					CertFile:   certFile,
+					KeyFile:    keyFile,
+					CAFile:     caFile,
+					ServerName: serverHostOverride,
+				})
 				if err != nil {
 					log.Fatalf("Failed to create TLS credentials %v", err)
 				}
-				opts = append(opts, grpc.WithTransportCredentials(creds))
+				opts = append(opts, grpc.WithTransportCredentials(credentials.NewTLS(config)))
 			} else {
 				fmt.Println("Warning: Running in insecure mode")
 				opts = append(opts, grpc.WithInsecure())
 			}
+			// capi-api authorizes the calls with the credentials of the users
+			opts = append(opts,
+				grpc.WithChainUnaryInterceptor(authn.UnaryClientInterceptor),
+				grpc.WithChainStreamInterceptor(authn.StreamClientInterceptor),
+			)
 
 			fmt.Println("server addr stuff")
 			fmt.Println(serverAddr)
@@ -83,9 +110,14 @@ func rootCmd() *cobra.Command {
 		},
 	}
 	cmd.PersistentFlags().StringVar(&tls, "tls", "", "tls for secure communications")
-	cmd.PersistentFlags().StringVar(&caFile, "ca_file", "", "ca file for secure communications")
+	cmd.PersistentFlags().StringVar(&caFile, "ca_file", "", "ca of the certificate of capi-api, the system roots if empty, reloaded when it changes")
+	cmd.PersistentFlags().StringVar(&certFile, "cert_file", "", "client certificate presented to capi-api, reloaded when it changes")
+	cmd.PersistentFlags().StringVar(&keyFile, "key_file", "", "key of the client certificate")
 	cmd.PersistentFlags().StringVar(&serverAddr, "server_addr", "aerostation-capi-service:10000", "Server address")
 	cmd.PersistentFlags().StringVar(&serverHostOverride, "server_host_override", "", "server host override")
+	cmd.PersistentFlags().StringVar(&authConfigFile, "auth_config", "/etc/aerostation/auth/config.yaml", "authenticators of the api, see pkg/authn")
+	cmd.PersistentFlags().StringVar(&authzPolicyFile, "authz_policy", "", "roles of the users, see pkg/authz, the default policy if empty")
+	cmd.PersistentFlags().StringVar(&auditLogFile, "audit_log", "", "file the denied requests are appended to, stdout if empty")
 	return cmd
 }
 
@@ -99,43 +131,60 @@ func Router(conn grpc.ClientConnInterface) *mux.Router {
 	router := mux.NewRouter()
 
 	kubernetesClient := pb.NewAerostationKubernetesAPIClient(conn)
-	kubeRouter := routes.NewKubernetesRouter(kubernetesClient)
+	kubernetesClientV2 := pbv2.NewAerostationKubernetesAPIClient(conn)
+	kubeRouter := routes.NewKubernetesRouter(kubernetesClient, kubernetesClientV2)
 
 	aerospikeClient := pb.NewAerostationAerospikeAPIClient(conn)
-	aeroAdminRouter := routes.NewAerospikeAdminRouter(aerospikeClient)
+	aerospikeClientV2 := pbv2.NewAerostationAerospikeAPIClient(conn)
+	aeroAdminRouter := routes.NewAerospikeAdminRouter(aerospikeClient, aerospikeClientV2)
 
 	awsRouter := routes.NewAWSValues()
 
 	cloudRouter := routes.GetCloudRouter()
 
-	aeroRouter := routes.NewAerospikeRouter(aerospikeClient)
+	aeroRouter := routes.NewAerospikeRouter(aerospikeClient, aerospikeClientV2, kubernetesClient)
+
+	operationsRouter := routes.NewOperationsRouter(pb.NewAerostationOperationsAPIClient(conn))
 
 	// Admin Kubernetes Routes
 	//router.HandleFunc("/api/v1/admin/kubernetes/clusters", auth.AuthMiddleware(http.HandlerFunc(kubeRouter.GetAllKubernetesClusters))).Methods("GET") //todo: use auth middleware in future
-	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(http.HandlerFunc(kubeRouter.GetAllKubernetesClusters))).Methods("GET")
-	router.HandleFunc("/api/v1/admin/kubernetes/clusters/{namespace}/{name}", routes.AuthMiddleware(http.HandlerFunc(kubeRouter.GetKubernetesCluster))).Methods("GET")
-	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(http.HandlerFunc(kubeRouter.CreateKubernetesCluster))).Methods("POST")
-	router.HandleFunc("/api/v1/admin/kubernetes/clusters/{namespace}/{name}", routes.AuthMiddleware(http.HandlerFunc(kubeRouter.DeleteKubernetesCluster))).Methods("DELETE")
-	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(http.HandlerFunc(kubeRouter.UpdateKubernetesCluster))).Methods("PATCH")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbList, http.HandlerFunc(kubeRouter.GetAllKubernetesClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters/watch", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbWatch, http.HandlerFunc(kubeRouter.WatchKubernetesClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters/{namespace}/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbGet, http.HandlerFunc(kubeRouter.GetKubernetesCluster)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbCreate, http.HandlerFunc(kubeRouter.CreateKubernetesCluster)))).Methods("POST")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters/{namespace}/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbDelete, http.HandlerFunc(kubeRouter.DeleteKubernetesCluster)))).Methods("DELETE")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbUpdate, http.HandlerFunc(kubeRouter.UpdateKubernetesCluster)))).Methods("PATCH")
+	router.HandleFunc("/api/v1/admin/kubernetes/clusters/{namespace}/{name}/upgrade", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminClusters, authz.VerbUpdate, http.HandlerFunc(kubeRouter.UpgradeKubernetesCluster)))).Methods("POST")
+
+	// User Kubernetes Routes
+	router.HandleFunc("/api/v1/kubernetes/clusters/{namespace}/{name}/kubeconfig", routes.AuthMiddleware(routes.Authorize(authz.ResourceKubeconfigs, authz.VerbCreate, http.HandlerFunc(kubeRouter.GetKubeconfig)))).Methods("POST")
 
 	// Admin Aerospike Routes
-	router.HandleFunc("/api/v1/admin/aerospike/clusters", routes.AuthMiddleware(http.HandlerFunc(aeroAdminRouter.GetAllAerospikeClusters))).Methods("GET")
-	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroAdminRouter.GetAerospikeCluster))).Methods("GET")
-	router.HandleFunc("/api/v1/admin/aerospike/clusters", routes.AuthMiddleware(http.HandlerFunc(aeroAdminRouter.AdminCreateAerospikeCluster))).Methods("POST")
-	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroAdminRouter.AdminDeleteAerospikeCluster))).Methods("DELETE")
-	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroAdminRouter.AdminUpdateAerospikeCluster))).Methods("PATCH")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbList, http.HandlerFunc(aeroAdminRouter.GetAllAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters/watch", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbWatch, http.HandlerFunc(aeroAdminRouter.WatchAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbGet, http.HandlerFunc(aeroAdminRouter.GetAerospikeCluster)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbCreate, http.HandlerFunc(aeroAdminRouter.AdminCreateAerospikeCluster)))).Methods("POST")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbDelete, http.HandlerFunc(aeroAdminRouter.AdminDeleteAerospikeCluster)))).Methods("DELETE")
+	router.HandleFunc("/api/v1/admin/aerospike/clusters/{namespace}/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminDatabases, authz.VerbUpdate, http.HandlerFunc(aeroAdminRouter.AdminUpdateAerospikeCluster)))).Methods("PATCH")
 
 	// User Aerospike Routes
-	router.HandleFunc("/api/v1/aerospike/clusters/", routes.AuthMiddleware(http.HandlerFunc(aeroRouter.GetAerospikeClusters))).Methods("GET")
-	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroRouter.GetAerospikeCluster))).Methods("GET")
-	router.HandleFunc("/api/v1/aerospike/clusters", routes.AuthMiddleware(http.HandlerFunc(aeroRouter.CreateAerospikeCluster))).Methods("POST")
-	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroRouter.DeleteAerospikeCluster))).Methods("DELETE")
-	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(http.HandlerFunc(aeroRouter.UpdateAerospikeCluster))).Methods("PATCH")
+	router.HandleFunc("/api/v1/aerospike/clusters/", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbList, http.HandlerFunc(aeroRouter.GetAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters/watch", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbWatch, http.HandlerFunc(aeroRouter.WatchAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbGet, http.HandlerFunc(aeroRouter.GetAerospikeCluster)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbCreate, http.HandlerFunc(aeroRouter.CreateAerospikeCluster)))).Methods("POST")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbDelete, http.HandlerFunc(aeroRouter.DeleteAerospikeCluster)))).Methods("DELETE")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", routes.AuthMiddleware(routes.Authorize(authz.ResourceDatabases, authz.VerbUpdate, http.HandlerFunc(aeroRouter.UpdateAerospikeCluster)))).Methods("PATCH")
+
+	// Operations of the create, update, upgrade and delete routes
+	router.HandleFunc("/api/v1/admin/operations", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminOperations, authz.VerbList, http.HandlerFunc(operationsRouter.AdminGetOperations)))).Methods("GET")
+	router.HandleFunc("/api/v1/admin/operations/{namespace}/{id}", routes.AuthMiddleware(routes.Authorize(authz.ResourceAdminOperations, authz.VerbGet, http.HandlerFunc(operationsRouter.AdminGetOperation)))).Methods("GET")
+	router.HandleFunc("/api/v1/operations", routes.AuthMiddleware(routes.Authorize(authz.ResourceOperations, authz.VerbList, http.HandlerFunc(operationsRouter.GetOperations)))).Methods("GET")
+	router.HandleFunc("/api/v1/operations/{id}", routes.AuthMiddleware(routes.Authorize(authz.ResourceOperations, authz.VerbGet, http.HandlerFunc(operationsRouter.GetOperation)))).Methods("GET")
 
 	// Cloud Values
-	router.HandleFunc("/api/v1/clouds", routes.AuthMiddleware(http.HandlerFunc(cloudRouter.GetSupportedClouds))).Methods("GET")
-	router.HandleFunc("/api/v1/clouds/aws/regions", routes.AuthMiddleware(http.HandlerFunc(awsRouter.GetAWSRegions))).Methods("GET")
-	router.HandleFunc("/api/v1/clouds/aws/{region}/instances", routes.AuthMiddleware(http.HandlerFunc(awsRouter.GetRegionInstanceTypes))).Methods("GET")
+	router.HandleFunc("/api/v1/clouds", routes.AuthMiddleware(routes.Authorize(authz.ResourceClouds, authz.VerbList, http.HandlerFunc(cloudRouter.GetSupportedClouds)))).Methods("GET")
+	router.HandleFunc("/api/v1/clouds/aws/regions", routes.AuthMiddleware(routes.Authorize(authz.ResourceClouds, authz.VerbList, http.HandlerFunc(awsRouter.GetAWSRegions)))).Methods("GET")
+	router.HandleFunc("/api/v1/clouds/aws/{region}/instances", routes.AuthMiddleware(routes.Authorize(authz.ResourceClouds, authz.VerbGet, http.HandlerFunc(awsRouter.GetRegionInstanceTypes)))).Methods("GET")
 
 	router.Use(auth.LoggingMiddleware)
 	return router
@@ -61,21 +61,21 @@ type CreateAerospikeClusterAdminRequest struct {
 // CreateAerospikeClusterRequest A request to create an aerospike cluster
 // swagger:model
 type CreateAerospikeClusterRequest struct {
-	// KubernetesClusterName of workload cluster
+	// Region of the cloud the database is placed in, on a cluster dedicated to the user or else a shared one
 	// required : true
-	// example : cluster-1
+	// example : us-west-2
 	Region string `json:"region,omitempty"`
 	// Name of aerospike cluster
 	// required : true
 	// example: name
 	Name string `json:"name,omitempty"`
-	// capi provider type
-	// required : true
-	// example: docker
-	TargetName string `json:"targetNamespace,omitempty"`
 	// target database namespace
-	// required : true
+	// required : false
 	// example: default
+	TargetName string `json:"targetNamespace,omitempty"`
+	// Cloud of the region (aws/azure/gcp/docker or a capi provider type), aws if empty
+	// required : false
+	// example: aws
 	Provider string `json:"provider,omitempty"`
 	// DatabaseType of the aerospike cluster (memory/ssd/performance)
 	// required : true
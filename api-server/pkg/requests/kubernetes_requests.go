@@ -0,0 +1,18 @@
+package requests
+
+// UpgradeKubernetesClusterRequest A request to upgrade the kubernetes version of a workload cluster
+// swagger:model
+type UpgradeKubernetesClusterRequest struct {
+	// KubeVersion to upgrade to, at most one minor version above the current one
+	// required : true
+	// example: v1.22.0
+	KubeVersion string `json:"kubeversion,omitempty"`
+}
+
+// GetKubeconfigRequest A request for a kubeconfig of a workload cluster
+// swagger:model
+type GetKubeconfigRequest struct {
+	// TTL is how long the kubeconfig is valid, at most 24h
+	// example: 8h
+	TTL string `json:"ttl,omitempty"`
+}
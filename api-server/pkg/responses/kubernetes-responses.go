@@ -1,9 +1,21 @@
 package responses
 
-import v1 "github.com/aerospike/aerostation/api/v1"
+import (
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
 
 // swagger:model
 type KubernetesClusterResponse struct {
 	Cluster v1.AeroClusterManagerSpec   `json:"cluster,omitempty"`
 	Status  v1.AeroClusterManagerStatus `json:"status,omitempty"`
 }
+
+// swagger:model
+type KubeconfigResponse struct {
+	// Kubeconfig with a client certificate of the authenticated user, signed by the cluster CA
+	Kubeconfig string `json:"kubeconfig"`
+	// ExpiresAt is when the client certificate is no longer accepted
+	ExpiresAt time.Time `json:"expiresAt"`
+}
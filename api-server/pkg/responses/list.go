@@ -0,0 +1,12 @@
+package responses
+
+import v1 "github.com/aerospike/aerostation/api/v1"
+
+// NamespacedNameList is a page of the names of the clusters or the databases
+// swagger:model
+type NamespacedNameList struct {
+	Items []v1.NamespacedName `json:"items"`
+	// Continue is the continue query parameter of the next page, empty on the last page
+	// example: eyJjIjoiZXlKMiIsImwiOjIsInMiOjF9
+	Continue string `json:"continue,omitempty"`
+}
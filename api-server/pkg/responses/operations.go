@@ -0,0 +1,43 @@
+package responses
+
+// OperationResponse is the response of the calls tracked as operations, see GET /api/v1/operations/{id}
+// swagger:model
+type OperationResponse struct {
+	// example: Cluster created successfully
+	Message string `json:"message"`
+	// OperationID is empty if the call could not be tracked
+	// example: op-x7k2m9q4zt
+	OperationID string `json:"operationId,omitempty"`
+}
+
+// Operation is the progress of a create, update, upgrade or delete call
+// swagger:model
+type Operation struct {
+	// example: op-x7k2m9q4zt
+	ID string `json:"id"`
+	// example: tenant-alice-5f2c1
+	Namespace string `json:"namespace"`
+	// Verb of the call: create, update, upgrade or delete
+	// example: create
+	Verb string `json:"verb"`
+	// Kind of the object the call changed, AeroClusterManager or AeroDatabase
+	// example: AeroDatabase
+	Kind string `json:"kind"`
+	// example: my-db
+	Name string `json:"name"`
+	Done bool   `json:"done"`
+	// Progress in percent
+	// example: 70
+	Progress int32 `json:"progress"`
+	// Phase of the object
+	// example: Deployed
+	Phase   string `json:"phase,omitempty"`
+	Message string `json:"message,omitempty"`
+	// Error the call failed with, only set once it is done
+	Error string `json:"error,omitempty"`
+	// example: 2021-11-02T15:04:05Z
+	CreateTime string `json:"createTime,omitempty"`
+	UpdateTime string `json:"updateTime,omitempty"`
+	// EndTime is empty until the call is done
+	EndTime string `json:"endTime,omitempty"`
+}
@@ -0,0 +1,45 @@
+package responses
+
+import (
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+// swagger:model
+type Condition struct {
+	Type               string    `json:"type"`
+	Status             string    `json:"status"`
+	Reason             string    `json:"reason,omitempty"`
+	Message            string    `json:"message,omitempty"`
+	LastTransitionTime time.Time `json:"lastTransitionTime"`
+	ObservedGeneration int64     `json:"observedGeneration,omitempty"`
+}
+
+// ClusterEvent is the data of a server-sent event of the clusters watch
+// swagger:model
+type ClusterEvent struct {
+	// Type is ADDED, MODIFIED, DELETED or HEARTBEAT, heartbeats only have a resourceVersion
+	Type            string      `json:"type"`
+	Name            string      `json:"name,omitempty"`
+	Namespace       string      `json:"namespace,omitempty"`
+	ResourceVersion string      `json:"resourceVersion"`
+	Phase           string      `json:"phase,omitempty"`
+	Conditions      []Condition `json:"conditions,omitempty"`
+	KubeVersion     string      `json:"kubeVersion,omitempty"`
+}
+
+// DatabaseEvent is the data of a server-sent event of the databases watch
+// swagger:model
+type DatabaseEvent struct {
+	// Type is ADDED, MODIFIED, DELETED or HEARTBEAT, heartbeats only have a resourceVersion
+	Type            string             `json:"type"`
+	Name            string             `json:"name,omitempty"`
+	Namespace       string             `json:"namespace,omitempty"`
+	ResourceVersion string             `json:"resourceVersion"`
+	Phase           string             `json:"phase,omitempty"`
+	Conditions      []Condition        `json:"conditions,omitempty"`
+	Cluster         *v1.NamespacedName `json:"cluster,omitempty"`
+	Size            int32              `json:"size,omitempty"`
+	ReadyPods       int32              `json:"readyPods,omitempty"`
+}
@@ -8,56 +8,124 @@ import (
 	"net/http"
 
 	"github.com/aerospike/aerostation/api-server/pkg/requests"
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
 	"github.com/aerospike/aerostation/api-server/pkg/validation"
 	v1 "github.com/aerospike/aerostation/api/v1"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/convert"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AerospikeRouter struct {
 	Client pb.AerostationAerospikeAPIClient
+	// ClientV2 returns the typed databases
+	ClientV2 pbv2.AerostationAerospikeAPIClient
+	// Kubernetes places the databases on the clusters of a region
+	Kubernetes pb.AerostationKubernetesAPIClient
 }
 
-func NewAerospikeRouter(client pb.AerostationAerospikeAPIClient) *AerospikeRouter {
-	return &AerospikeRouter{Client: client}
+func NewAerospikeRouter(client pb.AerostationAerospikeAPIClient, clientV2 pbv2.AerostationAerospikeAPIClient,
+	kubernetes pb.AerostationKubernetesAPIClient) *AerospikeRouter {
+	return &AerospikeRouter{Client: client, ClientV2: clientV2, Kubernetes: kubernetes}
 }
 
 //GetAerospikeClusters - get list of all the database clusters
 // swagger:operation GET /api/v1/aerospike/clusters aerospike aerospike
 // ---
 //  summary: Get all aerospike database clusters
-//  description: Get names of all the workload clusters in array of string
+//  description: Get a page of the databases of the user, metadata.continue is the continue of the next page
 //  operationId: getAerospikeClusters
 //  produces:
 //    - application/json
+//  parameters:
+//   - name: labelSelector
+//     in: query
+//     description: Label selector of kubernetes, e.g. env=prod,tier!=cache
+//     required: false
+//     type: string
+//   - name: provider
+//     in: query
+//     description: Provider of the host cluster, a cloud is accepted as well (aws, azure, gcp)
+//     required: false
+//     type: string
+//   - name: region
+//     in: query
+//     description: Region of the host cluster
+//     required: false
+//     type: string
+//   - name: phase
+//     in: query
+//     description: Phase of the databases
+//     required: false
+//     type: string
+//   - name: cluster
+//     in: query
+//     description: Host cluster of the databases, a name or namespace/name
+//     required: false
+//     type: string
+//   - name: sortBy
+//     in: query
+//     description: name, creationTimestamp, phase, provider, region, cluster, descending with a leading "-"
+//     required: false
+//     type: string
+//   - name: limit
+//     in: query
+//     description: Number of databases of a page, every one if 0, at most 500
+//     required: false
+//     type: integer
+//   - name: continue
+//     in: query
+//     description: continue of the previous page
+//     required: false
+//     type: string
 //  responses:
 //    '200':
 //      description: success
 //      schema:
-//       type: array
-//       items:
-//         type: string
-//       example: ["aerospike-cluster-1", "aerospike-cluster-2"]
+//       type: object
+//    '400':
+//      description: Invalid query
 //    '401':
 //      description: Unauthorized
+//    '410':
+//      description: The continue token expired, list again without it
 //    '500':
 //      description: Internal server error
 func (a *AerospikeRouter) GetAerospikeClusters(w http.ResponseWriter, r *http.Request) {
-	// TODO: Get User namespaces?
-
 	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 
-	//TODO: get user's namespace's/claims
-	res, err := a.Client.GetClusters(context.Background(), &pb.GetAerospikeClustersByNamespaceRequest{
-		AerospikeNamespace: "default",
-	})
+	ctx, namespace, err := tenantContext(r)
 	if err != nil {
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode("Internal server error " + "| Reason : " + err.Error())
+		writeNoTenant(w, err)
+		return
 	}
 
-	json.NewEncoder(w).Encode(json.RawMessage(res.Clusters))
+	query, err := parseListQuery(r)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Unable to list databases " + "| Reason : " + err.Error())
+		return
+	}
+
+	query.namespace = namespace
+	res, err := a.ClientV2.ListDatabases(ctx, query.databases())
+	if err != nil {
+		w.WriteHeader(httpCode(err))
+		json.NewEncoder(w).Encode("Unable to list databases " + "| Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	dbs := v1.AeroDatabaseList{Items: []v1.AeroDatabase{}}
+	dbs.Continue = res.Continue
+	for _, db := range res.Databases {
+		dbs.Items = append(dbs.Items, *convert.DatabaseFromProto(db))
+	}
+
+	json.NewEncoder(w).Encode(dbs)
 }
 
 //GetAerospikeCluster - Get aerospike cluster by name
@@ -90,18 +158,23 @@ func (a *AerospikeRouter) GetAerospikeCluster(w http.ResponseWriter, r *http.Req
 	vars := mux.Vars(r)
 	dbName := vars["name"]
 
-	//TODO: get user namespace/claims
-	res, err := a.Client.GetCluster(context.Background(), &pb.GetAerospikeClusterRequest{
-		AerospikeName:      dbName,
-		AerospikeNamespace: "default",
-	})
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
 
+	res, err := a.ClientV2.GetDatabase(ctx, &pbv2.GetDatabaseRequest{
+		Name:      dbName,
+		Namespace: namespace,
+	})
 	if err != nil {
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode("cluster not found ")
+		return
 	}
 
-	json.NewEncoder(w).Encode(json.RawMessage(res.Cluster))
+	json.NewEncoder(w).Encode(convert.DatabaseFromProto(res).Spec)
 }
 
 //CreateAerospikeCluster - Create Aerospike Database Cluster
@@ -122,6 +195,8 @@ func (a *AerospikeRouter) GetAerospikeCluster(w http.ResponseWriter, r *http.Req
 //  responses:
 //    '200':
 //      description: Aerospike database created successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Bad request
 //    '401':
@@ -129,7 +204,6 @@ func (a *AerospikeRouter) GetAerospikeCluster(w http.ResponseWriter, r *http.Req
 //    '500':
 //      description: Unable to create cluster
 func (a *AerospikeRouter) CreateAerospikeCluster(w http.ResponseWriter, r *http.Request) {
-	// TODO: get user namespace/claim
 	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 
@@ -148,24 +222,54 @@ func (a *AerospikeRouter) CreateAerospikeCluster(w http.ResponseWriter, r *http.
 		return
 	}
 
-	fmt.Printf("[DEBUG] creating cluster %s \n", input.Name)
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
+
+	cloud := input.Provider
+	if cloud == "" {
+		cloud = defaultCloud
+	}
+
+	cluster, created, err := a.placeDatabase(ctx, cloud, input.Region, namespace)
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.NotFound, codes.FailedPrecondition:
+			code = http.StatusServiceUnavailable
+		}
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode("Unable to create database" + " | Reason : " + status.Convert(err).Message())
+		return
+	}
 
-	//TODO: get Kubernetes Cluster from Region and get all user info
-	_, err := a.Client.CreateCluster(context.Background(), &pb.CreateAerospikeClusterRequest{
-		KubernetesClusterName:      "default-kubernetes-cluster",
-		KubernetesClusterNamespace: "default",
+	res, err := a.Client.CreateCluster(ctx, &pb.CreateAerospikeClusterRequest{
+		KubernetesClusterName:      cluster.Name,
+		KubernetesClusterNamespace: cluster.Namespace,
 		Name:                       input.Name,
-		Namespace:                  "default",
-		DatabaseType:               "memory",
+		Namespace:                  namespace,
+		TargetNamespace:            input.TargetName,
+		DatabaseType:               input.DatabaseType,
 		Options:                    &pb.DatabaseOptions{Replicas: input.Options.Replicas},
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpCode(err))
 		_ = json.NewEncoder(w).Encode("Unable to create cluster")
 		return
 	}
 
-	_ = json.NewEncoder(w).Encode("Aerospike database created successfully")
+	if created {
+		_ = json.NewEncoder(w).Encode(responses.OperationResponse{
+			Message:     "Aerospike database created successfully, it starts once cluster " + cluster.Name + " is provisioned",
+			OperationID: res.OperationId,
+		})
+		return
+	}
+	_ = json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Aerospike database created successfully", OperationID: res.OperationId})
 }
 
 //DeleteAerospikeCluster - delete aerospike database
@@ -185,6 +289,8 @@ func (a *AerospikeRouter) CreateAerospikeCluster(w http.ResponseWriter, r *http.
 //  responses:
 //    '200':
 //      description: Aerospike cluster deleted successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '401':
 //      description: Unauthorized
 //    '500':
@@ -199,20 +305,24 @@ func (a *AerospikeRouter) DeleteAerospikeCluster(w http.ResponseWriter, r *http.
 
 	fmt.Printf("[DEBUG] deleting aerospike cluster %s \n", clusterName)
 
-	ctx := context.Background()
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
 
-	_, err := a.Client.DeleteCluster(ctx, &pb.DeleteAerospikeClusterRequest{
+	res, err := a.Client.DeleteCluster(ctx, &pb.DeleteAerospikeClusterRequest{
 		AerospikeName:      clusterName,
-		AerospikeNamespace: "default",
+		AerospikeNamespace: namespace,
 	})
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpCode(err))
 		json.NewEncoder(w).Encode("Internal Server Error | Reason : " + err.Error())
 		return
 	}
 
-	json.NewEncoder(w).Encode("Aerospike cluster deleted successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Aerospike cluster deleted successfully", OperationID: res.OperationId})
 	return
 }
 
@@ -239,6 +349,8 @@ func (a *AerospikeRouter) DeleteAerospikeCluster(w http.ResponseWriter, r *http.
 //  responses:
 //    '200':
 //      description: Cluster updated successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Unable to update aerospike cluster
 //    '401':
@@ -246,8 +358,6 @@ func (a *AerospikeRouter) DeleteAerospikeCluster(w http.ResponseWriter, r *http.
 //    '500':
 //      description: Internal Server Error
 func (a *AerospikeRouter) UpdateAerospikeCluster(w http.ResponseWriter, r *http.Request) {
-	//TODO: get user claim/namespace
-
 	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 
@@ -262,18 +372,53 @@ func (a *AerospikeRouter) UpdateAerospikeCluster(w http.ResponseWriter, r *http.
 		return
 	}
 
-	_, err := a.Client.UpdateCluster(context.Background(), &pb.UpdateAerospikeClusterRequest{
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
+
+	res, err := a.Client.UpdateCluster(ctx, &pb.UpdateAerospikeClusterRequest{
 		ClusterName:      dbName,
-		ClusterNamespace: "default",
+		ClusterNamespace: namespace,
 		Options:          &pb.DatabaseOptions{Replicas: input.Replicas},
 	})
 
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		http.Error(w, err.Error(), httpCode(err))
 		json.NewEncoder(w).Encode("Internal Server Error | Reason : " + err.Error())
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster updated successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster updated successfully", OperationID: res.OperationId})
 	return
 }
+
+// defaultCloud is the cloud of the databases whose request has no provider
+const defaultCloud = "aws"
+
+// placeDatabase returns the cluster of the region dedicated to the user namespace, or else a shared one, which is
+// created when none has capacity
+func (a *AerospikeRouter) placeDatabase(ctx context.Context, cloud, region, namespace string) (*pb.NamespacedName, bool, error) {
+	dedicated, err := a.Kubernetes.MapRegionToCluster(ctx, &pb.MapRegionToClusterRequest{
+		Cloud:         cloud,
+		Region:        region,
+		UserNamespace: namespace,
+	})
+	if err == nil {
+		return dedicated.Cluster, dedicated.Created, nil
+	}
+	if status.Code(err) != codes.NotFound {
+		return nil, false, err
+	}
+
+	shared, err := a.Kubernetes.MapRegionToSharedCluster(ctx, &pb.MapRegionToSharedClusterRequest{
+		Cloud:  cloud,
+		Region: region,
+		Create: true,
+	})
+	if err != nil {
+		return nil, false, err
+	}
+	return shared.Cluster, shared.Created, nil
+}
@@ -1,8 +1,6 @@
 package routes
 
 import (
-	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -11,17 +9,23 @@ import (
 	"github.com/aerospike/aerostation/api-server/pkg/requests"
 	"github.com/aerospike/aerostation/api-server/pkg/responses"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/convert"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type AerospikeAdminRouter struct {
 	Client pb.AerostationAerospikeAPIClient
+	// ClientV2 returns the typed databases
+	ClientV2 pbv2.AerostationAerospikeAPIClient
 }
 
-func NewAerospikeAdminRouter(client pb.AerostationAerospikeAPIClient) *AerospikeAdminRouter {
-	return &AerospikeAdminRouter{Client: client}
+func NewAerospikeAdminRouter(client pb.AerostationAerospikeAPIClient, clientV2 pbv2.AerostationAerospikeAPIClient) *AerospikeAdminRouter {
+	return &AerospikeAdminRouter{Client: client, ClientV2: clientV2}
 }
 
 //getAerospikeCluster - get a aerospike cluster
@@ -62,60 +66,90 @@ func (a *AerospikeAdminRouter) GetAerospikeCluster(w http.ResponseWriter, r *htt
 	clusterName := vars["name"]
 	clusterNamespace := vars["namespace"]
 	//TODO: Get user namespace/scope/claim
-	res, err := a.Client.GetCluster(context.Background(), &pb.GetAerospikeClusterRequest{
-		AerospikeNamespace: clusterNamespace,
-		AerospikeName:      clusterName,
+	res, err := a.ClientV2.GetDatabase(r.Context(), &pbv2.GetDatabaseRequest{
+		Namespace: clusterNamespace,
+		Name:      clusterName,
 	})
-
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		json.NewEncoder(w).Encode("unable to get cluster " + clusterName + "| Reason : " + err.Error())
-		return
-	}
-
-	clusterReader := bytes.NewReader(res.Cluster)
-	var cluster v1.AeroDatabaseSpec
-	if err := json.NewDecoder(clusterReader).Decode(&cluster); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		json.NewEncoder(w).Encode("Internal Server Error")
-		return
-	}
-
-	statusReader := bytes.NewReader(res.Status)
-	var status v1.AeroDatabaseStatus
-	if err := json.NewDecoder(statusReader).Decode(&status); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		json.NewEncoder(w).Encode("Internal Server Error")
-		return
-	}
-
 	if err != nil {
-		w.WriteHeader(http.StatusBadRequest)
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.NotFound {
+			code = http.StatusNotFound
+		}
+		w.WriteHeader(code)
 		json.NewEncoder(w).Encode("unable to get cluster " + clusterName + "| Reason : " + err.Error())
 		return
 	}
 
-	json.NewEncoder(w).Encode(responses.AerospikeClusterResponse{Cluster: cluster, Status: status})
+	db := convert.DatabaseFromProto(res)
+	json.NewEncoder(w).Encode(responses.AerospikeClusterResponse{Cluster: db.Spec, Status: db.Status})
 }
 
 //GetAllAerospikeClusters - get list of all the database clusters
 // swagger:operation GET /api/v1/admin/aerospike/clusters admin aerospike
 // ---
 //  summary: Get all aerospike database clusters
-//  description: Get names of all the workload clusters in array of string
+//  description: Get a page of the names of the databases, with the continue of the next page
 //  operationId: getAllAerospikeClusters
 //  produces:
 //    - application/json
+//  parameters:
+//   - name: namespace
+//     in: query
+//     description: Namespace of the databases, every namespace if empty
+//     required: false
+//     type: string
+//   - name: labelSelector
+//     in: query
+//     description: Label selector of kubernetes, e.g. env=prod,tier!=cache
+//     required: false
+//     type: string
+//   - name: provider
+//     in: query
+//     description: Provider of the host cluster, a cloud is accepted as well (aws, azure, gcp)
+//     required: false
+//     type: string
+//   - name: region
+//     in: query
+//     description: Region of the host cluster
+//     required: false
+//     type: string
+//   - name: phase
+//     in: query
+//     description: Phase of the databases
+//     required: false
+//     type: string
+//   - name: cluster
+//     in: query
+//     description: Host cluster of the databases, a name or namespace/name
+//     required: false
+//     type: string
+//   - name: sortBy
+//     in: query
+//     description: name, creationTimestamp, phase, provider, region, cluster, descending with a leading "-"
+//     required: false
+//     type: string
+//   - name: limit
+//     in: query
+//     description: Number of databases of a page, every one if 0, at most 500
+//     required: false
+//     type: integer
+//   - name: continue
+//     in: query
+//     description: continue of the previous page
+//     required: false
+//     type: string
 //  responses:
 //    '200':
 //      description: success
 //      schema:
-//       type: array
-//       items:
-//	       $ref: '#/definitions/NamespacedName'
-//       example: [{"namespace":"cluster-namespace","name": "cluster-name"}, {"namespace":"cluster2-namespace","name": "cluster2-name"}]
+//       $ref: '#/definitions/NamespacedNameList'
+//       example: {"items": [{"namespace":"db-namespace","name": "db-name"}], "continue": "eyJvIjoicmVnaW9uIn0"}
+//    '400':
+//      description: Invalid query
 //    '401':
 //      description: Unauthorized
+//    '410':
+//      description: The continue token expired, list again without it
 //    '500':
 //      description: Internal server error
 func (a *AerospikeAdminRouter) GetAllAerospikeClusters(w http.ResponseWriter, r *http.Request) {
@@ -124,14 +158,26 @@ func (a *AerospikeAdminRouter) GetAllAerospikeClusters(w http.ResponseWriter, r
 	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 
-	//TODO: get user's namespace's/claims
-	res, err := a.Client.GetAllClusters(context.Background(), &pb.GetAllAerospikeClustersRequest{})
+	query, err := parseListQuery(r)
 	if err != nil {
-		w.WriteHeader(http.StatusNotFound)
-		json.NewEncoder(w).Encode("Internal server error " + "| Reason : " + err.Error())
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Unable to list databases " + "| Reason : " + err.Error())
+		return
 	}
 
-	json.NewEncoder(w).Encode(json.RawMessage(res.Clusters))
+	res, err := a.ClientV2.ListDatabases(r.Context(), query.databases())
+	if err != nil {
+		w.WriteHeader(httpCode(err))
+		json.NewEncoder(w).Encode("Unable to list databases " + "| Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	names := responses.NamespacedNameList{Items: []v1.NamespacedName{}, Continue: res.Continue}
+	for _, db := range res.Databases {
+		names.Items = append(names.Items, v1.NamespacedName{Name: db.Metadata.GetName(), Namespace: db.Metadata.GetNamespace()})
+	}
+
+	json.NewEncoder(w).Encode(names)
 }
 
 //AdminCreateAerospikeCluster - Create Aerospike Database Cluster
@@ -152,6 +198,8 @@ func (a *AerospikeAdminRouter) GetAllAerospikeClusters(w http.ResponseWriter, r
 //  responses:
 //    '200':
 //      description: Aerospike database created successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Unable to create cluster
 //    '401':
@@ -174,14 +222,14 @@ func (c *AerospikeAdminRouter) AdminCreateAerospikeCluster(w http.ResponseWriter
 	fmt.Printf("[DEBUG] creating cluster %s \n", input.Name)
 
 	//TODO: get Kubernetes Cluster from Region.
-	_, err := c.Client.CreateCluster(context.Background(), &pb.CreateAerospikeClusterRequest{
+	res, err := c.Client.CreateCluster(r.Context(), &pb.CreateAerospikeClusterRequest{
 		KubernetesClusterName:      input.KubernetesClusterName,
 		KubernetesClusterNamespace: input.KubernetesClusterNamespace,
 		TargetNamespace:            input.TargetNamespace,
 		DeployClient:               input.DeployClient,
 		Name:                       input.Name,
 		Namespace:                  input.Namespace,
-		DatabaseType:               "memory",
+		DatabaseType:               input.DatabaseType,
 		Options:                    &pb.DatabaseOptions{Replicas: input.Options.Replicas},
 	})
 
@@ -191,7 +239,7 @@ func (c *AerospikeAdminRouter) AdminCreateAerospikeCluster(w http.ResponseWriter
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster created successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster created successfully", OperationID: res.OperationId})
 }
 
 //AdminDeleteAerospikeCluster - delete aerospike database
@@ -216,6 +264,8 @@ func (c *AerospikeAdminRouter) AdminCreateAerospikeCluster(w http.ResponseWriter
 //  responses:
 //    '200':
 //      description: Aerospike cluster deleted successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Unable to delete cluster
 //    '401':
@@ -237,9 +287,9 @@ func (c *AerospikeAdminRouter) AdminDeleteAerospikeCluster(w http.ResponseWriter
 
 	fmt.Printf("[DEBUG] deleting aerospike cluster %s \n", clusterName)
 
-	ctx := context.Background()
+	ctx := r.Context()
 
-	_, err := c.Client.DeleteCluster(ctx, &pb.DeleteAerospikeClusterRequest{
+	res, err := c.Client.DeleteCluster(ctx, &pb.DeleteAerospikeClusterRequest{
 		AerospikeName:      clusterName,
 		AerospikeNamespace: clusterNamespaceName,
 	})
@@ -250,7 +300,7 @@ func (c *AerospikeAdminRouter) AdminDeleteAerospikeCluster(w http.ResponseWriter
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster deleted successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster deleted successfully", OperationID: res.OperationId})
 }
 
 //AdminUpdateAerospikeCluster - update aerospike cluster
@@ -281,6 +331,8 @@ func (c *AerospikeAdminRouter) AdminDeleteAerospikeCluster(w http.ResponseWriter
 //  responses:
 //    '200':
 //      description: Cluster updated successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Bad request
 //    '401':
@@ -305,7 +357,7 @@ func (a *AerospikeAdminRouter) AdminUpdateAerospikeCluster(w http.ResponseWriter
 		return
 	}
 
-	_, err := a.Client.UpdateCluster(context.Background(), &pb.UpdateAerospikeClusterRequest{
+	res, err := a.Client.UpdateCluster(r.Context(), &pb.UpdateAerospikeClusterRequest{
 		ClusterName:      dbName,
 		ClusterNamespace: dbNamespace,
 		Options:          &pb.DatabaseOptions{Replicas: input.Replicas},
@@ -317,6 +369,6 @@ func (a *AerospikeAdminRouter) AdminUpdateAerospikeCluster(w http.ResponseWriter
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster updated successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster updated successfully", OperationID: res.OperationId})
 	return
 }
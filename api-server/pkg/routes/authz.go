@@ -0,0 +1,41 @@
+package routes
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+
+	"github.com/aerospike/aerostation/pkg/authz"
+	"github.com/shaj13/go-guardian/v2/auth"
+)
+
+var authorizer *authz.Authorizer
+
+// SetupAuthorization sets the policy the routes are authorized with, see authz.Policy
+func SetupAuthorization(a *authz.Authorizer) {
+	authorizer = a
+}
+
+// Authorize lets the users whose roles allow the verb on the resource through, it runs after AuthMiddleware. The
+// other users get a 403 and are recorded in the audit log.
+func Authorize(resource, verb string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		user := auth.User(r)
+		if user == nil || authorizer == nil {
+			writeForbidden(w, authz.ErrForbidden)
+			return
+		}
+
+		if err := authorizer.Authorize(user, resource, verb, r.Method+" "+r.URL.Path); err != nil {
+			log.Println("[ERROR] ", err)
+			writeForbidden(w, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeForbidden(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusForbidden)
+	_ = json.NewEncoder(w).Encode("Forbidden | Reason : " + err.Error())
+}
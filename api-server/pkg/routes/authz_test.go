@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/pkg/authz"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func auditEvents(t *testing.T, audit string) []authz.Event {
+	var events []authz.Event
+	for _, line := range strings.Split(strings.TrimSpace(audit), "\n") {
+		if line == "" {
+			continue
+		}
+		event := authz.Event{}
+		NewWithT(t).Expect(json.Unmarshal([]byte(line), &event)).To(Succeed())
+		events = append(events, event)
+	}
+	return events
+}
+
+func TestAuthorizeRoutes(t *testing.T) {
+	g := NewWithT(t)
+	handler, _, _, audit := tenantBackend(t)
+
+	createDatabase(t, handler, alice, "orders")
+
+	// the admin routes are for platform admins only
+	w := call(handler, alice, http.MethodGet, "/api/v1/admin/aerospike/clusters", nil)
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+	w = call(handler, admin, http.MethodGet, "/api/v1/admin/aerospike/clusters", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+
+	// a tenant viewer reads the databases of its tenant but does not change them
+	g.Expect(listDatabases(t, handler, carol)).To(BeEmpty())
+	w = call(handler, carol, http.MethodPost, "/api/v1/aerospike/clusters", nil)
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+	w = call(handler, carol, http.MethodDelete, "/api/v1/aerospike/clusters/orders", nil)
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+
+	events := auditEvents(t, audit.String())
+	g.Expect(events).To(HaveLen(3))
+	g.Expect(events[0].User).To(Equal(alice))
+	g.Expect(events[0].Roles).To(ConsistOf(authz.RoleTenantAdmin))
+	g.Expect(events[0].Resource).To(Equal(authz.ResourceAdminDatabases))
+	g.Expect(events[0].Request).To(Equal("GET /api/v1/admin/aerospike/clusters"))
+	g.Expect(events[2].User).To(Equal(carol))
+	g.Expect(events[2].Verb).To(Equal(authz.VerbDelete))
+	g.Expect(events[2].Decision).To(Equal(authz.DecisionDeny))
+}
+
+func TestAuthorizeRPCs(t *testing.T) {
+	g := NewWithT(t)
+	handler, conn, _, audit := tenantBackend(t)
+	createDatabase(t, handler, bob, "payments")
+
+	aerospike := pb.NewAerostationAerospikeAPIClient(conn)
+	aerospikeV2 := pbv2.NewAerostationAerospikeAPIClient(conn)
+
+	// capi-api is not reachable without credentials
+	_, err := aerospike.GetAllClusters(context.Background(), &pb.GetAllAerospikeClustersRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+
+	// the calls of tenants are scoped to their namespace, even without the tenant metadata
+	_, err = aerospikeV2.GetDatabase(credentials(alice), &pbv2.GetDatabaseRequest{Name: "payments", Namespace: namespaceOf(t, bob)})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	database, err := aerospikeV2.GetDatabase(credentials(bob), &pbv2.GetDatabaseRequest{Name: "payments", Namespace: namespaceOf(t, bob)})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(database.Metadata.Name).To(Equal("payments"))
+
+	// the admin rpcs are for platform admins only
+	_, err = aerospike.GetAllClusters(credentials(bob), &pb.GetAllAerospikeClustersRequest{})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	_, err = aerospike.DeleteCluster(credentials(carol), &pb.DeleteAerospikeClusterRequest{AerospikeName: "payments", AerospikeNamespace: namespaceOf(t, carol)})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+	all, err := aerospikeV2.ListDatabases(credentials(admin), &pbv2.ListDatabasesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(all.Databases).To(HaveLen(1))
+
+	events := auditEvents(t, audit.String())
+	g.Expect(events).To(HaveLen(2))
+	g.Expect(events[0].User).To(Equal(bob))
+	g.Expect(events[0].Request).To(Equal("/messages.AerostationAerospikeAPI/GetAllClusters"))
+	g.Expect(events[1].User).To(Equal(carol))
+	g.Expect(events[1].Resource).To(Equal(authz.ResourceDatabases))
+}
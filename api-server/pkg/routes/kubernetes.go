@@ -0,0 +1,116 @@
+package routes
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aerospike/aerostation/api-server/pkg/requests"
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/gorilla/mux"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetKubeconfig - issue a kubeconfig of a workload cluster to the authenticated user
+// swagger:operation POST /api/v1/kubernetes/clusters/{namespace}/{name}/kubeconfig kubernetes kubernetes
+// ---
+//  summary: Get a kubeconfig of a workload cluster
+//  description: Returns a kubeconfig with a short-lived client certificate for the authenticated user, signed by the cluster CA. The user has the view ClusterRole of the workload cluster, in its tenant namespace only on the shared clusters.
+//  operationId: getKubeconfig
+//  produces:
+//    - application/json
+//  parameters:
+//   - name: namespace
+//     in: path
+//     description: Namespace of the cluster
+//     required: true
+//     type: string
+//   - name: name
+//     in: path
+//     description: Name of the cluster
+//     required: true
+//     type: string
+//   - name: body
+//     in: body
+//     description: Request body for the kubeconfig, the ttl defaults to 8h
+//     required: false
+//     schema:
+//	     $ref: '#/definitions/GetKubeconfigRequest'
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/KubeconfigResponse'
+//    '400':
+//      description: Bad request, e.g. the ttl is longer than 24h
+//    '401':
+//      description: Unauthorized
+//    '404':
+//      description: cluster not found
+//    '409':
+//      description: the cluster is not provisioned or its CA can not sign certificates
+//    '500':
+//      description: Unable to issue the kubeconfig
+func (k *KubernetesRouter) GetKubeconfig(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	vars := mux.Vars(r)
+	namespace := vars["namespace"]
+	clusterName := vars["name"]
+
+	user := auth.User(r)
+	if user == nil {
+		code := http.StatusUnauthorized
+		http.Error(w, http.StatusText(code), code)
+		return
+	}
+
+	// the body is optional
+	var input requests.GetKubeconfigRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && err != io.EOF {
+		w.WriteHeader(http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Bad request" + "| Reason : " + err.Error())
+		return
+	}
+
+	var ttl time.Duration
+	if input.TTL != "" {
+		var err error
+		if ttl, err = time.ParseDuration(input.TTL); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Bad request" + "| Reason : " + err.Error())
+			return
+		}
+	}
+
+	res, err := k.Client.GetKubeconfig(r.Context(), &pb.GetKubeconfigRequest{
+		Name:       clusterName,
+		Namespace:  namespace,
+		User:       user.GetUserName(),
+		TTLSeconds: int64(ttl / time.Second),
+	})
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.NotFound:
+			code = http.StatusNotFound
+		case codes.FailedPrecondition:
+			code = http.StatusConflict
+		}
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode("Unable to get kubeconfig of cluster " + clusterName + " | Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	json.NewEncoder(w).Encode(responses.KubeconfigResponse{
+		Kubeconfig: string(res.Kubeconfig),
+		ExpiresAt:  time.Unix(res.ExpiresAt, 0).UTC(),
+	})
+}
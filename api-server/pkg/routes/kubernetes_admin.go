@@ -1,64 +1,121 @@
 package routes
 
 import (
-	"bytes"
-	"context"
 	"encoding/json"
 	"fmt"
 	"log"
 	"net/http"
 
+	"github.com/aerospike/aerostation/api-server/pkg/requests"
 	"github.com/aerospike/aerostation/api-server/pkg/responses"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/convert"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
 	"github.com/gorilla/mux"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type KubernetesRouter struct {
 	Client pb.AerostationKubernetesAPIClient
+	// ClientV2 returns the typed clusters
+	ClientV2 pbv2.AerostationKubernetesAPIClient
 }
 
-func NewKubernetesRouter(client pb.AerostationKubernetesAPIClient) *KubernetesRouter {
-	return &KubernetesRouter{Client: client}
+func NewKubernetesRouter(client pb.AerostationKubernetesAPIClient, clientV2 pbv2.AerostationKubernetesAPIClient) *KubernetesRouter {
+	return &KubernetesRouter{Client: client, ClientV2: clientV2}
 }
 
 // GetAllKubernetesClusters - get list of all the workload clusters
 // swagger:operation GET /api/v1/admin/kubernetes/clusters admin kubernetes
 // ---
 //  summary: Get all clusters
-//  description: Get names of all the workload clusters in array of string
+//  description: Get a page of the names of the workload clusters, with the continue of the next page
 //  operationId: getAllClusters
 //  produces:
 //    - application/json
+//  parameters:
+//   - name: namespace
+//     in: query
+//     description: Namespace of the clusters, every namespace if empty
+//     required: false
+//     type: string
+//   - name: labelSelector
+//     in: query
+//     description: Label selector of kubernetes, e.g. env=prod,tier!=cache
+//     required: false
+//     type: string
+//   - name: provider
+//     in: query
+//     description: Provider of the clusters, a cloud is accepted as well (aws, azure, gcp)
+//     required: false
+//     type: string
+//   - name: region
+//     in: query
+//     description: Region of the clusters
+//     required: false
+//     type: string
+//   - name: phase
+//     in: query
+//     description: Phase of the clusters
+//     required: false
+//     type: string
+//   - name: sortBy
+//     in: query
+//     description: name, creationTimestamp, phase, provider, region, descending with a leading "-"
+//     required: false
+//     type: string
+//   - name: limit
+//     in: query
+//     description: Number of clusters of a page, every one if 0, at most 500
+//     required: false
+//     type: integer
+//   - name: continue
+//     in: query
+//     description: continue of the previous page
+//     required: false
+//     type: string
 //  responses:
 //    '200':
 //      description: success
 //      schema:
-//       type: array
-//       items:
-//	       $ref: '#/definitions/NamespacedName'
-//       example: [{"namespace":"cluster-namespace","name": "cluster-name"}, {"namespace":"cluster2-namespace","name": "cluster2-name"}]
+//       $ref: '#/definitions/NamespacedNameList'
+//       example: {"items": [{"namespace":"cluster-namespace","name": "cluster-name"}], "continue": "eyJvIjoicmVnaW9uIn0"}
+//    '400':
+//      description: Invalid query
 //    '401':
 //      description: Unauthorized
+//    '410':
+//      description: The continue token expired, list again without it
 //    '500':
 //      description: Internal server error
 func (k *KubernetesRouter) GetAllKubernetesClusters(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 
-	// TODO: get namespace of user
-	res, err := k.Client.GetClusters(context.Background(), &pb.GetKubernetesClustersRequest{
-		Namespace: "default",
-	}) //.List(context.Background(), clusters)
+	query, err := parseListQuery(r)
 	if err != nil {
 		w.WriteHeader(http.StatusBadRequest)
 		json.NewEncoder(w).Encode("unable to get all kubernetes clusters " + "| Reason : " + err.Error())
 		return
 	}
 
-	json.NewEncoder(w).Encode(json.RawMessage(res.Clusters))
+	res, err := k.ClientV2.ListClusters(r.Context(), query.clusters())
+	if err != nil {
+		w.WriteHeader(httpCode(err))
+		json.NewEncoder(w).Encode("unable to get all kubernetes clusters " + "| Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	names := responses.NamespacedNameList{Items: []v1.NamespacedName{}, Continue: res.Continue}
+	for _, cluster := range res.Clusters {
+		names.Items = append(names.Items, v1.NamespacedName{Name: cluster.Metadata.GetName(), Namespace: cluster.Metadata.GetNamespace()})
+	}
+
+	json.NewEncoder(w).Encode(names)
 }
 
 // GetKubernetesCluster - Get cluster spec by name
@@ -97,33 +154,22 @@ func (k *KubernetesRouter) GetKubernetesCluster(w http.ResponseWriter, r *http.R
 	clusterName := vars["name"]
 	clusterNamespace := vars["namespace"]
 	// TODO: Get user namespace/scope/claim
-	res, err := k.Client.GetCluster(context.Background(), &pb.GetKubernetesClusterRequest{
+	res, err := k.ClientV2.GetCluster(r.Context(), &pbv2.GetClusterRequest{
 		Namespace: clusterNamespace,
 		Name:      clusterName,
 	})
 	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
+		code := http.StatusInternalServerError
+		if status.Code(err) == codes.NotFound {
+			code = http.StatusNotFound
+		}
+		w.WriteHeader(code)
 		json.NewEncoder(w).Encode("unable to get cluster " + clusterName + "| Reason : " + err.Error())
 		return
 	}
 
-	clusterReader := bytes.NewReader(res.Cluster)
-	var cluster v1.AeroClusterManagerSpec
-	if err := json.NewDecoder(clusterReader).Decode(&cluster); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		json.NewEncoder(w).Encode("Internal Server Error")
-		return
-	}
-
-	statusReader := bytes.NewReader(res.Status)
-	var status v1.AeroClusterManagerStatus
-	if err := json.NewDecoder(statusReader).Decode(&status); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		json.NewEncoder(w).Encode("Internal Server Error")
-		return
-	}
-
-	json.NewEncoder(w).Encode(responses.KubernetesClusterResponse{Cluster: cluster, Status: status})
+	manager := convert.ClusterFromProto(res)
+	json.NewEncoder(w).Encode(responses.KubernetesClusterResponse{Cluster: manager.Spec, Status: manager.Status})
 }
 
 // CreateKubernetesCluster - Create cluster
@@ -144,6 +190,8 @@ func (k *KubernetesRouter) GetKubernetesCluster(w http.ResponseWriter, r *http.R
 //  responses:
 //    '200':
 //      description: Cluster created successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Bad request
 //    '401':
@@ -166,7 +214,40 @@ func (k *KubernetesRouter) CreateKubernetesCluster(w http.ResponseWriter, r *htt
 	fmt.Printf("[DEBUG] creating cluster %s on %s\n", input.Name, input.Provider)
 	eksOptions := &pb.CreateEKSKubernetesClusterRequest{}
 	dockerOptions := &pb.CreateDockerKubernetesClusterRequest{}
+	aksOptions := &pb.CreateAKSKubernetesClusterRequest{}
+	gkeOptions := &pb.CreateGCPKubernetesClusterRequest{}
 	switch input.Provider {
+	case "gke":
+		if input.GKEOptions == nil {
+			http.Error(w, "gkeOptions are required", http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Bad request" + "| Reason : gkeOptions are required")
+			return
+		}
+		gkeOptions = &pb.CreateGCPKubernetesClusterRequest{
+			Name:         input.Name,
+			Project:      input.GKEOptions.Project,
+			Region:       input.GKEOptions.Region,
+			InstanceType: input.GKEOptions.MachineType,
+			Network:      input.GKEOptions.Network,
+		}
+	case "aks":
+		if input.AKSOptions == nil {
+			http.Error(w, "aksOptions are required", http.StatusBadRequest)
+			json.NewEncoder(w).Encode("Bad request" + "| Reason : aksOptions are required")
+			return
+		}
+		aksOptions = &pb.CreateAKSKubernetesClusterRequest{
+			Name:           input.Name,
+			Region:         input.AKSOptions.Location,
+			InstanceType:   input.AKSOptions.VMSize,
+			SSHKey:         input.AKSOptions.SSHPublicKey,
+			ResourceGroup:  input.AKSOptions.ResourceGroup,
+			SubscriptionID: input.AKSOptions.SubscriptionID,
+			IdentityRef: &pb.NamespacedName{
+				Name:      input.AKSOptions.IdentityRef.Name,
+				Namespace: input.AKSOptions.IdentityRef.Namespace,
+			},
+		}
 	case "docker":
 		dockerOptions = &pb.CreateDockerKubernetesClusterRequest{}
 	case "eks":
@@ -184,16 +265,16 @@ func (k *KubernetesRouter) CreateKubernetesCluster(w http.ResponseWriter, r *htt
 		}
 	}
 
-	_, err := k.Client.CreateCluster(context.Background(), &pb.CreateKubernetesClusterRequest{
+	res, err := k.Client.CreateCluster(r.Context(), &pb.CreateKubernetesClusterRequest{
 		Name:        input.Name,
 		Provider:    input.Provider,
 		Replicas:    input.Replicas,
 		KubeVersion: input.KubeVersion,
 		Namespace:   metav1.NamespaceDefault,
 		Eks:         eksOptions,
-		Gke:         &pb.CreateGCPKubernetesClusterRequest{},
+		Gke:         gkeOptions,
 		Docker:      dockerOptions,
-		Aks:         &pb.CreateAKSKubernetesClusterRequest{},
+		Aks:         aksOptions,
 	})
 	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -201,7 +282,7 @@ func (k *KubernetesRouter) CreateKubernetesCluster(w http.ResponseWriter, r *htt
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster created successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster created successfully", OperationID: res.OperationId})
 	return
 }
 
@@ -227,6 +308,8 @@ func (k *KubernetesRouter) CreateKubernetesCluster(w http.ResponseWriter, r *htt
 //  responses:
 //    '200':
 //      description: Cluster deleted successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '401':
 //      description: Unauthorized
 //    '500':
@@ -246,14 +329,15 @@ func (k *KubernetesRouter) DeleteKubernetesCluster(w http.ResponseWriter, r *htt
 	}
 
 	fmt.Printf("[DEBUG] deleting cluster %s \n", clusterName)
-	ctx := context.Background()
-	if _, err := k.Client.DeleteCluster(ctx, &pb.DeleteKubernetesClusterRequest{Name: clusterName, Namespace: namespace}); err != nil {
+	ctx := r.Context()
+	res, err := k.Client.DeleteCluster(ctx, &pb.DeleteKubernetesClusterRequest{Name: clusterName, Namespace: namespace})
+	if err != nil {
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		json.NewEncoder(w).Encode("Unable to delete cluster " + clusterName + " | Reason : " + err.Error())
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster deleted successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster deleted successfully", OperationID: res.OperationId})
 	return
 }
 
@@ -275,6 +359,8 @@ func (k *KubernetesRouter) DeleteKubernetesCluster(w http.ResponseWriter, r *htt
 //  responses:
 //    '200':
 //      description: Cluster updated successfully
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
 //    '400':
 //      description: Unable to update cluster
 //    '401':
@@ -295,7 +381,7 @@ func (k *KubernetesRouter) UpdateKubernetesCluster(w http.ResponseWriter, r *htt
 	// TODO: get user namespace
 	// get AWS manager
 
-	_, err := k.Client.UpdateCluster(context.Background(), &pb.UpdateKubernetesClusterRequest{
+	res, err := k.Client.UpdateCluster(r.Context(), &pb.UpdateKubernetesClusterRequest{
 		Name:      input.Name,
 		Namespace: "default",
 		Replicas:  input.Replicas,
@@ -313,5 +399,79 @@ func (k *KubernetesRouter) UpdateKubernetesCluster(w http.ResponseWriter, r *htt
 		return
 	}
 
-	json.NewEncoder(w).Encode("Cluster updated successfully")
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster updated successfully", OperationID: res.OperationId})
+}
+
+// UpgradeKubernetesCluster - upgrade the kubernetes version of a cluster
+// swagger:operation POST /api/v1/admin/kubernetes/clusters/{namespace}/{name}/upgrade admin kubernetes
+// ---
+//  summary: Upgrade the kubernetes version of a workload cluster
+//  description: Upgrades the control plane and then the workers, the phase of the cluster is Upgrading until both are done
+//  operationId: upgradeClusters
+//  produces:
+//    - application/json
+//  parameters:
+//   - name: namespace
+//     in: path
+//     description: Namespace of the cluster
+//     required: true
+//     type: string
+//   - name: name
+//     in: path
+//     description: Name of the cluster
+//     required: true
+//     type: string
+//   - name: body
+//     in: body
+//     description: Request body for upgrading workload cluster
+//     required: true
+//     schema:
+//	     $ref: '#/definitions/UpgradeKubernetesClusterRequest'
+//  responses:
+//    '200':
+//      description: Cluster upgrade started
+//      schema:
+//         $ref: '#/definitions/OperationResponse'
+//    '400':
+//      description: Bad request, e.g. the version skips a minor version
+//    '401':
+//      description: Unauthorized
+//    '404':
+//      description: cluster not found
+//    '500':
+//      description: Unable to upgrade cluster
+func (k *KubernetesRouter) UpgradeKubernetesCluster(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	vars := mux.Vars(r)
+	namespace := vars["namespace"]
+	clusterName := vars["name"]
+
+	var input requests.UpgradeKubernetesClusterRequest
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		json.NewEncoder(w).Encode("Bad request" + "| Reason : " + err.Error())
+		return
+	}
+
+	res, err := k.Client.UpgradeCluster(r.Context(), &pb.UpgradeKubernetesClusterRequest{
+		Name:        clusterName,
+		Namespace:   namespace,
+		KubeVersion: input.KubeVersion,
+	})
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.NotFound:
+			code = http.StatusNotFound
+		}
+		w.WriteHeader(code)
+		json.NewEncoder(w).Encode("Unable to upgrade cluster " + clusterName + " | Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	json.NewEncoder(w).Encode(responses.OperationResponse{Message: "Cluster upgrade started", OperationID: res.OperationId})
 }
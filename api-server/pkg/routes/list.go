@@ -0,0 +1,72 @@
+package routes
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+)
+
+var errInvalidLimit = errors.New("limit must be a number of 0 or more")
+
+// listQuery is the query of the list routes, the fields of ListClustersRequest and ListDatabasesRequest
+type listQuery struct {
+	namespace     string
+	labelSelector string
+	provider      string
+	region        string
+	phase         string
+	cluster       string
+	sortBy        string
+	limit         int64
+	continueToken string
+}
+
+func parseListQuery(r *http.Request) (listQuery, error) {
+	query := r.URL.Query()
+	q := listQuery{
+		namespace:     query.Get("namespace"),
+		labelSelector: query.Get("labelSelector"),
+		provider:      query.Get("provider"),
+		region:        query.Get("region"),
+		phase:         query.Get("phase"),
+		cluster:       query.Get("cluster"),
+		sortBy:        query.Get("sortBy"),
+		continueToken: query.Get("continue"),
+	}
+	if limit := query.Get("limit"); limit != "" {
+		var err error
+		if q.limit, err = strconv.ParseInt(limit, 10, 64); err != nil || q.limit < 0 {
+			return q, errInvalidLimit
+		}
+	}
+	return q, nil
+}
+
+func (q listQuery) clusters() *pbv2.ListClustersRequest {
+	return &pbv2.ListClustersRequest{
+		Namespace:     q.namespace,
+		LabelSelector: q.labelSelector,
+		Provider:      q.provider,
+		Region:        q.region,
+		Phase:         q.phase,
+		SortBy:        q.sortBy,
+		Limit:         q.limit,
+		Continue:      q.continueToken,
+	}
+}
+
+func (q listQuery) databases() *pbv2.ListDatabasesRequest {
+	return &pbv2.ListDatabasesRequest{
+		Namespace:     q.namespace,
+		LabelSelector: q.labelSelector,
+		Provider:      q.provider,
+		Region:        q.region,
+		Phase:         q.phase,
+		Cluster:       q.cluster,
+		SortBy:        q.sortBy,
+		Limit:         q.limit,
+		Continue:      q.continueToken,
+	}
+}
@@ -0,0 +1,55 @@
+package routes
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+)
+
+func TestListPages(t *testing.T) {
+	g := NewWithT(t)
+	handler, _, _, _ := tenantBackend(t)
+
+	createDatabase(t, handler, alice, "orders")
+	for _, name := range []string{"carts", "payments", "users"} {
+		createDatabase(t, handler, bob, name)
+	}
+
+	// the pages of bob are in the order of the names, another namespace in the query is ignored
+	var names []string
+	query := url.Values{"limit": {"2"}, "namespace": {namespaceOf(t, alice)}}
+	for page := 0; ; page++ {
+		g.Expect(page).To(BeNumerically("<", 2))
+		w := call(handler, bob, http.MethodGet, "/api/v1/aerospike/clusters/?"+query.Encode(), nil)
+		g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+		dbs := v1.AeroDatabaseList{}
+		g.Expect(json.NewDecoder(w.Body).Decode(&dbs)).To(Succeed())
+		for _, db := range dbs.Items {
+			names = append(names, db.Name)
+		}
+		if dbs.Continue == "" {
+			break
+		}
+		query.Set("continue", dbs.Continue)
+	}
+	g.Expect(names).To(Equal([]string{"carts", "payments", "users"}))
+
+	for _, invalid := range []string{"limit=ten", "limit=-1", "sortBy=size", "provider=openstack", "continue=x"} {
+		w := call(handler, bob, http.MethodGet, "/api/v1/aerospike/clusters/?"+invalid, nil)
+		g.Expect(w.Code).To(Equal(http.StatusBadRequest), invalid)
+	}
+
+	// the admin list has the names of a page and the continue of the next one
+	query = url.Values{"sortBy": {"-name"}, "limit": {"1"}, "cluster": {"bob-dedicated"}}
+	w := call(handler, admin, http.MethodGet, "/api/v1/admin/aerospike/clusters?"+query.Encode(), nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	list := responses.NamespacedNameList{}
+	g.Expect(json.NewDecoder(w.Body).Decode(&list)).To(Succeed())
+	g.Expect(list.Items).To(Equal([]v1.NamespacedName{{Name: "users", Namespace: namespaceOf(t, bob)}}))
+	g.Expect(list.Continue).NotTo(BeEmpty())
+}
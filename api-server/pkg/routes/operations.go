@@ -0,0 +1,199 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/gorilla/mux"
+	"google.golang.org/grpc/status"
+)
+
+// OperationsRouter reports the progress of the create, update, upgrade and delete calls, their responses have the id
+// of their operation
+type OperationsRouter struct {
+	Client pb.AerostationOperationsAPIClient
+}
+
+func NewOperationsRouter(client pb.AerostationOperationsAPIClient) *OperationsRouter {
+	return &OperationsRouter{Client: client}
+}
+
+//GetOperations - get the operations of the user
+// swagger:operation GET /api/v1/operations operations operations
+// ---
+//  summary: Get the operations of the user
+//  description: Get the create, update and delete calls of the databases of the user, the operations are kept for 7 days once done
+//  operationId: getOperations
+//  produces:
+//    - application/json
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/Operation'
+//    '401':
+//      description: Unauthorized
+//    '500':
+//      description: Internal server error
+func (o *OperationsRouter) GetOperations(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
+	o.listOperations(ctx, w, namespace)
+}
+
+//GetOperation - get an operation of the user
+// swagger:operation GET /api/v1/operations/{id} operations operations
+// ---
+//  summary: Get an operation of the user
+//  description: Get the progress of a call from the phase and the conditions of the database, its error and its times
+//  operationId: getOperation
+//  produces:
+//    - application/json
+//  parameters:
+//   - name: id
+//     in: path
+//     description: operationId of the response of the call
+//     required: true
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/Operation'
+//    '401':
+//      description: Unauthorized
+//    '404':
+//      description: operation not found
+func (o *OperationsRouter) GetOperation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
+	o.getOperation(ctx, w, namespace, mux.Vars(r)["id"])
+}
+
+//AdminGetOperations - get the operations of every namespace
+// swagger:operation GET /api/v1/admin/operations admin operations
+// ---
+//  summary: Get the operations of every namespace
+//  description: Get the create, update, upgrade and delete calls of the clusters and the databases
+//  operationId: adminGetOperations
+//  produces:
+//    - application/json
+//  parameters:
+//   - name: namespace
+//     in: query
+//     description: Namespace of the operations, every namespace if empty
+//     required: false
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//       type: array
+//       items:
+//         $ref: '#/definitions/Operation'
+//    '401':
+//      description: Unauthorized
+//    '500':
+//      description: Internal server error
+func (o *OperationsRouter) AdminGetOperations(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	o.listOperations(r.Context(), w, r.URL.Query().Get("namespace"))
+}
+
+//AdminGetOperation - get an operation
+// swagger:operation GET /api/v1/admin/operations/{namespace}/{id} admin operations
+// ---
+//  summary: Get an operation
+//  description: Get the progress of a call from the phase and the conditions of the cluster or the database
+//  operationId: adminGetOperation
+//  produces:
+//    - application/json
+//  parameters:
+//   - name: namespace
+//     in: path
+//     description: Namespace of the cluster or the database
+//     required: true
+//     type: string
+//   - name: id
+//     in: path
+//     description: operationId of the response of the call
+//     required: true
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/Operation'
+//    '401':
+//      description: Unauthorized
+//    '404':
+//      description: operation not found
+func (o *OperationsRouter) AdminGetOperation(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	vars := mux.Vars(r)
+	o.getOperation(r.Context(), w, vars["namespace"], vars["id"])
+}
+
+func (o *OperationsRouter) listOperations(ctx context.Context, w http.ResponseWriter, namespace string) {
+	res, err := o.Client.ListOperations(ctx, &pb.ListOperationsRequest{Namespace: namespace})
+	if err != nil {
+		w.WriteHeader(httpCode(err))
+		_ = json.NewEncoder(w).Encode("Unable to get operations | Reason : " + status.Convert(err).Message())
+		return
+	}
+
+	ops := make([]responses.Operation, 0, len(res.Operations))
+	for _, op := range res.Operations {
+		ops = append(ops, operationFromProto(op))
+	}
+	_ = json.NewEncoder(w).Encode(ops)
+}
+
+func (o *OperationsRouter) getOperation(ctx context.Context, w http.ResponseWriter, namespace, id string) {
+	res, err := o.Client.GetOperation(ctx, &pb.GetOperationRequest{Id: id, Namespace: namespace})
+	if err != nil {
+		w.WriteHeader(httpCode(err))
+		_ = json.NewEncoder(w).Encode("Unable to get operation " + id + " | Reason : " + status.Convert(err).Message())
+		return
+	}
+	_ = json.NewEncoder(w).Encode(operationFromProto(res))
+}
+
+func operationFromProto(op *pb.Operation) responses.Operation {
+	return responses.Operation{
+		ID:         op.Id,
+		Namespace:  op.Namespace,
+		Verb:       op.Verb,
+		Kind:       op.Kind,
+		Name:       op.Name,
+		Done:       op.Done,
+		Progress:   op.Progress,
+		Phase:      op.Phase,
+		Message:    op.Message,
+		Error:      op.Error,
+		CreateTime: op.CreateTime,
+		UpdateTime: op.UpdateTime,
+		EndTime:    op.EndTime,
+	}
+}
@@ -0,0 +1,105 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/aerospike/aerostation/api-server/pkg/requests"
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func getOperation(t *testing.T, handler http.Handler, user, id string) responses.Operation {
+	g := NewWithT(t)
+
+	w := call(handler, user, http.MethodGet, "/api/v1/operations/"+id, nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	op := responses.Operation{}
+	g.Expect(json.NewDecoder(w.Body).Decode(&op)).To(Succeed())
+	return op
+}
+
+func TestOperations(t *testing.T) {
+	g := NewWithT(t)
+	handler, _, kube, _ := tenantBackend(t)
+	ctx := context.Background()
+
+	w := call(handler, alice, http.MethodPost, "/api/v1/aerospike/clusters", requests.CreateAerospikeClusterRequest{
+		Region:  "us-east-1",
+		Name:    "orders",
+		Options: v1.DatabaseOptions{Replicas: 2},
+	})
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	created := responses.OperationResponse{}
+	g.Expect(json.NewDecoder(w.Body).Decode(&created)).To(Succeed())
+	g.Expect(created.OperationID).NotTo(BeEmpty())
+
+	op := getOperation(t, handler, alice, created.OperationID)
+	g.Expect(op.Verb).To(Equal(v1.OperationVerbCreate))
+	g.Expect(op.Kind).To(Equal("AeroDatabase"))
+	g.Expect(op.Name).To(Equal("orders"))
+	g.Expect(op.Namespace).To(Equal(namespaceOf(t, alice)))
+	g.Expect(op.Done).To(BeFalse())
+	g.Expect(op.EndTime).To(BeEmpty())
+
+	// the controller deploys the database
+	db := &v1.AeroDatabase{}
+	g.Expect(kube.Get(ctx, client.ObjectKey{Name: "orders", Namespace: namespaceOf(t, alice)}, db)).To(Succeed())
+	db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseDeployed))
+	db.Status.Size, db.Status.ReadyPods = 2, 1
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason, "1/2 pods ready", db.Generation)
+	g.Expect(kube.Status().Update(ctx, db)).To(Succeed())
+
+	op = getOperation(t, handler, alice, created.OperationID)
+	g.Expect(op.Done).To(BeFalse())
+	g.Expect(op.Progress).To(Equal(int32(70)))
+	g.Expect(op.Phase).To(Equal(string(v1.DBPhaseDeployed)))
+	g.Expect(op.Message).To(Equal("PodsNotReady: 1/2 pods ready"))
+
+	db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseRunning))
+	db.Status.ReadyPods = 2
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionTrue, v1.RunningReason, "", db.Generation)
+	g.Expect(kube.Status().Update(ctx, db)).To(Succeed())
+
+	op = getOperation(t, handler, alice, created.OperationID)
+	g.Expect(op.Done).To(BeTrue())
+	g.Expect(op.Progress).To(Equal(int32(100)))
+	g.Expect(op.Error).To(BeEmpty())
+	g.Expect(op.EndTime).NotTo(BeEmpty())
+
+	// the delete is an operation of its own, done once the database is gone
+	w = call(handler, alice, http.MethodDelete, "/api/v1/aerospike/clusters/orders", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	deleted := responses.OperationResponse{}
+	g.Expect(json.NewDecoder(w.Body).Decode(&deleted)).To(Succeed())
+	op = getOperation(t, handler, alice, deleted.OperationID)
+	g.Expect(op.Verb).To(Equal(v1.OperationVerbDelete))
+	g.Expect(op.Done).To(BeTrue())
+
+	// the operations are scoped to the tenant
+	w = call(handler, alice, http.MethodGet, "/api/v1/operations", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	var ops []responses.Operation
+	g.Expect(json.NewDecoder(w.Body).Decode(&ops)).To(Succeed())
+	g.Expect(ops).To(HaveLen(2))
+
+	w = call(handler, bob, http.MethodGet, "/api/v1/operations/"+created.OperationID, nil)
+	g.Expect(w.Code).To(Equal(http.StatusNotFound))
+	w = call(handler, carol, http.MethodGet, "/api/v1/operations", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	g.Expect(json.NewDecoder(w.Body).Decode(&ops)).To(Succeed())
+	g.Expect(ops).To(BeEmpty())
+
+	// the admins see the operations of every tenant
+	w = call(handler, alice, http.MethodGet, "/api/v1/admin/operations", nil)
+	g.Expect(w.Code).To(Equal(http.StatusForbidden))
+	w = call(handler, admin, http.MethodGet, "/api/v1/admin/operations", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	g.Expect(json.NewDecoder(w.Body).Decode(&ops)).To(Succeed())
+	g.Expect(ops).To(HaveLen(2))
+}
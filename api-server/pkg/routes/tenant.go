@@ -0,0 +1,49 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tenantContext returns the tenant namespace of the authenticated user and the context of the grpc calls made for
+// it, the capi-api rejects the calls outside of the namespace
+func tenantContext(r *http.Request) (context.Context, string, error) {
+	user := auth.User(r)
+	if user == nil {
+		return nil, "", tenant.ErrNoTenant
+	}
+
+	namespace, err := tenant.Namespace(user.GetUserName(), authn.IdentityOf(user))
+	if err != nil {
+		return nil, "", err
+	}
+	return tenant.NewOutgoingContext(r.Context(), namespace), namespace, nil
+}
+
+func writeNoTenant(w http.ResponseWriter, err error) {
+	w.WriteHeader(http.StatusUnauthorized)
+	_ = json.NewEncoder(w).Encode("Unauthorized | Reason : " + err.Error())
+}
+
+// httpCode maps the errors of the calls made for a tenant, calls outside of its namespace are forbidden and expired
+// continue tokens are gone
+func httpCode(err error) int {
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		return http.StatusBadRequest
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.OutOfRange:
+		return http.StatusGone
+	}
+	return http.StatusInternalServerError
+}
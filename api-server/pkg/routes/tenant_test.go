@@ -0,0 +1,331 @@
+package routes
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	"github.com/aerospike/aerostation/api-server/pkg/requests"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/operations"
+	"github.com/aerospike/aerostation/capi-api/pkg/servers"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/authz"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"github.com/gorilla/mux"
+	. "github.com/onsi/gomega"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const (
+	alice = "alice"
+	bob   = "bob"
+	carol = "carol"
+	admin = "admin"
+)
+
+func cluster(name string, labels map[string]string) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: v1.AeroClusterManagerSpec{
+			ClusterOptions: v1.ClusterOptions{
+				Provider:   v1.ProviderEKS,
+				EKSOptions: &v1.EKSOptions{Region: "us-east-1"},
+			},
+		},
+		Status: v1.AeroClusterManagerStatus{Phase: string(v1.ManagerPhaseProvisioned)},
+	}
+}
+
+func namespaceOf(t *testing.T, user string) string {
+	// the users of the tests are htpasswd users
+	namespace, err := tenant.Namespace(user, authn.Identity(authn.AuthenticatorHtpasswd, "", user))
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	return namespace
+}
+
+// tenantBackend serves the capi-api over a fake management cluster with a shared cluster and a cluster dedicated to
+// bob, and returns the user routes of the api-server and the audit log. alice and bob are tenant admins, carol is a
+// tenant viewer and admin is a platform admin.
+func tenantBackend(t *testing.T) (http.Handler, *grpc.ClientConn, client.Client, *bytes.Buffer) {
+	handler, conn, kube, audit, _ := watchBackend(t)
+	return handler, conn, kube, audit
+}
+
+// watchBackend is tenantBackend with the broadcaster of the database watches, it is synced by the test
+func watchBackend(t *testing.T) (http.Handler, *grpc.ClientConn, client.Client, *bytes.Buffer, *watch.Broadcaster) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	kube := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		cluster("shared", map[string]string{v1.PlacementLabel: v1.PlacementShared}),
+		cluster("bob-dedicated", map[string]string{v1.PlacementLabel: v1.PlacementDedicated, v1.TenantLabel: namespaceOf(t, bob)}),
+	).Build()
+
+	htpasswd := filepath.Join(t.TempDir(), "htpasswd")
+	var users []byte
+	for _, user := range []string{alice, bob, carol, admin} {
+		hash, err := bcrypt.GenerateFromPassword([]byte(user+"-password"), bcrypt.MinCost)
+		g.Expect(err).NotTo(HaveOccurred())
+		users = append(users, []byte(user+":"+string(hash)+"\n")...)
+	}
+	g.Expect(ioutil.WriteFile(htpasswd, users, 0600)).To(Succeed())
+	g.Expect(SetupGoGuardian(context.Background(), authn.Config{
+		Htpasswd: htpasswd,
+		Roles:    map[string][]string{admin: {authz.RolePlatformAdmin}},
+	})).To(Succeed())
+
+	policy := authz.DefaultPolicy()
+	policy.DefaultRoles = nil
+	policy.Users = map[string][]string{
+		alice: {authz.RoleTenantAdmin},
+		bob:   {authz.RoleTenantAdmin},
+		carol: {authz.RoleTenantViewer},
+	}
+	audit := &bytes.Buffer{}
+	SetupAuthorization(authz.NewAuthorizer(policy, authz.NewAuditLog(audit)))
+	authorization := servers.NewAuthorization(strategy, authorizer)
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authorization.UnaryInterceptor, servers.TenantUnaryInterceptor),
+		grpc.ChainStreamInterceptor(authorization.StreamInterceptor, servers.TenantStreamInterceptor),
+	)
+	pb.RegisterAerostationKubernetesAPIServer(server, servers.NewKubernetesServer(kube))
+	databases := watch.NewBroadcaster(watch.DefaultHistorySize)
+	aerospike := servers.NewAerospikeServer(kube)
+	aerospike.Databases = databases
+	pb.RegisterAerostationAerospikeAPIServer(server, aerospike)
+	pb.RegisterAerostationOperationsAPIServer(server, servers.NewOperationsServer(operations.NewTracker(kube)))
+	pbv2.RegisterAerostationAerospikeAPIServer(server, servers.NewAerospikeServerV2(kube))
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.Dial("bufnet", grpc.WithInsecure(), grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}), grpc.WithChainUnaryInterceptor(authn.UnaryClientInterceptor), grpc.WithChainStreamInterceptor(authn.StreamClientInterceptor))
+	g.Expect(err).NotTo(HaveOccurred())
+	t.Cleanup(func() { _ = conn.Close() })
+
+	aeroRouter := NewAerospikeRouter(pb.NewAerostationAerospikeAPIClient(conn), pbv2.NewAerostationAerospikeAPIClient(conn),
+		pb.NewAerostationKubernetesAPIClient(conn))
+	aeroAdminRouter := NewAerospikeAdminRouter(pb.NewAerostationAerospikeAPIClient(conn), pbv2.NewAerostationAerospikeAPIClient(conn))
+	operationsRouter := NewOperationsRouter(pb.NewAerostationOperationsAPIClient(conn))
+	router := mux.NewRouter()
+	router.HandleFunc("/api/v1/admin/aerospike/clusters", AuthMiddleware(Authorize(authz.ResourceAdminDatabases, authz.VerbList, http.HandlerFunc(aeroAdminRouter.GetAllAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters/", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbList, http.HandlerFunc(aeroRouter.GetAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters/watch", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbWatch, http.HandlerFunc(aeroRouter.WatchAerospikeClusters)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbGet, http.HandlerFunc(aeroRouter.GetAerospikeCluster)))).Methods("GET")
+	router.HandleFunc("/api/v1/aerospike/clusters", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbCreate, http.HandlerFunc(aeroRouter.CreateAerospikeCluster)))).Methods("POST")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbDelete, http.HandlerFunc(aeroRouter.DeleteAerospikeCluster)))).Methods("DELETE")
+	router.HandleFunc("/api/v1/aerospike/clusters/{name}", AuthMiddleware(Authorize(authz.ResourceDatabases, authz.VerbUpdate, http.HandlerFunc(aeroRouter.UpdateAerospikeCluster)))).Methods("PATCH")
+	router.HandleFunc("/api/v1/admin/operations", AuthMiddleware(Authorize(authz.ResourceAdminOperations, authz.VerbList, http.HandlerFunc(operationsRouter.AdminGetOperations)))).Methods("GET")
+	router.HandleFunc("/api/v1/operations", AuthMiddleware(Authorize(authz.ResourceOperations, authz.VerbList, http.HandlerFunc(operationsRouter.GetOperations)))).Methods("GET")
+	router.HandleFunc("/api/v1/operations/{id}", AuthMiddleware(Authorize(authz.ResourceOperations, authz.VerbGet, http.HandlerFunc(operationsRouter.GetOperation)))).Methods("GET")
+
+	return router, conn, kube, audit, databases
+}
+
+func call(handler http.Handler, user, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var payload bytes.Buffer
+	if body != nil {
+		_ = json.NewEncoder(&payload).Encode(body)
+	}
+	r := httptest.NewRequest(method, path, &payload)
+	if user != "" {
+		r.SetBasicAuth(user, user+"-password")
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+// credentials returns a context forwarding the basic credentials of a user to the capi-api
+func credentials(user string) context.Context {
+	basic := base64.StdEncoding.EncodeToString([]byte(user + ":" + user + "-password"))
+	return authn.WithCredentials(context.Background(), "Basic "+basic)
+}
+
+func createDatabase(t *testing.T, handler http.Handler, user, name string) {
+	w := call(handler, user, http.MethodPost, "/api/v1/aerospike/clusters", requests.CreateAerospikeClusterRequest{
+		Region:  "us-east-1",
+		Name:    name,
+		Options: v1.DatabaseOptions{Replicas: 1},
+	})
+	NewWithT(t).Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+}
+
+func listDatabases(t *testing.T, handler http.Handler, user string) []string {
+	g := NewWithT(t)
+
+	w := call(handler, user, http.MethodGet, "/api/v1/aerospike/clusters/", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+
+	dbs := v1.AeroDatabaseList{}
+	g.Expect(json.NewDecoder(w.Body).Decode(&dbs)).To(Succeed())
+	var names []string
+	for _, db := range dbs.Items {
+		g.Expect(db.Namespace).To(Equal(namespaceOf(t, user)))
+		names = append(names, db.Name)
+	}
+	return names
+}
+
+func TestTenantIsolation(t *testing.T) {
+	g := NewWithT(t)
+	handler, _, kube, _ := tenantBackend(t)
+
+	createDatabase(t, handler, alice, "orders")
+	createDatabase(t, handler, bob, "payments")
+	// the names of the databases are per tenant
+	createDatabase(t, handler, bob, "orders")
+
+	g.Expect(listDatabases(t, handler, alice)).To(ConsistOf("orders"))
+	g.Expect(listDatabases(t, handler, bob)).To(ConsistOf("orders", "payments"))
+
+	// the databases land in the tenant namespaces, which are created on the way
+	db := &v1.AeroDatabase{}
+	g.Expect(kube.Get(context.Background(), client.ObjectKey{Name: "payments", Namespace: namespaceOf(t, bob)}, db)).To(Succeed())
+	g.Expect(db.Spec.Cluster.Name).To(Equal("bob-dedicated"))
+	namespace := &corev1.Namespace{}
+	g.Expect(kube.Get(context.Background(), client.ObjectKey{Name: namespaceOf(t, alice)}, namespace)).To(Succeed())
+	g.Expect(namespace.Labels).To(HaveKeyWithValue(v1.TenantLabel, namespaceOf(t, alice)))
+	// on the shared cluster the database is in the tenant namespace of the workload cluster
+	g.Expect(kube.Get(context.Background(), client.ObjectKey{Name: "orders", Namespace: namespaceOf(t, alice)}, db)).To(Succeed())
+	g.Expect(db.Spec.Cluster.Name).To(Equal("shared"))
+	g.Expect(db.Spec.TargetNamespace).To(Equal(namespaceOf(t, alice)))
+
+	// alice does not see the databases of bob
+	w := call(handler, alice, http.MethodGet, "/api/v1/aerospike/clusters/payments", nil)
+	g.Expect(w.Code).To(Equal(http.StatusNotFound))
+	w = call(handler, alice, http.MethodPatch, "/api/v1/aerospike/clusters/payments", v1.DatabaseOptions{Replicas: 3})
+	g.Expect(w.Code).NotTo(Equal(http.StatusOK))
+	w = call(handler, alice, http.MethodDelete, "/api/v1/aerospike/clusters/payments", nil)
+	g.Expect(w.Code).NotTo(Equal(http.StatusOK))
+
+	// alice deleting orders leaves the database of bob with the same name alone
+	w = call(handler, alice, http.MethodDelete, "/api/v1/aerospike/clusters/orders", nil)
+	g.Expect(w.Code).To(Equal(http.StatusOK), w.Body.String())
+	g.Expect(listDatabases(t, handler, alice)).To(BeEmpty())
+	g.Expect(listDatabases(t, handler, bob)).To(ConsistOf("orders", "payments"))
+	g.Expect(kube.Get(context.Background(), client.ObjectKey{Name: "payments", Namespace: namespaceOf(t, bob)}, db)).To(Succeed())
+	g.Expect(db.Spec.Options.Replicas).To(Equal(int32(1)))
+}
+
+func TestTenantUnauthenticated(t *testing.T) {
+	handler, _, _, _ := tenantBackend(t)
+
+	w := call(handler, "", http.MethodGet, "/api/v1/aerospike/clusters/", nil)
+	NewWithT(t).Expect(w.Code).To(Equal(http.StatusUnauthorized))
+}
+
+func TestTenantCrossNamespaceCalls(t *testing.T) {
+	handler, conn, _, _ := tenantBackend(t)
+	createDatabase(t, handler, bob, "payments")
+
+	// a caller that asks for the namespace of another tenant is rejected by the capi-api
+	ctx := tenant.NewOutgoingContext(credentials(alice), namespaceOf(t, alice))
+	bobs := namespaceOf(t, bob)
+	aerospike := pb.NewAerostationAerospikeAPIClient(conn)
+	aerospikeV2 := pbv2.NewAerostationAerospikeAPIClient(conn)
+	kubernetes := pb.NewAerostationKubernetesAPIClient(conn)
+
+	calls := map[string]func() error{
+		"GetDatabase": func() error {
+			_, err := aerospikeV2.GetDatabase(ctx, &pbv2.GetDatabaseRequest{Name: "payments", Namespace: bobs})
+			return err
+		},
+		"ListDatabases of all namespaces": func() error {
+			_, err := aerospikeV2.ListDatabases(ctx, &pbv2.ListDatabasesRequest{})
+			return err
+		},
+		"GetCluster": func() error {
+			_, err := aerospike.GetCluster(ctx, &pb.GetAerospikeClusterRequest{AerospikeName: "payments", AerospikeNamespace: bobs})
+			return err
+		},
+		"GetAllClusters": func() error {
+			_, err := aerospike.GetAllClusters(ctx, &pb.GetAllAerospikeClustersRequest{})
+			return err
+		},
+		"UpdateCluster": func() error {
+			_, err := aerospike.UpdateCluster(ctx, &pb.UpdateAerospikeClusterRequest{ClusterName: "payments", ClusterNamespace: bobs,
+				Options: &pb.DatabaseOptions{Replicas: 3}})
+			return err
+		},
+		"DeleteCluster": func() error {
+			_, err := aerospike.DeleteCluster(ctx, &pb.DeleteAerospikeClusterRequest{AerospikeName: "payments", AerospikeNamespace: bobs})
+			return err
+		},
+		"CreateCluster in another namespace": func() error {
+			_, err := aerospike.CreateCluster(ctx, &pb.CreateAerospikeClusterRequest{Name: "orders", Namespace: bobs,
+				KubernetesClusterName: "shared", KubernetesClusterNamespace: "default", Options: &pb.DatabaseOptions{Replicas: 1}})
+			return err
+		},
+		"CreateCluster in another namespace of a shared cluster": func() error {
+			_, err := aerospike.CreateCluster(ctx, &pb.CreateAerospikeClusterRequest{Name: "orders", Namespace: namespaceOf(t, alice),
+				TargetNamespace: "kube-system", KubernetesClusterName: "shared", KubernetesClusterNamespace: "default",
+				Options: &pb.DatabaseOptions{Replicas: 1}})
+			return err
+		},
+		"CreateCluster on a dedicated cluster of another tenant": func() error {
+			_, err := aerospike.CreateCluster(ctx, &pb.CreateAerospikeClusterRequest{Name: "orders", Namespace: namespaceOf(t, alice),
+				KubernetesClusterName: "bob-dedicated", KubernetesClusterNamespace: "default", Options: &pb.DatabaseOptions{Replicas: 1}})
+			return err
+		},
+		"MapRegionToCluster": func() error {
+			_, err := kubernetes.MapRegionToCluster(ctx, &pb.MapRegionToClusterRequest{Cloud: "aws", Region: "us-east-1", UserNamespace: bobs})
+			return err
+		},
+		"GetKubeconfig of a dedicated cluster of another tenant": func() error {
+			_, err := kubernetes.GetKubeconfig(ctx, &pb.GetKubeconfigRequest{Name: "bob-dedicated", Namespace: "default", User: bob})
+			return err
+		},
+		"DeleteKubernetesCluster": func() error {
+			_, err := kubernetes.DeleteCluster(ctx, &pb.DeleteKubernetesClusterRequest{Name: "shared", Namespace: "default"})
+			return err
+		},
+		"GetOperation": func() error {
+			_, err := pb.NewAerostationOperationsAPIClient(conn).GetOperation(ctx, &pb.GetOperationRequest{Id: "op-1", Namespace: bobs})
+			return err
+		},
+		"ListOperations of all namespaces": func() error {
+			_, err := pb.NewAerostationOperationsAPIClient(conn).ListOperations(ctx, &pb.ListOperationsRequest{})
+			return err
+		},
+		"WatchDatabases of all namespaces": func() error {
+			stream, err := aerospike.WatchDatabases(ctx, &pb.WatchDatabasesRequest{})
+			if err != nil {
+				return err
+			}
+			_, err = stream.Recv()
+			return err
+		},
+	}
+
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			NewWithT(t).Expect(status.Code(call())).To(Equal(codes.PermissionDenied))
+		})
+	}
+}
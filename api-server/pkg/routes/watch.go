@@ -0,0 +1,284 @@
+package routes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchKubernetesClusters - stream the changes of the workload clusters as server-sent events
+// swagger:operation GET /api/v1/admin/kubernetes/clusters/watch admin kubernetes
+// ---
+//  summary: Watch clusters
+//  description: Streams an ADDED event per cluster followed by the changes of the clusters as server-sent events. The id of every event is a resource version the watch can be resumed from with the Last-Event-ID header, HEARTBEAT events are sent when nothing changes.
+//  operationId: watchClusters
+//  produces:
+//    - text/event-stream
+//  parameters:
+//   - name: namespace
+//     in: query
+//     description: Namespace of the clusters, all namespaces if empty
+//     required: false
+//     type: string
+//   - name: resourceVersion
+//     in: query
+//     description: Resource version to resume from, the Last-Event-ID header takes precedence
+//     required: false
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/ClusterEvent'
+//    '400':
+//      description: Bad request, the resource version is invalid
+//    '401':
+//      description: Unauthorized
+//    '410':
+//      description: the resource version is too old, watch again without one
+//    '503':
+//      description: the watch cache is not ready yet
+func (k *KubernetesRouter) WatchKubernetesClusters(w http.ResponseWriter, r *http.Request) {
+	namespace, resourceVersion, heartbeat := watchParameters(r)
+
+	stream, err := k.Client.WatchClusters(r.Context(), &pb.WatchClustersRequest{
+		Namespace:        namespace,
+		ResourceVersion:  resourceVersion,
+		HeartbeatSeconds: heartbeat,
+	})
+	if err != nil {
+		writeWatchError(w, "clusters", err)
+		return
+	}
+
+	serveEvents(w, "clusters", func() (string, string, interface{}, error) {
+		event, err := stream.Recv()
+		if err != nil {
+			return "", "", nil, err
+		}
+		return event.ResourceVersion, event.Type.String(), responses.ClusterEvent{
+			Type:            event.Type.String(),
+			Name:            event.Name,
+			Namespace:       event.Namespace,
+			ResourceVersion: event.ResourceVersion,
+			Phase:           event.Phase,
+			Conditions:      watchConditions(event.Conditions),
+			KubeVersion:     event.KubeVersion,
+		}, nil
+	})
+}
+
+// WatchAerospikeClusters - stream the changes of the aerospike clusters as server-sent events
+// swagger:operation GET /api/v1/admin/aerospike/clusters/watch admin aerospike
+// ---
+//  summary: Watch aerospike clusters
+//  description: Streams an ADDED event per aerospike cluster followed by the changes of the clusters as server-sent events. The id of every event is a resource version the watch can be resumed from with the Last-Event-ID header, HEARTBEAT events are sent when nothing changes.
+//  operationId: watchAerospikeClusters
+//  produces:
+//    - text/event-stream
+//  parameters:
+//   - name: namespace
+//     in: query
+//     description: Namespace of the aerospike clusters, all namespaces if empty
+//     required: false
+//     type: string
+//   - name: resourceVersion
+//     in: query
+//     description: Resource version to resume from, the Last-Event-ID header takes precedence
+//     required: false
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/DatabaseEvent'
+//    '400':
+//      description: Bad request, the resource version is invalid
+//    '401':
+//      description: Unauthorized
+//    '410':
+//      description: the resource version is too old, watch again without one
+//    '503':
+//      description: the watch cache is not ready yet
+func (a *AerospikeAdminRouter) WatchAerospikeClusters(w http.ResponseWriter, r *http.Request) {
+	namespace, resourceVersion, heartbeat := watchParameters(r)
+	watchDatabases(r.Context(), w, a.Client, &pb.WatchDatabasesRequest{
+		Namespace:        namespace,
+		ResourceVersion:  resourceVersion,
+		HeartbeatSeconds: heartbeat,
+	})
+}
+
+// WatchAerospikeClusters - stream the changes of the aerospike clusters of the user as server-sent events
+// swagger:operation GET /api/v1/aerospike/clusters/watch aerospike aerospike
+// ---
+//  summary: Watch the aerospike clusters of the user
+//  description: Streams an ADDED event per aerospike cluster of the user followed by the changes of the clusters as server-sent events. The id of every event is a resource version the watch can be resumed from with the Last-Event-ID header, HEARTBEAT events are sent when nothing changes.
+//  operationId: watchUserAerospikeClusters
+//  produces:
+//    - text/event-stream
+//  parameters:
+//   - name: resourceVersion
+//     in: query
+//     description: Resource version to resume from, the Last-Event-ID header takes precedence
+//     required: false
+//     type: string
+//  responses:
+//    '200':
+//      description: success
+//      schema:
+//         $ref: '#/definitions/DatabaseEvent'
+//    '400':
+//      description: Bad request, the resource version is invalid
+//    '401':
+//      description: Unauthorized
+//    '410':
+//      description: the resource version is too old, watch again without one
+//    '503':
+//      description: the watch cache is not ready yet
+func (a *AerospikeRouter) WatchAerospikeClusters(w http.ResponseWriter, r *http.Request) {
+	ctx, namespace, err := tenantContext(r)
+	if err != nil {
+		writeNoTenant(w, err)
+		return
+	}
+
+	// the namespace of the query is ignored, the capi-api scopes the watch to the tenant namespace anyway
+	_, resourceVersion, heartbeat := watchParameters(r)
+	watchDatabases(ctx, w, a.Client, &pb.WatchDatabasesRequest{
+		Namespace:        namespace,
+		ResourceVersion:  resourceVersion,
+		HeartbeatSeconds: heartbeat,
+	})
+}
+
+func watchDatabases(ctx context.Context, w http.ResponseWriter, client pb.AerostationAerospikeAPIClient, request *pb.WatchDatabasesRequest) {
+	stream, err := client.WatchDatabases(ctx, request)
+	if err != nil {
+		writeWatchError(w, "aerospike clusters", err)
+		return
+	}
+
+	serveEvents(w, "aerospike clusters", func() (string, string, interface{}, error) {
+		event, err := stream.Recv()
+		if err != nil {
+			return "", "", nil, err
+		}
+		res := responses.DatabaseEvent{
+			Type:            event.Type.String(),
+			Name:            event.Name,
+			Namespace:       event.Namespace,
+			ResourceVersion: event.ResourceVersion,
+			Phase:           event.Phase,
+			Conditions:      watchConditions(event.Conditions),
+			Size:            event.Size,
+			ReadyPods:       event.ReadyPods,
+		}
+		if event.Cluster != nil {
+			res.Cluster = &v1.NamespacedName{Name: event.Cluster.Name, Namespace: event.Cluster.Namespace}
+		}
+		return event.ResourceVersion, event.Type.String(), res, nil
+	})
+}
+
+// watchParameters reads the namespace, the resource version to resume from and the heartbeat interval in seconds
+func watchParameters(r *http.Request) (string, string, int64) {
+	query := r.URL.Query()
+
+	resourceVersion := query.Get("resourceVersion")
+	// set by EventSource when it reconnects
+	if id := r.Header.Get("Last-Event-ID"); id != "" {
+		resourceVersion = id
+	}
+
+	heartbeat, _ := strconv.ParseInt(query.Get("heartbeatSeconds"), 10, 64)
+
+	return query.Get("namespace"), resourceVersion, heartbeat
+}
+
+// serveEvents writes the events returned by recv as server-sent events until the stream ends. The first event is
+// received before the headers are written, so the errors of starting the watch get their status code.
+func serveEvents(w http.ResponseWriter, resource string, recv func() (id, event string, data interface{}, err error)) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		json.NewEncoder(w).Encode("Unable to watch " + resource + " | Reason : streaming is not supported")
+		return
+	}
+
+	id, event, data, err := recv()
+	if err != nil {
+		writeWatchError(w, resource, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(http.StatusOK)
+
+	for {
+		payload, err := json.Marshal(data)
+		if err != nil {
+			fmt.Printf("[ERROR] unable to encode %s event: %v\n", resource, err)
+			return
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", id, event, payload); err != nil {
+			// the client went away
+			return
+		}
+		flusher.Flush()
+
+		if id, event, data, err = recv(); err != nil {
+			if err != io.EOF && status.Code(err) != codes.Canceled {
+				// the status is sent, tell the client why the stream ends
+				fmt.Fprintf(w, "event: ERROR\ndata: %q\n\n", status.Convert(err).Message())
+				flusher.Flush()
+			}
+			return
+		}
+	}
+}
+
+func writeWatchError(w http.ResponseWriter, resource string, err error) {
+	code := http.StatusInternalServerError
+	switch status.Code(err) {
+	case codes.InvalidArgument:
+		code = http.StatusBadRequest
+	case codes.OutOfRange:
+		code = http.StatusGone
+	case codes.Unavailable, codes.Unimplemented:
+		code = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Context-Type", "application/x-www-form-urlencoded")
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode("Unable to watch " + resource + " | Reason : " + status.Convert(err).Message())
+}
+
+func watchConditions(conditions []*pb.Condition) []responses.Condition {
+	out := make([]responses.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, responses.Condition{
+			Type:               c.Type,
+			Status:             c.Status,
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: time.Unix(c.LastTransitionTime, 0).UTC(),
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+package routes
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/aerospike/aerostation/api-server/pkg/responses"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// watchEvents reads the server-sent events of a watch until its first heartbeat
+func watchEvents(t *testing.T, server *httptest.Server, user, path string) []responses.DatabaseEvent {
+	g := NewWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	r, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+path, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	r.SetBasicAuth(user, user+"-password")
+	res, err := server.Client().Do(r)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer res.Body.Close()
+	g.Expect(res.StatusCode).To(Equal(http.StatusOK))
+
+	var events []responses.DatabaseEvent
+	scanner := bufio.NewScanner(res.Body)
+	for scanner.Scan() {
+		data := strings.TrimPrefix(scanner.Text(), "data: ")
+		if data == scanner.Text() {
+			continue
+		}
+		event := responses.DatabaseEvent{}
+		g.Expect(json.Unmarshal([]byte(data), &event)).To(Succeed())
+		if event.Type == "HEARTBEAT" {
+			return events
+		}
+		events = append(events, event)
+	}
+	t.Fatalf("the watch ended before its heartbeat: %v", scanner.Err())
+	return nil
+}
+
+func TestTenantWatch(t *testing.T) {
+	g := NewWithT(t)
+	handler, _, _, _, databases := watchBackend(t)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	database := func(user, name, resourceVersion string) client.Object {
+		return &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{
+			Name: name, Namespace: namespaceOf(t, user), ResourceVersion: resourceVersion,
+		}}
+	}
+	databases.Synced([]client.Object{database(alice, "orders", "1"), database(bob, "payments", "2")})
+	databases.Handler().OnAdd(database(bob, "orders", "3"))
+
+	events := watchEvents(t, server, alice, "/api/v1/aerospike/clusters/watch")
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Name).To(Equal("orders"))
+	g.Expect(events[0].Namespace).To(Equal(namespaceOf(t, alice)))
+
+	// the namespace of the query does not widen the watch
+	events = watchEvents(t, server, alice, "/api/v1/aerospike/clusters/watch?namespace="+namespaceOf(t, bob))
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Namespace).To(Equal(namespaceOf(t, alice)))
+
+	// resuming sends the changes since the resource version
+	events = watchEvents(t, server, bob, "/api/v1/aerospike/clusters/watch?resourceVersion=2")
+	g.Expect(events).To(HaveLen(1))
+	g.Expect(events[0].Name).To(Equal("orders"))
+	g.Expect(events[0].Namespace).To(Equal(namespaceOf(t, bob)))
+
+	// viewers may watch
+	g.Expect(watchEvents(t, server, carol, "/api/v1/aerospike/clusters/watch")).To(BeEmpty())
+
+	w := call(handler, "", http.MethodGet, "/api/v1/aerospike/clusters/watch", nil)
+	g.Expect(w.Code).To(Equal(http.StatusUnauthorized))
+}
@@ -2,8 +2,10 @@ package validation
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/aerospike/aerostation/api-server/pkg/requests"
+	v1 "github.com/aerospike/aerostation/api/v1"
 )
 
 // ValidateCreateAerospikeClusterRequest validates a
@@ -17,5 +19,13 @@ func ValidateCreateAerospikeClusterRequest(request requests.CreateAerospikeClust
 		return errors.New("rame cannot be empty")
 	}
 
+	if request.DatabaseType != "" && !v1.IsValidDatabaseType(request.DatabaseType) {
+		return errors.New("databaseType must be one of " + strings.Join(v1.DatabaseTypes, "/"))
+	}
+
+	if request.Options.Replicas < 0 {
+		return errors.New("options.replicas cannot be negative")
+	}
+
 	return nil
 }
@@ -65,6 +65,11 @@ type ClusterOptions struct {
 	EKSOptions    *EKSOptions    `json:"eksOptions,omitempty"`
 	AKSOptions    *AKSOptions    `json:"aksOptions,omitempty"`
 	GKEOptions    *GKEOptions    `json:"gkeOptions,omitempty"`
+	// Version of the aerospike kubernetes operator, one of the versions of the ako add-on. The default of the catalog
+	// is installed if empty, a change upgrades the operator in place.
+	// required : false
+	// example: 2.0.0
+	OperatorVersion string `json:"operatorVersion,omitempty"`
 }
 
 // swagger:model
@@ -90,6 +95,14 @@ type AeroClusterManagerStatus struct {
 	Phase              string            `json:"phase,omitempty"`
 	AerospikeOperator  ApplicationStatus `json:"aerospikeOperator,omitempty"`
 	PrometheusExporter ApplicationStatus `json:"prometheusExporter,omitempty"`
+	// KubeVersion the control plane and the workers of the cluster run,
+	// it trails spec.clusterOptions.kubeversion while an upgrade is in progress
+	// example: v1.21.2
+	KubeVersion string `json:"kubeVersion,omitempty"`
+	// Conditions the phase is derived from
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 type ClusterPhase string
@@ -99,6 +112,7 @@ const (
 	ManagerPhaseClusterCreating    = ClusterPhase("ClusterCreating")
 	ManagerPhaseOperatorInstalling = ClusterPhase("OperatorInstalling")
 	ManagerPhaseProvisioned        = ClusterPhase("Provisioned")
+	ManagerPhaseUpgrading          = ClusterPhase("Upgrading")
 	ManagerPhaseUnknown            = ClusterPhase("Unknown")
 	ManagerPhaseDeleting           = ClusterPhase("Deleting")
 )
@@ -109,6 +123,8 @@ func (c *AeroClusterManagerStatus) SetTypedPhase(p ClusterPhase) {
 
 type ApplicationStatus struct {
 	Running bool `json:"running,omitempty"`
+	// Version that is installed
+	Version string `json:"version,omitempty"`
 }
 
 // APIEndpoint represents a reachable Kubernetes API endpoint.
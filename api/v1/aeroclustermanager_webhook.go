@@ -0,0 +1,299 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/cluster-api/util/version"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+
+	"github.com/aerospike/aerostation/pkg/bundle"
+)
+
+// log is for logging in this package.
+var aeroclustermanagerlog = logf.Log.WithName("aeroclustermanager-resource")
+
+// Supported values of ClusterOptions.Provider
+const (
+	ProviderDocker = "docker"
+	ProviderEKS    = "eks"
+	ProviderAKS    = "aks"
+	ProviderGKE    = "gke"
+)
+
+// UpgradableProviders can move an existing cluster to a new kubernetes version
+var UpgradableProviders = []string{ProviderDocker, ProviderEKS}
+
+func (r *AeroClusterManager) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aerostation-aerospike-com-v1-aeroclustermanager,mutating=true,failurePolicy=fail,sideEffects=None,groups=aerostation.aerospike.com,resources=aeroclustermanagers,verbs=create;update,versions=v1,name=maeroclustermanager.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &AeroClusterManager{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AeroClusterManager) Default() {
+	aeroclustermanagerlog.Info("default", "name", r.Name)
+
+	if r.Spec.Name == "" {
+		r.Spec.Name = r.Name
+	}
+	if r.Spec.ClusterOptions.Name == "" {
+		r.Spec.ClusterOptions.Name = r.Spec.Name
+	}
+	if r.Spec.ClusterID.Name == "" {
+		r.Spec.ClusterID.Name = r.Spec.ClusterOptions.Name
+	}
+	if r.Spec.ClusterID.Namespace == "" {
+		r.Spec.ClusterID.Namespace = r.Namespace
+	}
+	if r.Spec.ClusterOptions.Provider == ProviderDocker && r.Spec.ClusterOptions.DockerOptions == nil {
+		r.Spec.ClusterOptions.DockerOptions = &DockerOptions{}
+	}
+}
+
+//+kubebuilder:webhook:path=/validate-aerostation-aerospike-com-v1-aeroclustermanager,mutating=false,failurePolicy=fail,sideEffects=None,groups=aerostation.aerospike.com,resources=aeroclustermanagers,verbs=create;update,versions=v1,name=vaeroclustermanager.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AeroClusterManager{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroClusterManager) ValidateCreate() error {
+	aeroclustermanagerlog.Info("validate create", "name", r.Name)
+
+	return r.validate(nil)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroClusterManager) ValidateUpdate(old runtime.Object) error {
+	aeroclustermanagerlog.Info("validate update", "name", r.Name)
+
+	oldManager, ok := old.(*AeroClusterManager)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an AeroClusterManager but got a %T", old))
+	}
+
+	// objects being deleted only lose their finalizers
+	if !r.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	// compare against the defaulted old object, it may predate the defaulting webhook
+	oldManager = oldManager.DeepCopy()
+	oldManager.Default()
+
+	return r.validate(oldManager)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroClusterManager) ValidateDelete() error {
+	return nil
+}
+
+func (r *AeroClusterManager) validate(old *AeroClusterManager) error {
+	// the options of objects that predate a rule are only checked once they change, updates of the finalizers, the
+	// labels or the other fields of the spec go through
+	var allErrs field.ErrorList
+	if old == nil || !equality.Semantic.DeepEqual(r.Spec.ClusterOptions, old.Spec.ClusterOptions) {
+		allErrs = r.Spec.ClusterOptions.validate(field.NewPath("spec", "clusterOptions"))
+	}
+
+	if old != nil {
+		path := field.NewPath("spec")
+		if r.Spec.ClusterOptions.Provider != old.Spec.ClusterOptions.Provider {
+			allErrs = append(allErrs, field.Forbidden(path.Child("clusterOptions", "provider"), "field is immutable"))
+		}
+		if r.Spec.ClusterID != old.Spec.ClusterID {
+			allErrs = append(allErrs, field.Forbidden(path.Child("clusterId"), "field is immutable"))
+		}
+		if r.Spec.ClusterOptions.KubeVersion != old.Spec.ClusterOptions.KubeVersion {
+			allErrs = append(allErrs, r.validateUpgrade(old)...)
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("AeroClusterManager").GroupKind(), r.Name, allErrs)
+}
+
+// validateUpgrade checks that the cluster can move from the kubernetes version it runs to the requested one
+func (r *AeroClusterManager) validateUpgrade(old *AeroClusterManager) field.ErrorList {
+	path := field.NewPath("spec", "clusterOptions", "kubeversion")
+	target := r.Spec.ClusterOptions.KubeVersion
+
+	if !containsString(UpgradableProviders, r.Spec.ClusterOptions.Provider) {
+		return field.ErrorList{field.Forbidden(path, "kubernetes upgrades are not supported for provider "+r.Spec.ClusterOptions.Provider)}
+	}
+	if old.Status.IsUpgrading() {
+		return field.ErrorList{field.Forbidden(path, "an upgrade to "+old.Spec.ClusterOptions.KubeVersion+" is in progress")}
+	}
+
+	// a blocked upgrade leaves the cluster on the version in the status
+	current := old.Spec.ClusterOptions.KubeVersion
+	if old.Status.KubeVersion != "" {
+		current = old.Status.KubeVersion
+	}
+	if err := ValidateKubeVersionUpgrade(current, target); err != nil {
+		return field.ErrorList{field.Invalid(path, target, err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateKubeVersionUpgrade enforces the kubernetes version skew policy on an upgrade,
+// downgrades and major version changes are not supported and minor versions can not be skipped
+func ValidateKubeVersionUpgrade(from, to string) error {
+	current, err := version.ParseMajorMinorPatch(from)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes version %q: %v", from, err)
+	}
+	target, err := version.ParseMajorMinorPatch(to)
+	if err != nil {
+		return fmt.Errorf("invalid kubernetes version %q: %v", to, err)
+	}
+
+	switch {
+	case target.LT(current):
+		return fmt.Errorf("downgrading from %s to %s is not supported", from, to)
+	case target.Major != current.Major:
+		return fmt.Errorf("upgrading from %s to %s changes the major version", from, to)
+	case target.Minor > current.Minor+1:
+		return fmt.Errorf("upgrading from %s to %s skips a minor version, upgrade to v%d.%d first",
+			from, to, current.Major, current.Minor+1)
+	}
+
+	return nil
+}
+
+// validate checks that exactly the options of the provider are set
+func (o *ClusterOptions) validate(path *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if o.OperatorVersion != "" {
+		allErrs = append(allErrs, validateOperatorVersion(path.Child("operatorVersion"), o.OperatorVersion)...)
+	}
+
+	if !version.KubeSemver.MatchString(o.KubeVersion) {
+		allErrs = append(allErrs, field.Invalid(path.Child("kubeversion"), o.KubeVersion, "must be a kubernetes version like v1.21.2"))
+	}
+	if o.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("replicas"), o.Replicas, "must be at least 1"))
+	}
+
+	set := map[string]bool{
+		ProviderDocker: o.DockerOptions != nil,
+		ProviderEKS:    o.EKSOptions != nil,
+		ProviderAKS:    o.AKSOptions != nil,
+		ProviderGKE:    o.GKEOptions != nil,
+	}
+	if _, ok := set[o.Provider]; !ok {
+		allErrs = append(allErrs, field.NotSupported(path.Child("provider"), o.Provider,
+			[]string{ProviderDocker, ProviderEKS, ProviderAKS, ProviderGKE}))
+		return allErrs
+	}
+
+	for _, provider := range []string{ProviderDocker, ProviderEKS, ProviderAKS, ProviderGKE} {
+		optionsPath := path.Child(provider + "Options")
+		if provider == o.Provider && !set[provider] {
+			allErrs = append(allErrs, field.Required(optionsPath, "required for provider "+o.Provider))
+		}
+		if provider != o.Provider && set[provider] {
+			allErrs = append(allErrs, field.Forbidden(optionsPath, "not allowed for provider "+o.Provider))
+		}
+	}
+
+	switch {
+	case o.EKSOptions != nil && o.Provider == ProviderEKS:
+		allErrs = append(allErrs, requireFields(path.Child("eksOptions"), map[string]string{
+			"region":       o.EKSOptions.Region,
+			"instanceType": o.EKSOptions.InstanceType,
+		})...)
+	case o.AKSOptions != nil && o.Provider == ProviderAKS:
+		allErrs = append(allErrs, requireFields(path.Child("aksOptions"), map[string]string{
+			"location":         o.AKSOptions.Location,
+			"vmSize":           o.AKSOptions.VMSize,
+			"resourceGroup":    o.AKSOptions.ResourceGroup,
+			"subscriptionID":   o.AKSOptions.SubscriptionID,
+			"identityRef.name": o.AKSOptions.IdentityRef.Name,
+		})...)
+	case o.GKEOptions != nil && o.Provider == ProviderGKE:
+		allErrs = append(allErrs, requireFields(path.Child("gkeOptions"), map[string]string{
+			"project":     o.GKEOptions.Project,
+			"region":      o.GKEOptions.Region,
+			"machineType": o.GKEOptions.MachineType,
+		})...)
+	}
+
+	return allErrs
+}
+
+// requireFields returns an error for every empty field, sorted by name
+func requireFields(path *field.Path, fields map[string]string) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if fields[name] == "" {
+			allErrs = append(allErrs, field.Required(path.Child(name), ""))
+		}
+	}
+
+	return allErrs
+}
+
+func containsString(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Addons is the add-on bundle the operator versions are validated against, the embedded add-ons by default. The
+// manager and the capi-api set it to the bundle of the management cluster, with its overrides.
+var Addons = bundle.New(nil, "")
+
+// validateOperatorVersion checks that the bundle has the version of the aerospike kubernetes operator
+func validateOperatorVersion(path *field.Path, operatorVersion string) field.ErrorList {
+	versions, err := Addons.Versions(context.Background(), bundle.AddonAKO)
+	if err != nil {
+		return field.ErrorList{field.InternalError(path, err)}
+	}
+	if !containsString(versions, operatorVersion) {
+		return field.ErrorList{field.NotSupported(path, operatorVersion, versions)}
+	}
+	return nil
+}
@@ -0,0 +1,238 @@
+package v1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func eksClusterManager() *AeroClusterManager {
+	return &AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec: AeroClusterManagerSpec{
+			ClusterOptions: ClusterOptions{
+				Provider:    ProviderEKS,
+				KubeVersion: "v1.21.2",
+				Replicas:    3,
+				EKSOptions:  &EKSOptions{Region: "us-east-1", InstanceType: "t3.medium"},
+			},
+		},
+	}
+}
+
+func TestAeroClusterManagerDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	manager := eksClusterManager()
+	manager.Default()
+
+	g.Expect(manager.Spec.Name).To(Equal("demo"))
+	g.Expect(manager.Spec.ClusterOptions.Name).To(Equal("demo"))
+	g.Expect(manager.Spec.ClusterID).To(Equal(NamespacedName{Namespace: "default", Name: "demo"}))
+	g.Expect(manager.Spec.ClusterOptions.DockerOptions).To(BeNil())
+
+	manager = &AeroClusterManager{Spec: AeroClusterManagerSpec{ClusterOptions: ClusterOptions{Provider: ProviderDocker}}}
+	manager.Default()
+	g.Expect(manager.Spec.ClusterOptions.DockerOptions).NotTo(BeNil())
+}
+
+func TestAeroClusterManagerValidateCreate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(*AeroClusterManager)
+		fields []string
+	}{
+		{
+			name:   "valid",
+			modify: func(*AeroClusterManager) {},
+		},
+		{
+			name:   "invalid kubeversion",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.KubeVersion = "1.21" },
+			fields: []string{"spec.clusterOptions.kubeversion"},
+		},
+		{
+			name:   "no replicas",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.Replicas = 0 },
+			fields: []string{"spec.clusterOptions.replicas"},
+		},
+		{
+			name:   "unknown provider",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.Provider = "openstack" },
+			fields: []string{"spec.clusterOptions.provider"},
+		},
+		{
+			name:   "missing provider options",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.EKSOptions = nil },
+			fields: []string{"spec.clusterOptions.eksOptions"},
+		},
+		{
+			name: "options of another provider",
+			modify: func(m *AeroClusterManager) {
+				m.Spec.ClusterOptions.GKEOptions = &GKEOptions{Project: "aerostation-dev"}
+			},
+			fields: []string{"spec.clusterOptions.gkeOptions"},
+		},
+		{
+			name: "missing required fields",
+			modify: func(m *AeroClusterManager) {
+				m.Spec.ClusterOptions.EKSOptions = &EKSOptions{}
+			},
+			fields: []string{"spec.clusterOptions.eksOptions.instanceType", "spec.clusterOptions.eksOptions.region"},
+		},
+		{
+			name:   "operator version of the bundle",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.OperatorVersion = "2.0.0" },
+		},
+		{
+			name:   "unknown operator version",
+			modify: func(m *AeroClusterManager) { m.Spec.ClusterOptions.OperatorVersion = "1.0.0" },
+			fields: []string{"spec.clusterOptions.operatorVersion"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			manager := eksClusterManager()
+			tt.modify(manager)
+			manager.Default()
+
+			g.Expect(invalidFields(manager.ValidateCreate())).To(Equal(tt.fields))
+		})
+	}
+}
+
+func TestAeroClusterManagerValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	// objects created before the webhook was installed are not defaulted
+	old := eksClusterManager()
+
+	manager := eksClusterManager()
+	manager.Default()
+	manager.Spec.ClusterOptions.Replicas = 5
+	g.Expect(manager.ValidateUpdate(old)).To(Succeed())
+
+	manager.Spec.ClusterID.Name = "other"
+	g.Expect(invalidFields(manager.ValidateUpdate(old))).To(Equal([]string{"spec.clusterId"}))
+
+	manager = eksClusterManager()
+	manager.Spec.ClusterOptions.Provider = ProviderAKS
+	manager.Spec.ClusterOptions.EKSOptions = nil
+	manager.Spec.ClusterOptions.AKSOptions = &AKSOptions{
+		Location:       "eastus",
+		VMSize:         "Standard_D2s_v3",
+		ResourceGroup:  "aerostation",
+		SubscriptionID: "00000000-0000-0000-0000-000000000000",
+		IdentityRef:    NamespacedName{Name: "cluster-identity"},
+	}
+	manager.Default()
+	g.Expect(invalidFields(manager.ValidateUpdate(old))).To(Equal([]string{"spec.clusterOptions.provider"}))
+
+	now := metav1.Now()
+	manager.DeletionTimestamp = &now
+	g.Expect(manager.ValidateUpdate(old)).To(Succeed())
+
+	// a legacy object with the options of two providers still gets its finalizers and labels updated, its options
+	// are validated once they change
+	legacy := eksClusterManager()
+	legacy.Spec.ClusterOptions.DockerOptions = &DockerOptions{}
+	manager = legacy.DeepCopy()
+	manager.Default()
+	manager.Finalizers = []string{"aeroclustermanager.aerostation.io"}
+	manager.Labels = map[string]string{"team": "edge"}
+	g.Expect(manager.ValidateUpdate(legacy)).To(Succeed())
+	manager.Spec.ClusterOptions.Replicas = 5
+	g.Expect(invalidFields(manager.ValidateUpdate(legacy))).NotTo(BeEmpty())
+}
+
+// invalidFields returns the fields of an Invalid error in order
+func invalidFields(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	status, ok := err.(apierrors.APIStatus)
+	if !ok || !apierrors.IsInvalid(err) {
+		return []string{err.Error()}
+	}
+
+	var fields []string
+	for _, cause := range status.Status().Details.Causes {
+		fields = append(fields, cause.Field)
+	}
+
+	return fields
+}
+
+func TestValidateKubeVersionUpgrade(t *testing.T) {
+	tests := []struct {
+		from, to string
+		err      string
+	}{
+		{from: "v1.21.2", to: "v1.21.5"},
+		{from: "v1.21.2", to: "v1.22.0"},
+		{from: "v1.21.2", to: "v1.21.1", err: "downgrading from v1.21.2 to v1.21.1 is not supported"},
+		{from: "v1.21.2", to: "v1.23.0", err: "upgrading from v1.21.2 to v1.23.0 skips a minor version, upgrade to v1.22 first"},
+		{from: "v1.21.2", to: "v2.0.0", err: "upgrading from v1.21.2 to v2.0.0 changes the major version"},
+		{from: "v1.21.2", to: "latest", err: `invalid kubernetes version "latest"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.from+"->"+tt.to, func(t *testing.T) {
+			g := NewWithT(t)
+
+			err := ValidateKubeVersionUpgrade(tt.from, tt.to)
+			if tt.err == "" {
+				g.Expect(err).NotTo(HaveOccurred())
+				return
+			}
+			g.Expect(err).To(MatchError(ContainSubstring(tt.err)))
+		})
+	}
+}
+
+func TestAeroClusterManagerValidateUpgrade(t *testing.T) {
+	g := NewWithT(t)
+
+	old := eksClusterManager()
+	old.Default()
+	old.Status.KubeVersion = "v1.21.2"
+
+	manager := old.DeepCopy()
+	manager.Spec.ClusterOptions.KubeVersion = "v1.22.0"
+	g.Expect(manager.ValidateUpdate(old)).To(Succeed())
+
+	manager.Spec.ClusterOptions.KubeVersion = "v1.23.0"
+	g.Expect(invalidFields(manager.ValidateUpdate(old))).To(Equal([]string{"spec.clusterOptions.kubeversion"}))
+
+	// a running upgrade has to finish first
+	upgrading := old.DeepCopy()
+	upgrading.Spec.ClusterOptions.KubeVersion = "v1.22.0"
+	upgrading.Status.SetCondition(KubeVersionUpgradedCondition, metav1.ConditionFalse, UpgradingWorkersReason, "", 1)
+	manager = upgrading.DeepCopy()
+	manager.Spec.ClusterOptions.KubeVersion = "v1.22.1"
+	g.Expect(invalidFields(manager.ValidateUpdate(upgrading))).To(Equal([]string{"spec.clusterOptions.kubeversion"}))
+
+	// a blocked upgrade can be corrected, the skew is checked against the running version
+	blocked := upgrading.DeepCopy()
+	blocked.Spec.ClusterOptions.KubeVersion = "v1.23.0"
+	blocked.Status.SetCondition(KubeVersionUpgradedCondition, metav1.ConditionFalse, UpgradeBlockedReason, "", 1)
+	manager = blocked.DeepCopy()
+	manager.Spec.ClusterOptions.KubeVersion = "v1.22.0"
+	g.Expect(manager.ValidateUpdate(blocked)).To(Succeed())
+
+	gke := &AeroClusterManager{Spec: AeroClusterManagerSpec{ClusterOptions: ClusterOptions{
+		Provider:    ProviderGKE,
+		KubeVersion: "v1.21.2",
+		Replicas:    3,
+		GKEOptions:  &GKEOptions{Project: "aerostation-dev", Region: "us-central1", MachineType: "e2-standard-4"},
+	}}}
+	manager = gke.DeepCopy()
+	manager.Spec.ClusterOptions.KubeVersion = "v1.22.0"
+	g.Expect(invalidFields(manager.ValidateUpdate(gke))).To(Equal([]string{"spec.clusterOptions.kubeversion"}))
+}
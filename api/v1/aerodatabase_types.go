@@ -17,6 +17,7 @@ limitations under the License.
 package v1
 
 import (
+	"github.com/aerospike/aerostation/api/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -64,6 +65,26 @@ type AeroDatabaseStatus struct {
 	// Important: Run "make" to regenerate code after modifying this file
 	Phase     string `json:"phase,omitempty"`
 	LastError string `json:"lastError,omitempty"`
+	// Size of the aerospike cluster reported by the operator
+	// example : 2
+	Size int32 `json:"size,omitempty"`
+	// ReadyPods is the number of aerospike pods passing their readiness checks
+	// example : 2
+	ReadyPods int32 `json:"readyPods,omitempty"`
+	// Image of the aerospike server reported by the operator
+	// example : aerospike/aerospike-server-enterprise:5.6.0.7
+	Image string `json:"image,omitempty"`
+	// OperatorPhase of the remote AerospikeCluster (InProgress/Completed)
+	// example : Completed
+	OperatorPhase string `json:"operatorPhase,omitempty"`
+	// Pods has the aerospike specific status of the pods, keyed by pod name
+	Pods map[string]v1alpha1.AerospikePodStatus `json:"pods,omitempty"`
+	// Monitoring is the exporter of the database, set while monitoring is enabled
+	Monitoring *MonitoringStatus `json:"monitoring,omitempty"`
+	// Conditions the phase is derived from
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
 const (
@@ -72,12 +93,33 @@ const (
 	DBPhaseRunning  = ClusterPhase("Running")
 )
 
+// The operator does not publish a phase for an AerospikeCluster, it is derived
+// from the status the operator has written back.
+const (
+	// AerospikeClusterInProgress the operator has not caught up with the spec yet
+	AerospikeClusterInProgress = "InProgress"
+	// AerospikeClusterCompleted the status reflects the size and image of the spec
+	AerospikeClusterCompleted = "Completed"
+)
+
 type DBPhase string
 
 func (c *AeroDatabaseStatus) SetTypedPhase(p DBPhase) {
 	c.Phase = string(p)
 }
 
+// MonitoringStatus is where prometheus scrapes the exporter of a database
+// swagger:model
+type MonitoringStatus struct {
+	// Monitor is the ServiceMonitor or the PodMonitor of the exporter, empty if the prometheus operator is not
+	// installed on the cluster
+	// example : ServiceMonitor aerospike/demo-db-exporter
+	Monitor string `json:"monitor,omitempty"`
+	// Endpoints are the metrics endpoints of the exporters of the pods
+	// example : ["http://10.244.1.7:9145/metrics"]
+	Endpoints []string `json:"endpoints,omitempty"`
+}
+
 // ClusterKey is the key to the cluster
 // swagger:model
 type ClusterKey struct {
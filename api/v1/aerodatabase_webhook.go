@@ -0,0 +1,164 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+	"regexp"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var aerodatabaselog = logf.Log.WithName("aerodatabase-resource")
+
+// DefaultDatabaseReplicas matches the replication factor of the database templates
+const DefaultDatabaseReplicas = 2
+
+func (r *AeroDatabase) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/mutate-aerostation-aerospike-com-v1-aerodatabase,mutating=true,failurePolicy=fail,sideEffects=None,groups=aerostation.aerospike.com,resources=aerodatabases,verbs=create;update,versions=v1,name=maerodatabase.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Defaulter = &AeroDatabase{}
+
+// Default implements webhook.Defaulter so a webhook will be registered for the type
+func (r *AeroDatabase) Default() {
+	aerodatabaselog.Info("default", "name", r.Name)
+
+	if r.Spec.Name == "" {
+		r.Spec.Name = r.Name
+	}
+	if r.Spec.Namespace == "" {
+		r.Spec.Namespace = r.Namespace
+	}
+	if r.Spec.TargetNamespace == "" {
+		r.Spec.TargetNamespace = r.Spec.Namespace
+	}
+	if r.Spec.Cluster.Namespace == "" {
+		r.Spec.Cluster.Namespace = r.Namespace
+	}
+	if r.Spec.DatabaseType == "" {
+		r.Spec.DatabaseType = DatabaseTypeMemory
+	}
+	if r.Spec.Options.Replicas == 0 {
+		r.Spec.Options.Replicas = DefaultDatabaseReplicas
+	}
+	r.Spec.Options.Monitoring.Default()
+}
+
+//+kubebuilder:webhook:path=/validate-aerostation-aerospike-com-v1-aerodatabase,mutating=false,failurePolicy=fail,sideEffects=None,groups=aerostation.aerospike.com,resources=aerodatabases,verbs=create;update,versions=v1,name=vaerodatabase.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &AeroDatabase{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroDatabase) ValidateCreate() error {
+	aerodatabaselog.Info("validate create", "name", r.Name)
+
+	return r.validate(nil)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroDatabase) ValidateUpdate(old runtime.Object) error {
+	aerodatabaselog.Info("validate update", "name", r.Name)
+
+	oldDatabase, ok := old.(*AeroDatabase)
+	if !ok {
+		return apierrors.NewBadRequest(fmt.Sprintf("expected an AeroDatabase but got a %T", old))
+	}
+
+	// objects being deleted only lose their finalizers
+	if !r.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	// compare against the defaulted old object, it may predate the defaulting webhook
+	oldDatabase = oldDatabase.DeepCopy()
+	oldDatabase.Default()
+
+	return r.validate(oldDatabase)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *AeroDatabase) ValidateDelete() error {
+	return nil
+}
+
+// prometheusDuration is the duration format of the scrape intervals of the prometheus operator
+var prometheusDuration = regexp.MustCompile(`^(0|(([0-9]+)y)?(([0-9]+)w)?(([0-9]+)d)?(([0-9]+)h)?(([0-9]+)m)?(([0-9]+)s)?(([0-9]+)ms)?)$`)
+
+func (r *AeroDatabase) validate(old *AeroDatabase) error {
+	var allErrs field.ErrorList
+	path := field.NewPath("spec")
+
+	if r.Spec.Cluster.Name == "" {
+		allErrs = append(allErrs, field.Required(path.Child("clusterKey", "name"), ""))
+	}
+	if !IsValidDatabaseType(r.Spec.DatabaseType) {
+		allErrs = append(allErrs, field.NotSupported(path.Child("databaseType"), r.Spec.DatabaseType, DatabaseTypes))
+	}
+	if r.Spec.Options.Replicas < 1 {
+		allErrs = append(allErrs, field.Invalid(path.Child("options", "replicas"), r.Spec.Options.Replicas, "must be at least 1"))
+	}
+	if monitoring := r.Spec.Options.Monitoring; monitoring.IsEnabled() {
+		monitoringPath := path.Child("options", "monitoring")
+		if monitoring.MonitorKind != "" && !IsValidMonitorKind(monitoring.MonitorKind) {
+			allErrs = append(allErrs, field.NotSupported(monitoringPath.Child("monitorKind"), monitoring.MonitorKind, MonitorKinds))
+		}
+		// the aerospike server listens on 3000-3003 in the same pod
+		if monitoring.Port < 0 || monitoring.Port > 65535 || (monitoring.Port >= 3000 && monitoring.Port <= 3003) {
+			allErrs = append(allErrs, field.Invalid(monitoringPath.Child("port"), monitoring.Port, "must be a free port of the aerospike pods"))
+		}
+		if monitoring.Interval != "" && !prometheusDuration.MatchString(monitoring.Interval) {
+			allErrs = append(allErrs, field.Invalid(monitoringPath.Child("interval"), monitoring.Interval, "must be a prometheus duration like 30s"))
+		}
+	}
+
+	// Options are the only thing that can be changed after creation
+	if old != nil {
+		immutable := []struct {
+			name    string
+			changed bool
+		}{
+			{"clusterKey", r.Spec.Cluster != old.Spec.Cluster},
+			{"name", r.Spec.Name != old.Spec.Name},
+			{"namespace", r.Spec.Namespace != old.Spec.Namespace},
+			{"targetNamespace", r.Spec.TargetNamespace != old.Spec.TargetNamespace},
+			{"deployClient", r.Spec.DeployClient != old.Spec.DeployClient},
+			{"databaseType", r.Spec.DatabaseType != old.Spec.DatabaseType},
+		}
+		for _, f := range immutable {
+			if f.changed {
+				allErrs = append(allErrs, field.Forbidden(path.Child(f.name), "field is immutable"))
+			}
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+
+	return apierrors.NewInvalid(GroupVersion.WithKind("AeroDatabase").GroupKind(), r.Name, allErrs)
+}
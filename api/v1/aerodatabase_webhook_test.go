@@ -0,0 +1,91 @@
+package v1
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func aeroDatabase() *AeroDatabase {
+	return &AeroDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo-db", Namespace: "default"},
+		Spec: AeroDatabaseSpec{
+			Cluster: ClusterKey{Name: "demo"},
+		},
+	}
+}
+
+func TestAeroDatabaseDefault(t *testing.T) {
+	g := NewWithT(t)
+
+	db := aeroDatabase()
+	db.Default()
+
+	g.Expect(db.Spec.Name).To(Equal("demo-db"))
+	g.Expect(db.Spec.Namespace).To(Equal("default"))
+	g.Expect(db.Spec.TargetNamespace).To(Equal("default"))
+	g.Expect(db.Spec.Cluster).To(Equal(ClusterKey{Name: "demo", Namespace: "default"}))
+	g.Expect(db.Spec.DatabaseType).To(Equal(DatabaseTypeMemory))
+	g.Expect(db.Spec.Options.Replicas).To(Equal(int32(DefaultDatabaseReplicas)))
+	g.Expect(db.Spec.Options.Monitoring).To(BeNil())
+	g.Expect(db.ValidateCreate()).To(Succeed())
+
+	db.Spec.Options.Monitoring = &MonitoringOptions{Enabled: true}
+	db.Default()
+	g.Expect(*db.Spec.Options.Monitoring).To(Equal(MonitoringOptions{
+		Enabled:     true,
+		Image:       DefaultExporterImage,
+		Port:        DefaultExporterPort,
+		MonitorKind: MonitorKindServiceMonitor,
+	}))
+	g.Expect(db.ValidateCreate()).To(Succeed())
+}
+
+func TestAeroDatabaseValidateCreate(t *testing.T) {
+	g := NewWithT(t)
+
+	db := aeroDatabase()
+	db.Spec.Cluster.Name = ""
+	db.Spec.DatabaseType = "disk"
+	db.Spec.Options.Replicas = -1
+	db.Default()
+
+	g.Expect(invalidFields(db.ValidateCreate())).To(Equal([]string{
+		"spec.clusterKey.name",
+		"spec.databaseType",
+		"spec.options.replicas",
+	}))
+
+	db = aeroDatabase()
+	db.Spec.Options.Monitoring = &MonitoringOptions{Enabled: true, Port: 3001, MonitorKind: "Probe", Interval: "30 seconds"}
+	db.Default()
+	g.Expect(invalidFields(db.ValidateCreate())).To(Equal([]string{
+		"spec.options.monitoring.monitorKind",
+		"spec.options.monitoring.port",
+		"spec.options.monitoring.interval",
+	}))
+
+	// a disabled exporter is not validated
+	db.Spec.Options.Monitoring.Enabled = false
+	g.Expect(db.ValidateCreate()).To(Succeed())
+}
+
+func TestAeroDatabaseValidateUpdate(t *testing.T) {
+	g := NewWithT(t)
+
+	old := aeroDatabase()
+
+	db := aeroDatabase()
+	db.Default()
+	db.Spec.Options.Replicas = 4
+	db.Spec.Options.Monitoring = &MonitoringOptions{Enabled: true, Interval: "1m30s"}
+	g.Expect(db.ValidateUpdate(old)).To(Succeed())
+
+	db.Spec.DatabaseType = DatabaseTypeSSD
+	db.Spec.TargetNamespace = "aerospike"
+	g.Expect(invalidFields(db.ValidateUpdate(old))).To(Equal([]string{
+		"spec.targetNamespace",
+		"spec.databaseType",
+	}))
+}
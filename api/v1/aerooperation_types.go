@@ -0,0 +1,104 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// The verbs of the calls tracked by an AeroOperation
+const (
+	OperationVerbCreate  = "create"
+	OperationVerbUpdate  = "update"
+	OperationVerbUpgrade = "upgrade"
+	OperationVerbDelete  = "delete"
+)
+
+// OperationTarget is the object a call changed, it is in the namespace of the operation
+type OperationTarget struct {
+	// Kind of the object, AeroClusterManager or AeroDatabase
+	// example: AeroDatabase
+	Kind string `json:"kind"`
+	// example: my-db
+	Name string `json:"name"`
+	// Generation of the object after the call, the call is done once the status of the object reflects it
+	Generation int64 `json:"generation,omitempty"`
+	// UID of the object after the call, a create or an update fails if the object is replaced
+	UID types.UID `json:"uid,omitempty"`
+}
+
+// AeroOperationSpec is the call an AeroOperation tracks
+type AeroOperationSpec struct {
+	// Verb of the call: create, update, upgrade or delete
+	// example: create
+	Verb string `json:"verb"`
+	// Target is the object the call changed
+	Target OperationTarget `json:"target"`
+}
+
+// AeroOperationStatus is the progress of the call, derived from the phase and the conditions of the target
+type AeroOperationStatus struct {
+	// Done is true once the target reflects the call, or the call failed
+	Done bool `json:"done,omitempty"`
+	// Progress of the call in percent
+	// example: 40
+	Progress int32 `json:"progress,omitempty"`
+	// Phase of the target
+	// example: ClusterCreating
+	Phase string `json:"phase,omitempty"`
+	// Message of the step in progress
+	Message string `json:"message,omitempty"`
+	// Error the call failed with, only set once it is done
+	Error string `json:"error,omitempty"`
+	// UpdateTime is the last time the status changed
+	UpdateTime *metav1.Time `json:"updateTime,omitempty"`
+	// EndTime is the time the call was found done
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Verb",type=string,JSONPath=`.spec.verb`
+//+kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.target.kind`
+//+kubebuilder:printcolumn:name="Target",type=string,JSONPath=`.spec.target.name`
+//+kubebuilder:printcolumn:name="Done",type=boolean,JSONPath=`.status.done`
+//+kubebuilder:printcolumn:name="Progress",type=integer,JSONPath=`.status.progress`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AeroOperation tracks a create, update, upgrade or delete of an AeroClusterManager or an AeroDatabase until the
+// controllers are done with it, like a google.longrunning Operation. The operations outlive their target.
+type AeroOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AeroOperationSpec   `json:"spec,omitempty"`
+	Status AeroOperationStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AeroOperationList contains a list of AeroOperation
+type AeroOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AeroOperation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AeroOperation{}, &AeroOperationList{})
+}
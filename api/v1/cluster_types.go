@@ -17,8 +17,52 @@ type EKSOptions struct {
 	SSHKey string `json:"sshKey,omitempty"`
 }
 
-type AKSOptions struct{}
+// AKSOptions input for creating workload cluster on AKS
+// swagger:model
+type AKSOptions struct {
+	// Location (azure region) of workload cluster
+	// required : true
+	// example: eastus
+	Location string `json:"location,omitempty"`
+	// Size of the virtual machines of the node pool
+	// required : true
+	// example: Standard_D2s_v3
+	VMSize string `json:"vmSize,omitempty"`
+	// Resource group the managed cluster is created in
+	// required : true
+	// example: aerostation
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+	// Subscription the managed cluster is billed to
+	// required : true
+	// example: 00000000-0000-0000-0000-000000000000
+	SubscriptionID string `json:"subscriptionID,omitempty"`
+	// Reference to the AzureClusterIdentity used to talk to azure
+	// required : true
+	IdentityRef NamespacedName `json:"identityRef,omitempty"`
+	// Base64 encoded ssh public key installed on the nodes
+	// required : false
+	SSHPublicKey string `json:"sshPublicKey,omitempty"`
+}
 
-type GKEOptions struct{}
+// GKEOptions input for creating workload cluster on GKE
+// swagger:model
+type GKEOptions struct {
+	// GCP project the cluster is created in
+	// required : true
+	// example: aerostation-dev
+	Project string `json:"project,omitempty"`
+	// Region of workload cluster
+	// required : true
+	// example: us-central1
+	Region string `json:"region,omitempty"`
+	// Machine type of the node pool
+	// required : true
+	// example: e2-standard-4
+	MachineType string `json:"machineType,omitempty"`
+	// Name of the VPC network, created if it does not exist
+	// required : false
+	// example: default
+	Network string `json:"network,omitempty"`
+}
 
 type DockerOptions struct{}
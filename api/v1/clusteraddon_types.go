@@ -0,0 +1,138 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterAddonSpec is an add-on of the bundle installed on a workload cluster
+type ClusterAddonSpec struct {
+	// Cluster is the AeroClusterManager of the workload cluster, in the namespace of the ClusterAddon
+	// example: my-cluster
+	Cluster string `json:"cluster"`
+	// Addon is the name of the add-on in the bundle: calico, cert-manager, ako...
+	// example: ako
+	Addon string `json:"addon"`
+	// Version of the add-on, the default version of the bundle if empty. Changing it upgrades the add-on once its
+	// dependencies are ready.
+	// example: 2.0.0
+	Version string `json:"version,omitempty"`
+	// TargetNamespace the objects of the add-on are applied to, the namespaces of the manifest if empty
+	TargetNamespace string `json:"targetNamespace,omitempty"`
+	// Values the manifest is rendered with, only the add-ons that are templates take values
+	Values map[string]string `json:"values,omitempty"`
+	// DependsOn are the add-ons that have to be ready on the cluster before this one is installed or upgraded, in
+	// addition to the dependencies of the bundle
+	// example: ["cert-manager"]
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// ClusterAddonStatus is the add-on installed on the workload cluster
+type ClusterAddonStatus struct {
+	// Phase is derived from the conditions: Pending, Installing, Upgrading, Installed, Failed or Deleting
+	Phase string `json:"phase,omitempty"`
+	// Version installed on the cluster, it trails the version of the spec while an upgrade is in progress
+	// example: 2.0.0
+	Version string `json:"version,omitempty"`
+	// Source of the installed manifest: embedded, configmap:<namespace>/<name> or oci:<reference>
+	Source string `json:"source,omitempty"`
+	// SHA256 of the installed manifest, before it is rendered
+	SHA256 string `json:"sha256,omitempty"`
+	// Objects is the number of objects of the add-on on the cluster
+	Objects int32 `json:"objects,omitempty"`
+	// AvailableVersions is the catalog of the add-on, the embedded versions and the overrides
+	AvailableVersions []string `json:"availableVersions,omitempty"`
+	// Conditions the phase is derived from
+	// +listType=map
+	// +listMapKey=type
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+type AddonPhase string
+
+const (
+	AddonPhasePending    = AddonPhase("Pending")
+	AddonPhaseInstalling = AddonPhase("Installing")
+	AddonPhaseUpgrading  = AddonPhase("Upgrading")
+	AddonPhaseInstalled  = AddonPhase("Installed")
+	AddonPhaseFailed     = AddonPhase("Failed")
+	AddonPhaseDeleting   = AddonPhase("Deleting")
+)
+
+func (c *ClusterAddonStatus) SetTypedPhase(p AddonPhase) {
+	c.Phase = string(p)
+}
+
+// SetCondition adds or updates the condition, lastTransitionTime only changes with the status
+func (c *ClusterAddonStatus) SetCondition(conditionType string, status metav1.ConditionStatus, reason, message string, generation int64) {
+	meta.SetStatusCondition(&c.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// IsConditionTrue returns true if the condition is present and true
+func (c *ClusterAddonStatus) IsConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.Conditions, conditionType)
+}
+
+// IsReady returns true once the version of the spec is applied and healthy, the dependents of the add-on wait for it
+func (a *ClusterAddon) IsReady() bool {
+	for _, conditionType := range []string{AddonAppliedCondition, AddonHealthyCondition} {
+		condition := meta.FindStatusCondition(a.Status.Conditions, conditionType)
+		if condition == nil || condition.Status != metav1.ConditionTrue || condition.ObservedGeneration != a.Generation {
+			return false
+		}
+	}
+	return true
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:printcolumn:name="Cluster",type=string,JSONPath=`.spec.cluster`
+//+kubebuilder:printcolumn:name="Addon",type=string,JSONPath=`.spec.addon`
+//+kubebuilder:printcolumn:name="Version",type=string,JSONPath=`.status.version`
+//+kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+//+kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ClusterAddon installs, upgrades and removes an add-on of the bundle on the workload cluster of an
+// AeroClusterManager. The add-ons a cluster requires are created by the AeroClusterManager.
+type ClusterAddon struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAddonSpec   `json:"spec,omitempty"`
+	Status ClusterAddonStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterAddonList contains a list of ClusterAddon
+type ClusterAddonList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAddon `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterAddon{}, &ClusterAddonList{})
+}
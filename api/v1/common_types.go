@@ -3,3 +3,20 @@ package v1
 const (
 	PausedAnnotation = "aerostation.io/paused"
 )
+
+const (
+	// PlacementLabel marks the clusters databases are placed on by region, its value is PlacementShared or
+	// PlacementDedicated
+	PlacementLabel = "aerostation.io/placement"
+	// TenantLabel is the user namespace a PlacementDedicated cluster belongs to
+	TenantLabel = "aerostation.io/tenant"
+	// CapacityAnnotation is how many databases are placed on a cluster at most, DefaultCapacity if not set
+	CapacityAnnotation = "aerostation.io/capacity"
+
+	// PlacementShared clusters host the databases of every user
+	PlacementShared = "shared"
+	// PlacementDedicated clusters only host the databases of their tenant
+	PlacementDedicated = "dedicated"
+
+	DefaultCapacity = 10
+)
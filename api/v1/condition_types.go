@@ -0,0 +1,108 @@
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Condition types reported on AeroClusterManager, AeroDatabase and ClusterAddon
+const (
+	// InfrastructureReadyCondition the cloud infrastructure of the cluster is provisioned
+	InfrastructureReadyCondition = "InfrastructureReady"
+	// ControlPlaneReadyCondition the kubernetes control plane of the cluster is reachable
+	ControlPlaneReadyCondition = "ControlPlaneReady"
+	// CNIInstalledCondition pod networking is available on the cluster
+	CNIInstalledCondition = "CNIInstalled"
+	// CertManagerInstalledCondition cert-manager, required by the operator webhooks, is installed
+	CertManagerInstalledCondition = "CertManagerInstalled"
+	// OperatorInstalledCondition the aerospike kubernetes operator is installed
+	OperatorInstalledCondition = "OperatorInstalled"
+	// DatabaseReadyCondition the aerospike database is serving
+	DatabaseReadyCondition = "DatabaseReady"
+	// MonitoringReadyCondition the exporters of the database are ready and prometheus is told to scrape them
+	MonitoringReadyCondition = "MonitoringReady"
+	// DeletionBlockedCondition the object is being deleted but something is holding it back
+	DeletionBlockedCondition = "DeletionBlocked"
+	// KubeVersionUpgradedCondition the control plane and the workers run the requested kubernetes version
+	KubeVersionUpgradedCondition = "KubeVersionUpgraded"
+	// AddonDependenciesReadyCondition the add-ons an add-on depends on are ready on the cluster
+	AddonDependenciesReadyCondition = "DependenciesReady"
+	// AddonAppliedCondition the manifest of the version of the spec is applied to the cluster
+	AddonAppliedCondition = "Applied"
+	// AddonHealthyCondition the workloads and the custom resource definitions of the add-on are ready
+	AddonHealthyCondition = "Healthy"
+)
+
+// Condition reasons
+const (
+	ProvisioningReason              = "Provisioning"
+	ProvisionedReason               = "Provisioned"
+	WaitingForClusterReason         = "WaitingForCluster"
+	WaitingForControlPlaneReason    = "WaitingForControlPlane"
+	ProvidedByInfrastructureReason  = "ProvidedByInfrastructure"
+	InstalledReason                 = "Installed"
+	InstallFailedReason             = "InstallFailed"
+	DeployFailedReason              = "DeployFailed"
+	OperatorInProgressReason        = "OperatorInProgress"
+	PodsNotReadyReason              = "PodsNotReady"
+	RunningReason                   = "Running"
+	DatabasesExistReason            = "DatabasesExist"
+	RemoteDeleteFailedReason        = "RemoteDeleteFailed"
+	NotBlockedReason                = "NotBlocked"
+	UpgradedReason                  = "Upgraded"
+	UpgradingControlPlaneReason     = "UpgradingControlPlane"
+	UpgradingWorkersReason          = "UpgradingWorkers"
+	UpgradeBlockedReason            = "UpgradeBlocked"
+	UpgradingReason                 = "Upgrading"
+	WaitingForDependenciesReason    = "WaitingForDependencies"
+	DependentsExistReason           = "DependentsExist"
+	InvalidSpecReason               = "InvalidSpec"
+	PrometheusOperatorMissingReason = "PrometheusOperatorMissing"
+	IncompatibleCRDReason           = "IncompatibleCRD"
+	ProviderNotInstalledReason      = "ProviderNotInstalled"
+)
+
+// SetCondition adds or updates the condition, lastTransitionTime only changes with the status
+func (c *AeroClusterManagerStatus) SetCondition(conditionType string, status metav1.ConditionStatus, reason, message string, generation int64) {
+	meta.SetStatusCondition(&c.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// IsConditionTrue returns true if the condition is present and true
+func (c *AeroClusterManagerStatus) IsConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.Conditions, conditionType)
+}
+
+// IsUpgrading returns true while the control plane or the workers move to a new kubernetes version
+func (c *AeroClusterManagerStatus) IsUpgrading() bool {
+	condition := meta.FindStatusCondition(c.Conditions, KubeVersionUpgradedCondition)
+	return condition != nil && condition.Status == metav1.ConditionFalse &&
+		(condition.Reason == UpgradingControlPlaneReason || condition.Reason == UpgradingWorkersReason)
+}
+
+// IsOperatorUpgrading returns true while a new version of the operator waits for its dependencies
+func (c *AeroClusterManagerStatus) IsOperatorUpgrading() bool {
+	condition := meta.FindStatusCondition(c.Conditions, OperatorInstalledCondition)
+	return condition != nil && condition.Status == metav1.ConditionFalse && condition.Reason == UpgradingReason
+}
+
+// SetCondition adds or updates the condition, lastTransitionTime only changes with the status
+func (c *AeroDatabaseStatus) SetCondition(conditionType string, status metav1.ConditionStatus, reason, message string, generation int64) {
+	meta.SetStatusCondition(&c.Conditions, metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: generation,
+	})
+}
+
+// IsConditionTrue returns true if the condition is present and true
+func (c *AeroDatabaseStatus) IsConditionTrue(conditionType string) bool {
+	return meta.IsStatusConditionTrue(c.Conditions, conditionType)
+}
@@ -7,4 +7,100 @@ type DatabaseOptions struct {
 	// required : true
 	// example : 2
 	Replicas int32 `json:"replicas,omitempty"`
+	// Monitoring adds the aerospike prometheus exporter to the aerospike pods
+	// required : false
+	Monitoring *MonitoringOptions `json:"monitoring,omitempty"`
+}
+
+// MonitoringOptions of the aerospike prometheus exporter, it runs as a sidecar of the aerospike pods
+// swagger:model
+type MonitoringOptions struct {
+	// Enabled adds the exporter, and a ServiceMonitor or a PodMonitor if the prometheus operator is installed on the
+	// cluster
+	// example : true
+	Enabled bool `json:"enabled,omitempty"`
+	// Image of the exporter
+	// example : aerospike/aerospike-prometheus-exporter:1.4.0
+	Image string `json:"image,omitempty"`
+	// Port the exporter serves its metrics on
+	// example : 9145
+	Port int32 `json:"port,omitempty"`
+	// MonitorKind is the kind of the object that tells prometheus to scrape the exporter, ServiceMonitor or PodMonitor
+	// example : ServiceMonitor
+	MonitorKind string `json:"monitorKind,omitempty"`
+	// Interval of the scrapes, the interval of prometheus if empty
+	// example : 30s
+	Interval string `json:"interval,omitempty"`
+	// Labels of the monitor, the monitor selector of prometheus picks it by them
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// The kinds of monitors of the prometheus operator
+const (
+	MonitorKindServiceMonitor = "ServiceMonitor"
+	MonitorKindPodMonitor     = "PodMonitor"
+)
+
+// MonitorKinds are the kinds of monitors a database can be scraped through
+var MonitorKinds = []string{MonitorKindServiceMonitor, MonitorKindPodMonitor}
+
+// IsValidMonitorKind returns true if the prometheus operator has such a monitor
+func IsValidMonitorKind(kind string) bool {
+	for _, k := range MonitorKinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// The defaults of the monitoring options
+const (
+	DefaultExporterImage = "aerospike/aerospike-prometheus-exporter:1.4.0"
+	DefaultExporterPort  = 9145
+)
+
+// IsEnabled returns true if the exporter is added to the aerospike pods
+func (m *MonitoringOptions) IsEnabled() bool {
+	return m != nil && m.Enabled
+}
+
+// Default sets the image, the port and the monitor kind of an enabled exporter if they are not set
+func (m *MonitoringOptions) Default() {
+	if !m.IsEnabled() {
+		return
+	}
+	if m.Image == "" {
+		m.Image = DefaultExporterImage
+	}
+	if m.Port == 0 {
+		m.Port = DefaultExporterPort
+	}
+	if m.MonitorKind == "" {
+		m.MonitorKind = MonitorKindServiceMonitor
+	}
+}
+
+// Supported values of AeroDatabaseSpec.DatabaseType
+const (
+	// DatabaseTypeMemory keeps all data in memory without persistence
+	DatabaseTypeMemory = "memory"
+	// DatabaseTypeSSD stores data on persistent block devices
+	DatabaseTypeSSD = "ssd"
+	// DatabaseTypePerformance keeps data in memory, persisted to persistent volumes
+	DatabaseTypePerformance = "performance"
+)
+
+// DatabaseTypes are the supported values of AeroDatabaseSpec.DatabaseType
+var DatabaseTypes = []string{DatabaseTypeMemory, DatabaseTypeSSD, DatabaseTypePerformance}
+
+// IsValidDatabaseType returns true if there is a template for the database type
+func IsValidDatabaseType(databaseType string) bool {
+	for _, t := range DatabaseTypes {
+		if t == databaseType {
+			return true
+		}
+	}
+
+	return false
 }
@@ -1,25 +1,53 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net"
 
+	v1 "github.com/aerospike/aerostation/api/v1"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/operations"
+	"github.com/aerospike/aerostation/capi-api/pkg/placement"
 	"github.com/aerospike/aerostation/capi-api/pkg/servers"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
 	"github.com/aerospike/aerostation/cmd/aeroctl/cmd"
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/authz"
+	"github.com/aerospike/aerostation/pkg/bundle"
+	"github.com/aerospike/aerostation/pkg/tlsconfig"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 )
 
 var (
 	tls        = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
-	certFile   = flag.String("cert_file", "", "The TLS cert file")
-	keyFile    = flag.String("key_file", "", "The TLS key file")
+	certFile   = flag.String("cert_file", "", "The TLS cert file, reloaded when it changes")
+	keyFile    = flag.String("key_file", "", "The TLS key file, reloaded when it changes")
 	jsonDBFile = flag.String("json_db_file", "", "A json file containing a list of features")
 	port       = flag.Int("port", 10000, "The server port")
+
+	clientCAFile      = flag.String("client_ca_file", "", "The CA of the client certificates, they are not asked for if empty")
+	requireClientCert = flag.Bool("require_client_cert", false, "Reject the clients without a certificate signed by client_ca_file")
+
+	placementNamespace = flag.String("placement_namespace", "default", "The namespace of the clusters created by placement")
+	placementTemplates = flag.String("placement_templates", "", "A yaml file with the ClusterOptions per provider of the clusters created by placement, none are created if empty")
+	operationsSweep    = flag.Duration("operations_sweep_interval", operations.DefaultSweepInterval, "How often the operations are refreshed and the ones done for longer than their retention deleted")
+	addonsNamespace    = flag.String("addons_namespace", "aerostation-system", "The namespace of the add-on overrides the operator versions of the clusters are validated against")
+
+	authConfigFile  = flag.String("auth_config", "/etc/aerostation/auth/config.yaml", "The authenticators of the credentials the api-server forwards, see pkg/authn")
+	authzPolicyFile = flag.String("authz_policy", "", "The roles of the users, see pkg/authz, the default policy if empty")
+	auditLogFile    = flag.String("audit_log", "", "The file the denied calls are appended to, stdout if empty")
 )
 
 func main() {
@@ -37,32 +65,160 @@ func main() {
 
 	fmt.Println("parsing flags")
 	flag.Parse()
+	v1.Addons = bundle.New(client, *addonsNamespace)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", *port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
-	//var opts []grpc.ServerOption
-	/*
-		if *tls {
-			if *certFile == "" {
-				*certFile = data.Path("x509/server_cert.pem")
-			}
-			if *keyFile == "" {
-				*keyFile = data.Path("x509/server_key.pem")
-			}
-			creds, err := credentials.NewServerTLSFromFile(*certFile, *keyFile)
-			if err != nil {
-				log.Fatalf("Failed to generate credentials %v", err)
-			}
-			opts = []grpc.ServerOption{grpc.Creds(creds)}
+	var opts []grpc.ServerOption
+	if *tls {
+		tlsConfig, err := tlsconfig.Server(tlsconfig.Options{
+			CertFile:          *certFile,
+			KeyFile:           *keyFile,
+			CAFile:            *clientCAFile,
+			RequireClientCert: *requireClientCert,
+		})
+		if err != nil {
+			log.Fatalf("Failed to generate credentials %v", err)
 		}
-	*/
-	//opts = []grpc.ServerOption{grpc.EmptyServerOption{}}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	} else {
+		fmt.Println("Warning: Running in insecure mode")
+	}
+	fmt.Println("starting watch cache")
+	clusters, databases, err := startWatchCache(context.Background(), config)
+	if err != nil {
+		log.Fatalf("failed to start watch cache: %v", err)
+	}
+
+	authorization, err := loadAuthorization(context.Background(), *authConfigFile, *authzPolicyFile, *auditLogFile)
+	if err != nil {
+		log.Fatalf("failed to set up authorization: %v", err)
+	}
+
 	fmt.Println("creating grpc server")
-	grpcServer := grpc.NewServer() //(ops...)
-	pb.RegisterAerostationKubernetesAPIServer(grpcServer, servers.NewKubernetesServer(client))
-	pb.RegisterAerostationAerospikeAPIServer(grpcServer, servers.NewAerospikeServer(client))
+	// the calls are authorized with the credentials of the users, the calls made for a tenant are scoped to its namespace
+	opts = append(opts,
+		grpc.ChainUnaryInterceptor(authorization.UnaryInterceptor, servers.TenantUnaryInterceptor),
+		grpc.ChainStreamInterceptor(authorization.StreamInterceptor, servers.TenantStreamInterceptor),
+	)
+	grpcServer := grpc.NewServer(opts...)
+	kubeServer := servers.NewKubernetesServer(client)
+	kubeServer.Clusters = clusters
+	templates, err := loadPlacementTemplates(*placementTemplates)
+	if err != nil {
+		log.Fatalf("failed to load placement templates: %v", err)
+	}
+	kubeServer.Placer = placement.NewPlacer(client, *placementNamespace, templates)
+	aeroServer := servers.NewAerospikeServer(client)
+	aeroServer.Databases = databases
+	pb.RegisterAerostationKubernetesAPIServer(grpcServer, kubeServer)
+	pb.RegisterAerostationAerospikeAPIServer(grpcServer, aeroServer)
+	tracker := operations.NewTracker(client)
+	go tracker.Run(context.Background(), *operationsSweep)
+	pb.RegisterAerostationOperationsAPIServer(grpcServer, servers.NewOperationsServer(tracker))
+	pbv2.RegisterAerostationKubernetesAPIServer(grpcServer, servers.NewKubernetesServerV2(client))
+	pbv2.RegisterAerostationAerospikeAPIServer(grpcServer, servers.NewAerospikeServerV2(client))
 	if err := grpcServer.Serve(lis); err != nil {
 		panic(err)
 	}
 }
+
+// loadAuthorization returns the interceptors authorizing the calls with the authenticators and the policy of the
+// api-server
+func loadAuthorization(ctx context.Context, authConfig, policy, auditLog string) (*servers.Authorization, error) {
+	config, err := authn.Load(authConfig)
+	if err != nil {
+		return nil, err
+	}
+	strategy, err := authn.NewStrategy(ctx, config)
+	if err != nil {
+		return nil, err
+	}
+	authorizer, err := authz.Load(policy, auditLog)
+	if err != nil {
+		return nil, err
+	}
+	return servers.NewAuthorization(strategy, authorizer), nil
+}
+
+// startWatchCache starts an informer cache of the clusters and databases and returns the broadcasters of the watch
+// RPCs, seeded once the cache has synced
+func startWatchCache(ctx context.Context, config *rest.Config) (*watch.Broadcaster, *watch.Broadcaster, error) {
+	informers, err := cache.New(config, cache.Options{Scheme: cmd.Scheme})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	clusters := watch.NewBroadcaster(watch.DefaultHistorySize)
+	databases := watch.NewBroadcaster(watch.DefaultHistorySize)
+	watched := []struct {
+		broadcaster *watch.Broadcaster
+		object      client.Object
+		list        client.ObjectList
+	}{
+		{clusters, &v1.AeroClusterManager{}, &v1.AeroClusterManagerList{}},
+		{databases, &v1.AeroDatabase{}, &v1.AeroDatabaseList{}},
+	}
+
+	for _, w := range watched {
+		informer, err := informers.GetInformer(ctx, w.object)
+		if err != nil {
+			return nil, nil, err
+		}
+		if lister, ok := informer.(interface{ LastSyncResourceVersion() string }); ok {
+			w.broadcaster.ListResourceVersion = lister.LastSyncResourceVersion
+		}
+		informer.AddEventHandler(w.broadcaster.Handler())
+	}
+
+	go func() {
+		if err := informers.Start(ctx); err != nil {
+			log.Fatalf("watch cache stopped: %v", err)
+		}
+	}()
+	if !informers.WaitForCacheSync(ctx) {
+		return nil, nil, fmt.Errorf("watch cache did not sync")
+	}
+
+	for _, w := range watched {
+		if err := informers.List(ctx, w.list); err != nil {
+			return nil, nil, err
+		}
+		var objects []client.Object
+		if err := meta.EachListItem(w.list, func(obj runtime.Object) error {
+			objects = append(objects, obj.(client.Object))
+			return nil
+		}); err != nil {
+			return nil, nil, err
+		}
+		w.broadcaster.Synced(objects)
+	}
+
+	return clusters, databases, nil
+}
+
+// loadPlacementTemplates reads the cluster templates of placement, e.g.
+//
+//	eks:
+//	  eksOptions:
+//	    instanceType: t3.large
+//	    sshKey: default
+//	  kubeversion: v1.21.2
+//	  replicas: 3
+func loadPlacementTemplates(path string) (map[string]v1.ClusterOptions, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := map[string]v1.ClusterOptions{}
+	if err := yaml.UnmarshalStrict(data, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
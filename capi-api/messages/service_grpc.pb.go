@@ -26,7 +26,11 @@ type AerostationKubernetesAPIClient interface {
 	GetCluster(ctx context.Context, in *GetKubernetesClusterRequest, opts ...grpc.CallOption) (*GetKubernetesClusterResponse, error)
 	GetClusters(ctx context.Context, in *GetKubernetesClustersRequest, opts ...grpc.CallOption) (*GetKubernetesClustersResponse, error)
 	UpdateCluster(ctx context.Context, in *UpdateKubernetesClusterRequest, opts ...grpc.CallOption) (*UpdateKubernetesClusterResponse, error)
+	UpgradeCluster(ctx context.Context, in *UpgradeKubernetesClusterRequest, opts ...grpc.CallOption) (*UpgradeKubernetesClusterResponse, error)
 	DeleteCluster(ctx context.Context, in *DeleteKubernetesClusterRequest, opts ...grpc.CallOption) (*DeleteKubernetesClusterResponse, error)
+	WatchClusters(ctx context.Context, in *WatchClustersRequest, opts ...grpc.CallOption) (AerostationKubernetesAPI_WatchClustersClient, error)
+	// Credentials
+	GetKubeconfig(ctx context.Context, in *GetKubeconfigRequest, opts ...grpc.CallOption) (*GetKubeconfigResponse, error)
 	// Validation
 	IsKubernetesCluster(ctx context.Context, in *IsKubernetesClusterRequest, opts ...grpc.CallOption) (*IsKubernetesClusterResponse, error)
 	// Info
@@ -78,6 +82,15 @@ func (c *aerostationKubernetesAPIClient) UpdateCluster(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *aerostationKubernetesAPIClient) UpgradeCluster(ctx context.Context, in *UpgradeKubernetesClusterRequest, opts ...grpc.CallOption) (*UpgradeKubernetesClusterResponse, error) {
+	out := new(UpgradeKubernetesClusterResponse)
+	err := c.cc.Invoke(ctx, "/messages.AerostationKubernetesAPI/UpgradeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aerostationKubernetesAPIClient) DeleteCluster(ctx context.Context, in *DeleteKubernetesClusterRequest, opts ...grpc.CallOption) (*DeleteKubernetesClusterResponse, error) {
 	out := new(DeleteKubernetesClusterResponse)
 	err := c.cc.Invoke(ctx, "/messages.AerostationKubernetesAPI/DeleteCluster", in, out, opts...)
@@ -87,6 +100,47 @@ func (c *aerostationKubernetesAPIClient) DeleteCluster(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *aerostationKubernetesAPIClient) WatchClusters(ctx context.Context, in *WatchClustersRequest, opts ...grpc.CallOption) (AerostationKubernetesAPI_WatchClustersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AerostationKubernetesAPI_ServiceDesc.Streams[0], "/messages.AerostationKubernetesAPI/WatchClusters", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aerostationKubernetesAPIWatchClustersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AerostationKubernetesAPI_WatchClustersClient interface {
+	Recv() (*ClusterEvent, error)
+	grpc.ClientStream
+}
+
+type aerostationKubernetesAPIWatchClustersClient struct {
+	grpc.ClientStream
+}
+
+func (x *aerostationKubernetesAPIWatchClustersClient) Recv() (*ClusterEvent, error) {
+	m := new(ClusterEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *aerostationKubernetesAPIClient) GetKubeconfig(ctx context.Context, in *GetKubeconfigRequest, opts ...grpc.CallOption) (*GetKubeconfigResponse, error) {
+	out := new(GetKubeconfigResponse)
+	err := c.cc.Invoke(ctx, "/messages.AerostationKubernetesAPI/GetKubeconfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *aerostationKubernetesAPIClient) IsKubernetesCluster(ctx context.Context, in *IsKubernetesClusterRequest, opts ...grpc.CallOption) (*IsKubernetesClusterResponse, error) {
 	out := new(IsKubernetesClusterResponse)
 	err := c.cc.Invoke(ctx, "/messages.AerostationKubernetesAPI/IsKubernetesCluster", in, out, opts...)
@@ -122,7 +176,11 @@ type AerostationKubernetesAPIServer interface {
 	GetCluster(context.Context, *GetKubernetesClusterRequest) (*GetKubernetesClusterResponse, error)
 	GetClusters(context.Context, *GetKubernetesClustersRequest) (*GetKubernetesClustersResponse, error)
 	UpdateCluster(context.Context, *UpdateKubernetesClusterRequest) (*UpdateKubernetesClusterResponse, error)
+	UpgradeCluster(context.Context, *UpgradeKubernetesClusterRequest) (*UpgradeKubernetesClusterResponse, error)
 	DeleteCluster(context.Context, *DeleteKubernetesClusterRequest) (*DeleteKubernetesClusterResponse, error)
+	WatchClusters(*WatchClustersRequest, AerostationKubernetesAPI_WatchClustersServer) error
+	// Credentials
+	GetKubeconfig(context.Context, *GetKubeconfigRequest) (*GetKubeconfigResponse, error)
 	// Validation
 	IsKubernetesCluster(context.Context, *IsKubernetesClusterRequest) (*IsKubernetesClusterResponse, error)
 	// Info
@@ -147,9 +205,18 @@ func (UnimplementedAerostationKubernetesAPIServer) GetClusters(context.Context,
 func (UnimplementedAerostationKubernetesAPIServer) UpdateCluster(context.Context, *UpdateKubernetesClusterRequest) (*UpdateKubernetesClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateCluster not implemented")
 }
+func (UnimplementedAerostationKubernetesAPIServer) UpgradeCluster(context.Context, *UpgradeKubernetesClusterRequest) (*UpgradeKubernetesClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpgradeCluster not implemented")
+}
 func (UnimplementedAerostationKubernetesAPIServer) DeleteCluster(context.Context, *DeleteKubernetesClusterRequest) (*DeleteKubernetesClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
+func (UnimplementedAerostationKubernetesAPIServer) WatchClusters(*WatchClustersRequest, AerostationKubernetesAPI_WatchClustersServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchClusters not implemented")
+}
+func (UnimplementedAerostationKubernetesAPIServer) GetKubeconfig(context.Context, *GetKubeconfigRequest) (*GetKubeconfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetKubeconfig not implemented")
+}
 func (UnimplementedAerostationKubernetesAPIServer) IsKubernetesCluster(context.Context, *IsKubernetesClusterRequest) (*IsKubernetesClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IsKubernetesCluster not implemented")
 }
@@ -245,6 +312,24 @@ func _AerostationKubernetesAPI_UpdateCluster_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AerostationKubernetesAPI_UpgradeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeKubernetesClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationKubernetesAPIServer).UpgradeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.AerostationKubernetesAPI/UpgradeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationKubernetesAPIServer).UpgradeCluster(ctx, req.(*UpgradeKubernetesClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AerostationKubernetesAPI_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKubernetesClusterRequest)
 	if err := dec(in); err != nil {
@@ -263,6 +348,45 @@ func _AerostationKubernetesAPI_DeleteCluster_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AerostationKubernetesAPI_WatchClusters_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchClustersRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AerostationKubernetesAPIServer).WatchClusters(m, &aerostationKubernetesAPIWatchClustersServer{stream})
+}
+
+type AerostationKubernetesAPI_WatchClustersServer interface {
+	Send(*ClusterEvent) error
+	grpc.ServerStream
+}
+
+type aerostationKubernetesAPIWatchClustersServer struct {
+	grpc.ServerStream
+}
+
+func (x *aerostationKubernetesAPIWatchClustersServer) Send(m *ClusterEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _AerostationKubernetesAPI_GetKubeconfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetKubeconfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationKubernetesAPIServer).GetKubeconfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.AerostationKubernetesAPI/GetKubeconfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationKubernetesAPIServer).GetKubeconfig(ctx, req.(*GetKubeconfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AerostationKubernetesAPI_IsKubernetesCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(IsKubernetesClusterRequest)
 	if err := dec(in); err != nil {
@@ -340,10 +464,18 @@ var AerostationKubernetesAPI_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateCluster",
 			Handler:    _AerostationKubernetesAPI_UpdateCluster_Handler,
 		},
+		{
+			MethodName: "UpgradeCluster",
+			Handler:    _AerostationKubernetesAPI_UpgradeCluster_Handler,
+		},
 		{
 			MethodName: "DeleteCluster",
 			Handler:    _AerostationKubernetesAPI_DeleteCluster_Handler,
 		},
+		{
+			MethodName: "GetKubeconfig",
+			Handler:    _AerostationKubernetesAPI_GetKubeconfig_Handler,
+		},
 		{
 			MethodName: "IsKubernetesCluster",
 			Handler:    _AerostationKubernetesAPI_IsKubernetesCluster_Handler,
@@ -357,7 +489,13 @@ var AerostationKubernetesAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AerostationKubernetesAPI_MapRegionToCluster_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchClusters",
+			Handler:       _AerostationKubernetesAPI_WatchClusters_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "service.proto",
 }
 
@@ -371,6 +509,7 @@ type AerostationAerospikeAPIClient interface {
 	GetAllClusters(ctx context.Context, in *GetAllAerospikeClustersRequest, opts ...grpc.CallOption) (*GetAerospikeClustersResponse, error)
 	UpdateCluster(ctx context.Context, in *UpdateAerospikeClusterRequest, opts ...grpc.CallOption) (*UpdateAerospikeClusterResponse, error)
 	DeleteCluster(ctx context.Context, in *DeleteAerospikeClusterRequest, opts ...grpc.CallOption) (*DeleteAerospikeClusterResponse, error)
+	WatchDatabases(ctx context.Context, in *WatchDatabasesRequest, opts ...grpc.CallOption) (AerostationAerospikeAPI_WatchDatabasesClient, error)
 }
 
 type aerostationAerospikeAPIClient struct {
@@ -435,6 +574,38 @@ func (c *aerostationAerospikeAPIClient) DeleteCluster(ctx context.Context, in *D
 	return out, nil
 }
 
+func (c *aerostationAerospikeAPIClient) WatchDatabases(ctx context.Context, in *WatchDatabasesRequest, opts ...grpc.CallOption) (AerostationAerospikeAPI_WatchDatabasesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AerostationAerospikeAPI_ServiceDesc.Streams[0], "/messages.AerostationAerospikeAPI/WatchDatabases", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &aerostationAerospikeAPIWatchDatabasesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AerostationAerospikeAPI_WatchDatabasesClient interface {
+	Recv() (*DatabaseEvent, error)
+	grpc.ClientStream
+}
+
+type aerostationAerospikeAPIWatchDatabasesClient struct {
+	grpc.ClientStream
+}
+
+func (x *aerostationAerospikeAPIWatchDatabasesClient) Recv() (*DatabaseEvent, error) {
+	m := new(DatabaseEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // AerostationAerospikeAPIServer is the server API for AerostationAerospikeAPI service.
 // All implementations must embed UnimplementedAerostationAerospikeAPIServer
 // for forward compatibility
@@ -445,6 +616,7 @@ type AerostationAerospikeAPIServer interface {
 	GetAllClusters(context.Context, *GetAllAerospikeClustersRequest) (*GetAerospikeClustersResponse, error)
 	UpdateCluster(context.Context, *UpdateAerospikeClusterRequest) (*UpdateAerospikeClusterResponse, error)
 	DeleteCluster(context.Context, *DeleteAerospikeClusterRequest) (*DeleteAerospikeClusterResponse, error)
+	WatchDatabases(*WatchDatabasesRequest, AerostationAerospikeAPI_WatchDatabasesServer) error
 	mustEmbedUnimplementedAerostationAerospikeAPIServer()
 }
 
@@ -470,6 +642,9 @@ func (UnimplementedAerostationAerospikeAPIServer) UpdateCluster(context.Context,
 func (UnimplementedAerostationAerospikeAPIServer) DeleteCluster(context.Context, *DeleteAerospikeClusterRequest) (*DeleteAerospikeClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
 }
+func (UnimplementedAerostationAerospikeAPIServer) WatchDatabases(*WatchDatabasesRequest, AerostationAerospikeAPI_WatchDatabasesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchDatabases not implemented")
+}
 func (UnimplementedAerostationAerospikeAPIServer) mustEmbedUnimplementedAerostationAerospikeAPIServer() {
 }
 
@@ -592,6 +767,27 @@ func _AerostationAerospikeAPI_DeleteCluster_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AerostationAerospikeAPI_WatchDatabases_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchDatabasesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AerostationAerospikeAPIServer).WatchDatabases(m, &aerostationAerospikeAPIWatchDatabasesServer{stream})
+}
+
+type AerostationAerospikeAPI_WatchDatabasesServer interface {
+	Send(*DatabaseEvent) error
+	grpc.ServerStream
+}
+
+type aerostationAerospikeAPIWatchDatabasesServer struct {
+	grpc.ServerStream
+}
+
+func (x *aerostationAerospikeAPIWatchDatabasesServer) Send(m *DatabaseEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // AerostationAerospikeAPI_ServiceDesc is the grpc.ServiceDesc for AerostationAerospikeAPI service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -624,6 +820,135 @@ var AerostationAerospikeAPI_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AerostationAerospikeAPI_DeleteCluster_Handler,
 		},
 	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchDatabases",
+			Handler:       _AerostationAerospikeAPI_WatchDatabases_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "service.proto",
+}
+
+// AerostationOperationsAPIClient is the client API for AerostationOperationsAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AerostationOperationsAPIClient interface {
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
+}
+
+type aerostationOperationsAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAerostationOperationsAPIClient(cc grpc.ClientConnInterface) AerostationOperationsAPIClient {
+	return &aerostationOperationsAPIClient{cc}
+}
+
+func (c *aerostationOperationsAPIClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*Operation, error) {
+	out := new(Operation)
+	err := c.cc.Invoke(ctx, "/messages.AerostationOperationsAPI/GetOperation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aerostationOperationsAPIClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, "/messages.AerostationOperationsAPI/ListOperations", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AerostationOperationsAPIServer is the server API for AerostationOperationsAPI service.
+// All implementations must embed UnimplementedAerostationOperationsAPIServer
+// for forward compatibility
+type AerostationOperationsAPIServer interface {
+	GetOperation(context.Context, *GetOperationRequest) (*Operation, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
+	mustEmbedUnimplementedAerostationOperationsAPIServer()
+}
+
+// UnimplementedAerostationOperationsAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAerostationOperationsAPIServer struct {
+}
+
+func (UnimplementedAerostationOperationsAPIServer) GetOperation(context.Context, *GetOperationRequest) (*Operation, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedAerostationOperationsAPIServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
+func (UnimplementedAerostationOperationsAPIServer) mustEmbedUnimplementedAerostationOperationsAPIServer() {
+}
+
+// UnsafeAerostationOperationsAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AerostationOperationsAPIServer will
+// result in compilation errors.
+type UnsafeAerostationOperationsAPIServer interface {
+	mustEmbedUnimplementedAerostationOperationsAPIServer()
+}
+
+func RegisterAerostationOperationsAPIServer(s grpc.ServiceRegistrar, srv AerostationOperationsAPIServer) {
+	s.RegisterService(&AerostationOperationsAPI_ServiceDesc, srv)
+}
+
+func _AerostationOperationsAPI_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationOperationsAPIServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.AerostationOperationsAPI/GetOperation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationOperationsAPIServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AerostationOperationsAPI_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationOperationsAPIServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.AerostationOperationsAPI/ListOperations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationOperationsAPIServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AerostationOperationsAPI_ServiceDesc is the grpc.ServiceDesc for AerostationOperationsAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AerostationOperationsAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "messages.AerostationOperationsAPI",
+	HandlerType: (*AerostationOperationsAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetOperation",
+			Handler:    _AerostationOperationsAPI_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _AerostationOperationsAPI_ListOperations_Handler,
+		},
+	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }
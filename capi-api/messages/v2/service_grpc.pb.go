@@ -0,0 +1,265 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             v3.5.0
+// source: v2/service.proto
+
+package v2
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// AerostationKubernetesAPIClient is the client API for AerostationKubernetesAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AerostationKubernetesAPIClient interface {
+	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*Cluster, error)
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+}
+
+type aerostationKubernetesAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAerostationKubernetesAPIClient(cc grpc.ClientConnInterface) AerostationKubernetesAPIClient {
+	return &aerostationKubernetesAPIClient{cc}
+}
+
+func (c *aerostationKubernetesAPIClient) GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*Cluster, error) {
+	out := new(Cluster)
+	err := c.cc.Invoke(ctx, "/messages.v2.AerostationKubernetesAPI/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aerostationKubernetesAPIClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/messages.v2.AerostationKubernetesAPI/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AerostationKubernetesAPIServer is the server API for AerostationKubernetesAPI service.
+// All implementations must embed UnimplementedAerostationKubernetesAPIServer
+// for forward compatibility
+type AerostationKubernetesAPIServer interface {
+	GetCluster(context.Context, *GetClusterRequest) (*Cluster, error)
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	mustEmbedUnimplementedAerostationKubernetesAPIServer()
+}
+
+// UnimplementedAerostationKubernetesAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAerostationKubernetesAPIServer struct {
+}
+
+func (UnimplementedAerostationKubernetesAPIServer) GetCluster(context.Context, *GetClusterRequest) (*Cluster, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
+}
+func (UnimplementedAerostationKubernetesAPIServer) ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (UnimplementedAerostationKubernetesAPIServer) mustEmbedUnimplementedAerostationKubernetesAPIServer() {
+}
+
+// UnsafeAerostationKubernetesAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AerostationKubernetesAPIServer will
+// result in compilation errors.
+type UnsafeAerostationKubernetesAPIServer interface {
+	mustEmbedUnimplementedAerostationKubernetesAPIServer()
+}
+
+func RegisterAerostationKubernetesAPIServer(s grpc.ServiceRegistrar, srv AerostationKubernetesAPIServer) {
+	s.RegisterService(&AerostationKubernetesAPI_ServiceDesc, srv)
+}
+
+func _AerostationKubernetesAPI_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationKubernetesAPIServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.v2.AerostationKubernetesAPI/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationKubernetesAPIServer).GetCluster(ctx, req.(*GetClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AerostationKubernetesAPI_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationKubernetesAPIServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.v2.AerostationKubernetesAPI/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationKubernetesAPIServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AerostationKubernetesAPI_ServiceDesc is the grpc.ServiceDesc for AerostationKubernetesAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AerostationKubernetesAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "messages.v2.AerostationKubernetesAPI",
+	HandlerType: (*AerostationKubernetesAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetCluster",
+			Handler:    _AerostationKubernetesAPI_GetCluster_Handler,
+		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _AerostationKubernetesAPI_ListClusters_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/service.proto",
+}
+
+// AerostationAerospikeAPIClient is the client API for AerostationAerospikeAPI service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AerostationAerospikeAPIClient interface {
+	GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*Database, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
+}
+
+type aerostationAerospikeAPIClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAerostationAerospikeAPIClient(cc grpc.ClientConnInterface) AerostationAerospikeAPIClient {
+	return &aerostationAerospikeAPIClient{cc}
+}
+
+func (c *aerostationAerospikeAPIClient) GetDatabase(ctx context.Context, in *GetDatabaseRequest, opts ...grpc.CallOption) (*Database, error) {
+	out := new(Database)
+	err := c.cc.Invoke(ctx, "/messages.v2.AerostationAerospikeAPI/GetDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *aerostationAerospikeAPIClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/messages.v2.AerostationAerospikeAPI/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AerostationAerospikeAPIServer is the server API for AerostationAerospikeAPI service.
+// All implementations must embed UnimplementedAerostationAerospikeAPIServer
+// for forward compatibility
+type AerostationAerospikeAPIServer interface {
+	GetDatabase(context.Context, *GetDatabaseRequest) (*Database, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
+	mustEmbedUnimplementedAerostationAerospikeAPIServer()
+}
+
+// UnimplementedAerostationAerospikeAPIServer must be embedded to have forward compatible implementations.
+type UnimplementedAerostationAerospikeAPIServer struct {
+}
+
+func (UnimplementedAerostationAerospikeAPIServer) GetDatabase(context.Context, *GetDatabaseRequest) (*Database, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDatabase not implemented")
+}
+func (UnimplementedAerostationAerospikeAPIServer) ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
+func (UnimplementedAerostationAerospikeAPIServer) mustEmbedUnimplementedAerostationAerospikeAPIServer() {
+}
+
+// UnsafeAerostationAerospikeAPIServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AerostationAerospikeAPIServer will
+// result in compilation errors.
+type UnsafeAerostationAerospikeAPIServer interface {
+	mustEmbedUnimplementedAerostationAerospikeAPIServer()
+}
+
+func RegisterAerostationAerospikeAPIServer(s grpc.ServiceRegistrar, srv AerostationAerospikeAPIServer) {
+	s.RegisterService(&AerostationAerospikeAPI_ServiceDesc, srv)
+}
+
+func _AerostationAerospikeAPI_GetDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationAerospikeAPIServer).GetDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.v2.AerostationAerospikeAPI/GetDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationAerospikeAPIServer).GetDatabase(ctx, req.(*GetDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AerostationAerospikeAPI_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AerostationAerospikeAPIServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/messages.v2.AerostationAerospikeAPI/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AerostationAerospikeAPIServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AerostationAerospikeAPI_ServiceDesc is the grpc.ServiceDesc for AerostationAerospikeAPI service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AerostationAerospikeAPI_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "messages.v2.AerostationAerospikeAPI",
+	HandlerType: (*AerostationAerospikeAPIServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetDatabase",
+			Handler:    _AerostationAerospikeAPI_GetDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _AerostationAerospikeAPI_ListDatabases_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "v2/service.proto",
+}
@@ -0,0 +1,439 @@
+// Package convert maps the api/v1 types onto the typed messages of the v2 services and back.
+package convert
+
+import (
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/api/v1alpha1"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterToProto converts an AeroClusterManager
+func ClusterToProto(manager *v1.AeroClusterManager) *pbv2.Cluster {
+	return &pbv2.Cluster{
+		Metadata: objectMetaToProto(manager.ObjectMeta),
+		Spec:     clusterSpecToProto(manager.Spec),
+		Status:   clusterStatusToProto(manager.Status),
+	}
+}
+
+// ClusterFromProto converts a Cluster back to an AeroClusterManager
+func ClusterFromProto(cluster *pbv2.Cluster) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: objectMetaFromProto(cluster.GetMetadata()),
+		Spec:       clusterSpecFromProto(cluster.GetSpec()),
+		Status:     clusterStatusFromProto(cluster.GetStatus()),
+	}
+}
+
+func clusterSpecToProto(spec v1.AeroClusterManagerSpec) *pbv2.ClusterSpec {
+	return &pbv2.ClusterSpec{
+		Name:           spec.Name,
+		Suspend:        spec.Suspend,
+		ClusterOptions: clusterOptionsToProto(spec.ClusterOptions),
+		ClusterID:      namespacedNameToProto(spec.ClusterID),
+		ControlPlaneEndpoint: &pbv2.APIEndpoint{
+			Host: spec.ControlPlaneEndpoint.Host,
+			Port: spec.ControlPlaneEndpoint.Port,
+		},
+		Managed: spec.Managed,
+	}
+}
+
+func clusterSpecFromProto(spec *pbv2.ClusterSpec) v1.AeroClusterManagerSpec {
+	return v1.AeroClusterManagerSpec{
+		Name:           spec.GetName(),
+		Suspend:        spec.GetSuspend(),
+		ClusterOptions: clusterOptionsFromProto(spec.GetClusterOptions()),
+		ClusterID:      namespacedNameFromProto(spec.GetClusterID()),
+		ControlPlaneEndpoint: v1.APIEndpoint{
+			Host: spec.GetControlPlaneEndpoint().GetHost(),
+			Port: spec.GetControlPlaneEndpoint().GetPort(),
+		},
+		Managed: spec.GetManaged(),
+	}
+}
+
+func clusterOptionsToProto(options v1.ClusterOptions) *pbv2.ClusterOptions {
+	out := &pbv2.ClusterOptions{
+		Name:            options.Name,
+		Provider:        options.Provider,
+		KubeVersion:     options.KubeVersion,
+		Replicas:        options.Replicas,
+		OperatorVersion: options.OperatorVersion,
+	}
+
+	if options.DockerOptions != nil {
+		out.Docker = &pbv2.DockerOptions{}
+	}
+	if eks := options.EKSOptions; eks != nil {
+		out.Eks = &pbv2.EKSOptions{
+			Region:       eks.Region,
+			InstanceType: eks.InstanceType,
+			SSHKey:       eks.SSHKey,
+		}
+	}
+	if aks := options.AKSOptions; aks != nil {
+		out.Aks = &pbv2.AKSOptions{
+			Location:       aks.Location,
+			VMSize:         aks.VMSize,
+			ResourceGroup:  aks.ResourceGroup,
+			SubscriptionID: aks.SubscriptionID,
+			IdentityRef:    namespacedNameToProto(aks.IdentityRef),
+			SSHPublicKey:   aks.SSHPublicKey,
+		}
+	}
+	if gke := options.GKEOptions; gke != nil {
+		out.Gke = &pbv2.GKEOptions{
+			Project:     gke.Project,
+			Region:      gke.Region,
+			MachineType: gke.MachineType,
+			Network:     gke.Network,
+		}
+	}
+
+	return out
+}
+
+func clusterOptionsFromProto(options *pbv2.ClusterOptions) v1.ClusterOptions {
+	out := v1.ClusterOptions{
+		Name:            options.GetName(),
+		Provider:        options.GetProvider(),
+		KubeVersion:     options.GetKubeVersion(),
+		Replicas:        options.GetReplicas(),
+		OperatorVersion: options.GetOperatorVersion(),
+	}
+
+	if options.GetDocker() != nil {
+		out.DockerOptions = &v1.DockerOptions{}
+	}
+	if eks := options.GetEks(); eks != nil {
+		out.EKSOptions = &v1.EKSOptions{
+			Region:       eks.Region,
+			InstanceType: eks.InstanceType,
+			SSHKey:       eks.SSHKey,
+		}
+	}
+	if aks := options.GetAks(); aks != nil {
+		out.AKSOptions = &v1.AKSOptions{
+			Location:       aks.Location,
+			VMSize:         aks.VMSize,
+			ResourceGroup:  aks.ResourceGroup,
+			SubscriptionID: aks.SubscriptionID,
+			IdentityRef:    namespacedNameFromProto(aks.IdentityRef),
+			SSHPublicKey:   aks.SSHPublicKey,
+		}
+	}
+	if gke := options.GetGke(); gke != nil {
+		out.GKEOptions = &v1.GKEOptions{
+			Project:     gke.Project,
+			Region:      gke.Region,
+			MachineType: gke.MachineType,
+			Network:     gke.Network,
+		}
+	}
+
+	return out
+}
+
+func clusterStatusToProto(status v1.AeroClusterManagerStatus) *pbv2.ClusterStatus {
+	return &pbv2.ClusterStatus{
+		Phase:              status.Phase,
+		AerospikeOperator:  applicationStatusToProto(status.AerospikeOperator),
+		PrometheusExporter: applicationStatusToProto(status.PrometheusExporter),
+		KubeVersion:        status.KubeVersion,
+		Conditions:         conditionsToProto(status.Conditions),
+	}
+}
+
+func clusterStatusFromProto(status *pbv2.ClusterStatus) v1.AeroClusterManagerStatus {
+	return v1.AeroClusterManagerStatus{
+		Phase:              status.GetPhase(),
+		AerospikeOperator:  applicationStatusFromProto(status.GetAerospikeOperator()),
+		PrometheusExporter: applicationStatusFromProto(status.GetPrometheusExporter()),
+		KubeVersion:        status.GetKubeVersion(),
+		Conditions:         conditionsFromProto(status.GetConditions()),
+	}
+}
+
+func applicationStatusToProto(status v1.ApplicationStatus) *pbv2.ApplicationStatus {
+	return &pbv2.ApplicationStatus{Running: status.Running, Version: status.Version}
+}
+
+func applicationStatusFromProto(status *pbv2.ApplicationStatus) v1.ApplicationStatus {
+	return v1.ApplicationStatus{Running: status.GetRunning(), Version: status.GetVersion()}
+}
+
+// DatabaseToProto converts an AeroDatabase
+func DatabaseToProto(db *v1.AeroDatabase) *pbv2.Database {
+	return &pbv2.Database{
+		Metadata: objectMetaToProto(db.ObjectMeta),
+		Spec:     databaseSpecToProto(db.Spec),
+		Status:   databaseStatusToProto(db.Status),
+	}
+}
+
+// DatabaseFromProto converts a Database back to an AeroDatabase
+func DatabaseFromProto(db *pbv2.Database) *v1.AeroDatabase {
+	return &v1.AeroDatabase{
+		ObjectMeta: objectMetaFromProto(db.GetMetadata()),
+		Spec:       databaseSpecFromProto(db.GetSpec()),
+		Status:     databaseStatusFromProto(db.GetStatus()),
+	}
+}
+
+func databaseSpecToProto(spec v1.AeroDatabaseSpec) *pbv2.DatabaseSpec {
+	return &pbv2.DatabaseSpec{
+		Cluster:         &pbv2.NamespacedName{Name: spec.Cluster.Name, Namespace: spec.Cluster.Namespace},
+		Name:            spec.Name,
+		Namespace:       spec.Namespace,
+		TargetNamespace: spec.TargetNamespace,
+		DeployClient:    spec.DeployClient,
+		DatabaseType:    spec.DatabaseType,
+		Options: &pbv2.DatabaseOptions{
+			Replicas:   spec.Options.Replicas,
+			Monitoring: monitoringOptionsToProto(spec.Options.Monitoring),
+		},
+	}
+}
+
+func databaseSpecFromProto(spec *pbv2.DatabaseSpec) v1.AeroDatabaseSpec {
+	return v1.AeroDatabaseSpec{
+		Cluster:         v1.ClusterKey{Name: spec.GetCluster().GetName(), Namespace: spec.GetCluster().GetNamespace()},
+		Name:            spec.GetName(),
+		Namespace:       spec.GetNamespace(),
+		TargetNamespace: spec.GetTargetNamespace(),
+		DeployClient:    spec.GetDeployClient(),
+		DatabaseType:    spec.GetDatabaseType(),
+		Options: v1.DatabaseOptions{
+			Replicas:   spec.GetOptions().GetReplicas(),
+			Monitoring: monitoringOptionsFromProto(spec.GetOptions().GetMonitoring()),
+		},
+	}
+}
+
+func monitoringOptionsToProto(options *v1.MonitoringOptions) *pbv2.MonitoringOptions {
+	if options == nil {
+		return nil
+	}
+	return &pbv2.MonitoringOptions{
+		Enabled:     options.Enabled,
+		Image:       options.Image,
+		Port:        options.Port,
+		MonitorKind: options.MonitorKind,
+		Interval:    options.Interval,
+		Labels:      options.Labels,
+	}
+}
+
+func monitoringOptionsFromProto(options *pbv2.MonitoringOptions) *v1.MonitoringOptions {
+	if options == nil {
+		return nil
+	}
+	out := &v1.MonitoringOptions{
+		Enabled:     options.GetEnabled(),
+		Image:       options.GetImage(),
+		Port:        options.GetPort(),
+		MonitorKind: options.GetMonitorKind(),
+		Interval:    options.GetInterval(),
+	}
+	if labels := options.GetLabels(); len(labels) > 0 {
+		out.Labels = labels
+	}
+	return out
+}
+
+func databaseStatusToProto(status v1.AeroDatabaseStatus) *pbv2.DatabaseStatus {
+	out := &pbv2.DatabaseStatus{
+		Phase:         status.Phase,
+		LastError:     status.LastError,
+		Size:          status.Size,
+		ReadyPods:     status.ReadyPods,
+		Image:         status.Image,
+		OperatorPhase: status.OperatorPhase,
+		Conditions:    conditionsToProto(status.Conditions),
+	}
+
+	if monitoring := status.Monitoring; monitoring != nil {
+		out.Monitoring = &pbv2.MonitoringStatus{Monitor: monitoring.Monitor, Endpoints: monitoring.Endpoints}
+	}
+
+	if status.Pods != nil {
+		out.Pods = make(map[string]*pbv2.PodStatus, len(status.Pods))
+		for name, pod := range status.Pods {
+			out.Pods[name] = podStatusToProto(pod)
+		}
+	}
+
+	return out
+}
+
+func databaseStatusFromProto(status *pbv2.DatabaseStatus) v1.AeroDatabaseStatus {
+	out := v1.AeroDatabaseStatus{
+		Phase:         status.GetPhase(),
+		LastError:     status.GetLastError(),
+		Size:          status.GetSize(),
+		ReadyPods:     status.GetReadyPods(),
+		Image:         status.GetImage(),
+		OperatorPhase: status.GetOperatorPhase(),
+		Conditions:    conditionsFromProto(status.GetConditions()),
+	}
+
+	if monitoring := status.GetMonitoring(); monitoring != nil {
+		out.Monitoring = &v1.MonitoringStatus{Monitor: monitoring.GetMonitor(), Endpoints: monitoring.GetEndpoints()}
+	}
+
+	if pods := status.GetPods(); len(pods) > 0 {
+		out.Pods = make(map[string]v1alpha1.AerospikePodStatus, len(pods))
+		for name, pod := range pods {
+			out.Pods[name] = podStatusFromProto(pod)
+		}
+	}
+
+	return out
+}
+
+func podStatusToProto(pod v1alpha1.AerospikePodStatus) *pbv2.PodStatus {
+	return &pbv2.PodStatus{
+		Image:          pod.Image,
+		PodIP:          pod.PodIP,
+		HostInternalIP: pod.HostInternalIP,
+		HostExternalIP: pod.HostExternalIP,
+		PodPort:        int64(pod.PodPort),
+		ServicePort:    pod.ServicePort,
+		Aerospike: &pbv2.AerospikeInstanceSummary{
+			ClusterName:                 pod.Aerospike.ClusterName,
+			NodeID:                      pod.Aerospike.NodeID,
+			RackID:                      int64(pod.Aerospike.RackID),
+			TLSName:                     pod.Aerospike.TLSName,
+			AccessEndpoints:             pod.Aerospike.AccessEndpoints,
+			AlternateAccessEndpoints:    pod.Aerospike.AlternateAccessEndpoints,
+			TLSAccessEndpoints:          pod.Aerospike.TLSAccessEndpoints,
+			TLSAlternateAccessEndpoints: pod.Aerospike.TLSAlternateAccessEndpoints,
+		},
+		InitializedVolumePaths: pod.InitializedVolumePaths,
+		AerospikeConfigHash:    pod.AerospikeConfigHash,
+		NetworkPolicyHash:      pod.NetworkPolicyHash,
+		PodSpecHash:            pod.PodSpecHash,
+	}
+}
+
+func podStatusFromProto(pod *pbv2.PodStatus) v1alpha1.AerospikePodStatus {
+	summary := pod.GetAerospike()
+	return v1alpha1.AerospikePodStatus{
+		Image:          pod.GetImage(),
+		PodIP:          pod.GetPodIP(),
+		HostInternalIP: pod.GetHostInternalIP(),
+		HostExternalIP: pod.GetHostExternalIP(),
+		PodPort:        int(pod.GetPodPort()),
+		ServicePort:    pod.GetServicePort(),
+		Aerospike: v1alpha1.AerospikeInstanceSummary{
+			ClusterName:                 summary.GetClusterName(),
+			NodeID:                      summary.GetNodeID(),
+			RackID:                      int(summary.GetRackID()),
+			TLSName:                     summary.GetTLSName(),
+			AccessEndpoints:             summary.GetAccessEndpoints(),
+			AlternateAccessEndpoints:    summary.GetAlternateAccessEndpoints(),
+			TLSAccessEndpoints:          summary.GetTLSAccessEndpoints(),
+			TLSAlternateAccessEndpoints: summary.GetTLSAlternateAccessEndpoints(),
+		},
+		InitializedVolumePaths: pod.GetInitializedVolumePaths(),
+		AerospikeConfigHash:    pod.GetAerospikeConfigHash(),
+		NetworkPolicyHash:      pod.GetNetworkPolicyHash(),
+		PodSpecHash:            pod.GetPodSpecHash(),
+	}
+}
+
+// conditionsToProto converts the conditions of a status, the times are truncated to seconds like in the api server
+func conditionsToProto(conditions []metav1.Condition) []*pbv2.Condition {
+	if conditions == nil {
+		return nil
+	}
+
+	out := make([]*pbv2.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, &pbv2.Condition{
+			Type:               c.Type,
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: unixTime(c.LastTransitionTime),
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+	return out
+}
+
+func conditionsFromProto(conditions []*pbv2.Condition) []metav1.Condition {
+	if len(conditions) == 0 {
+		return nil
+	}
+
+	out := make([]metav1.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, metav1.Condition{
+			Type:               c.GetType(),
+			Status:             metav1.ConditionStatus(c.GetStatus()),
+			Reason:             c.GetReason(),
+			Message:            c.GetMessage(),
+			LastTransitionTime: fromUnixTime(c.GetLastTransitionTime()),
+			ObservedGeneration: c.GetObservedGeneration(),
+		})
+	}
+	return out
+}
+
+func objectMetaToProto(meta metav1.ObjectMeta) *pbv2.ObjectMeta {
+	return &pbv2.ObjectMeta{
+		Name:              meta.Name,
+		Namespace:         meta.Namespace,
+		ResourceVersion:   meta.ResourceVersion,
+		Generation:        meta.Generation,
+		CreationTimestamp: unixTime(meta.CreationTimestamp),
+		Labels:            meta.Labels,
+		Annotations:       meta.Annotations,
+	}
+}
+
+func objectMetaFromProto(meta *pbv2.ObjectMeta) metav1.ObjectMeta {
+	out := metav1.ObjectMeta{
+		Name:              meta.GetName(),
+		Namespace:         meta.GetNamespace(),
+		ResourceVersion:   meta.GetResourceVersion(),
+		Generation:        meta.GetGeneration(),
+		CreationTimestamp: fromUnixTime(meta.GetCreationTimestamp()),
+	}
+	// empty maps are not distinguishable from nil ones on the wire
+	if len(meta.GetLabels()) > 0 {
+		out.Labels = meta.GetLabels()
+	}
+	if len(meta.GetAnnotations()) > 0 {
+		out.Annotations = meta.GetAnnotations()
+	}
+	return out
+}
+
+func namespacedNameToProto(name v1.NamespacedName) *pbv2.NamespacedName {
+	return &pbv2.NamespacedName{Name: name.Name, Namespace: name.Namespace}
+}
+
+func namespacedNameFromProto(name *pbv2.NamespacedName) v1.NamespacedName {
+	return v1.NamespacedName{Name: name.GetName(), Namespace: name.GetNamespace()}
+}
+
+// unixTime is 0 for the zero time, so unset times stay unset
+func unixTime(t metav1.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
+func fromUnixTime(seconds int64) metav1.Time {
+	if seconds == 0 {
+		return metav1.Time{}
+	}
+	return metav1.NewTime(time.Unix(seconds, 0))
+}
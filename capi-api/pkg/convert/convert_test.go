@@ -0,0 +1,220 @@
+package convert
+
+import (
+	"testing"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/api/v1alpha1"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/proto"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func testMeta(name string) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         "default",
+		ResourceVersion:   "42",
+		Generation:        3,
+		CreationTimestamp: metav1.Unix(1600000000, 0),
+		Labels:            map[string]string{"team": "db"},
+		Annotations:       map[string]string{"note": "test"},
+	}
+}
+
+func testConditions() []metav1.Condition {
+	return []metav1.Condition{{
+		Type:               "Ready",
+		Status:             metav1.ConditionFalse,
+		Reason:             "Provisioning",
+		Message:            "waiting for the control plane",
+		LastTransitionTime: metav1.Unix(1600000100, 0),
+		ObservedGeneration: 3,
+	}}
+}
+
+// roundTrip sends the message over the wire like the grpc clients do
+func roundTrip(t *testing.T, in, out proto.Message) {
+	g := NewWithT(t)
+
+	data, err := proto.Marshal(in)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(proto.Unmarshal(data, out)).To(Succeed())
+}
+
+func TestClusterRoundTrip(t *testing.T) {
+	managers := map[string]*v1.AeroClusterManager{
+		"eks": {
+			ObjectMeta: testMeta("eks"),
+			Spec: v1.AeroClusterManagerSpec{
+				Name:    "eks",
+				Suspend: true,
+				ClusterOptions: v1.ClusterOptions{
+					Name: "eks", Provider: "eks", KubeVersion: "v1.21.2", Replicas: 3, OperatorVersion: "2.0.0",
+					EKSOptions: &v1.EKSOptions{Region: "us-west-2", InstanceType: "t3.large", SSHKey: "default"},
+				},
+				ClusterID:            v1.NamespacedName{Name: "eks-cluster", Namespace: "default"},
+				ControlPlaneEndpoint: v1.APIEndpoint{Host: "10.0.0.1", Port: 6443},
+				Managed:              true,
+			},
+			Status: v1.AeroClusterManagerStatus{
+				Phase:              string(v1.ManagerPhaseProvisioned),
+				AerospikeOperator:  v1.ApplicationStatus{Running: true, Version: "2.0.0"},
+				PrometheusExporter: v1.ApplicationStatus{Running: false},
+				KubeVersion:        "v1.21.2",
+				Conditions:         testConditions(),
+			},
+		},
+		"aks": {
+			ObjectMeta: metav1.ObjectMeta{Name: "aks", Namespace: "default"},
+			Spec: v1.AeroClusterManagerSpec{
+				ClusterOptions: v1.ClusterOptions{
+					Provider: "aks",
+					AKSOptions: &v1.AKSOptions{
+						Location: "eastus", VMSize: "Standard_D2s_v3", ResourceGroup: "rg", SubscriptionID: "sub",
+						IdentityRef: v1.NamespacedName{Name: "identity", Namespace: "default"}, SSHPublicKey: "c3No",
+					},
+				},
+			},
+		},
+		"gke": {
+			ObjectMeta: metav1.ObjectMeta{Name: "gke", Namespace: "default"},
+			Spec: v1.AeroClusterManagerSpec{
+				ClusterOptions: v1.ClusterOptions{
+					Provider:   "gke",
+					GKEOptions: &v1.GKEOptions{Project: "p", Region: "us-central1", MachineType: "n1-standard-2", Network: "vpc"},
+				},
+			},
+		},
+		"docker": {
+			ObjectMeta: metav1.ObjectMeta{Name: "docker", Namespace: "default"},
+			Spec: v1.AeroClusterManagerSpec{
+				ClusterOptions: v1.ClusterOptions{Provider: "docker", DockerOptions: &v1.DockerOptions{}},
+			},
+		},
+	}
+
+	for name, manager := range managers {
+		t.Run(name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			cluster := &pbv2.Cluster{}
+			roundTrip(t, ClusterToProto(manager), cluster)
+			g.Expect(ClusterFromProto(cluster)).To(Equal(manager))
+		})
+	}
+}
+
+func TestDatabaseRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	db := &v1.AeroDatabase{
+		ObjectMeta: testMeta("db"),
+		Spec: v1.AeroDatabaseSpec{
+			Cluster:         v1.ClusterKey{Name: "eks", Namespace: "default"},
+			Name:            "db",
+			Namespace:       "default",
+			TargetNamespace: "aerospike",
+			DeployClient:    true,
+			DatabaseType:    v1.DatabaseTypeSSD,
+			Options: v1.DatabaseOptions{
+				Replicas: 3,
+				Monitoring: &v1.MonitoringOptions{
+					Enabled:     true,
+					Image:       v1.DefaultExporterImage,
+					Port:        v1.DefaultExporterPort,
+					MonitorKind: v1.MonitorKindPodMonitor,
+					Interval:    "30s",
+					Labels:      map[string]string{"release": "prometheus"},
+				},
+			},
+		},
+		Status: v1.AeroDatabaseStatus{
+			Phase:         string(v1.DBPhaseRunning),
+			LastError:     "none",
+			Size:          3,
+			ReadyPods:     2,
+			Image:         "aerospike/aerospike-server-enterprise:5.7.0.8",
+			OperatorPhase: v1.AerospikeClusterCompleted,
+			Pods: map[string]v1alpha1.AerospikePodStatus{
+				"db-0-0": {
+					Image:          "aerospike/aerospike-server-enterprise:5.7.0.8",
+					PodIP:          "10.1.0.5",
+					HostInternalIP: "192.168.0.5",
+					HostExternalIP: "34.0.0.5",
+					PodPort:        3000,
+					ServicePort:    30000,
+					Aerospike: v1alpha1.AerospikeInstanceSummary{
+						ClusterName:                 "db",
+						NodeID:                      "0a0",
+						RackID:                      1,
+						TLSName:                     "db-tls",
+						AccessEndpoints:             []string{"10.1.0.5:3000"},
+						AlternateAccessEndpoints:    []string{"34.0.0.5:30000"},
+						TLSAccessEndpoints:          []string{"10.1.0.5:4333"},
+						TLSAlternateAccessEndpoints: []string{"34.0.0.5:30333"},
+					},
+					InitializedVolumePaths: []string{"/opt/aerospike"},
+					AerospikeConfigHash:    "a",
+					NetworkPolicyHash:      "b",
+					PodSpecHash:            "c",
+				},
+			},
+			Monitoring: &v1.MonitoringStatus{
+				Monitor:   "PodMonitor aerospike/db-exporter",
+				Endpoints: []string{"http://10.1.0.5:9145/metrics"},
+			},
+			Conditions: testConditions(),
+		},
+	}
+
+	database := &pbv2.Database{}
+	roundTrip(t, DatabaseToProto(db), database)
+	g.Expect(DatabaseFromProto(database)).To(Equal(db))
+}
+
+func TestProtoRoundTrip(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := &pbv2.Cluster{
+		Metadata: &pbv2.ObjectMeta{Name: "c", Namespace: "default", ResourceVersion: "7", CreationTimestamp: 1600000000},
+		Spec: &pbv2.ClusterSpec{
+			Name:                 "c",
+			ClusterOptions:       &pbv2.ClusterOptions{Provider: "eks", Eks: &pbv2.EKSOptions{Region: "us-east-1"}},
+			ClusterID:            &pbv2.NamespacedName{Name: "c", Namespace: "default"},
+			ControlPlaneEndpoint: &pbv2.APIEndpoint{Host: "h", Port: 443},
+		},
+		Status: &pbv2.ClusterStatus{
+			Phase:              "Provisioned",
+			AerospikeOperator:  &pbv2.ApplicationStatus{Running: true},
+			PrometheusExporter: &pbv2.ApplicationStatus{},
+			Conditions:         []*pbv2.Condition{{Type: "Ready", Status: "True", LastTransitionTime: 1600000100}},
+		},
+	}
+	g.Expect(proto.Equal(ClusterToProto(ClusterFromProto(cluster)), cluster)).To(BeTrue())
+
+	database := &pbv2.Database{
+		Metadata: &pbv2.ObjectMeta{Name: "db", Namespace: "default"},
+		Spec: &pbv2.DatabaseSpec{
+			Cluster: &pbv2.NamespacedName{Name: "c", Namespace: "default"},
+			Options: &pbv2.DatabaseOptions{Replicas: 2, Monitoring: &pbv2.MonitoringOptions{Enabled: true, Port: 9145}},
+		},
+		Status: &pbv2.DatabaseStatus{
+			Phase:      "Running",
+			Monitoring: &pbv2.MonitoringStatus{Endpoints: []string{"http://10.1.0.5:9145/metrics"}},
+			Pods: map[string]*pbv2.PodStatus{
+				"db-0-0": {PodIP: "10.1.0.5", PodPort: 3000, Aerospike: &pbv2.AerospikeInstanceSummary{NodeID: "0a0"}},
+			},
+		},
+	}
+	g.Expect(proto.Equal(DatabaseToProto(DatabaseFromProto(database)), database)).To(BeTrue())
+}
+
+func TestFromEmptyProto(t *testing.T) {
+	g := NewWithT(t)
+
+	// messages of older servers may leave everything unset
+	g.Expect(ClusterFromProto(&pbv2.Cluster{})).To(Equal(&v1.AeroClusterManager{}))
+	g.Expect(DatabaseFromProto(&pbv2.Database{})).To(Equal(&v1.AeroDatabase{}))
+}
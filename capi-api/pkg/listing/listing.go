@@ -0,0 +1,411 @@
+// Package listing selects, sorts and pages the clusters and the databases of the list RPCs. The pages follow the list
+// continuation of kubernetes, the filters on fields kubernetes does not index and the sorting are applied by capi-api.
+package listing
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/capi-api/pkg/placement"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrInvalid is returned for options that cannot be applied, e.g. an unknown sort key
+	ErrInvalid = errors.New("invalid list options")
+
+	// ErrExpired is returned when kubernetes no longer has the snapshot of a continue token, the list starts over
+	ErrExpired = errors.New("continue token expired")
+)
+
+// MaxLimit is the largest page, larger limits are lowered to it
+const MaxLimit = 500
+
+// The keys of Options.SortBy, descending with a leading "-"
+const (
+	// SortName is the order of kubernetes, by namespace and name
+	SortName              = "name"
+	SortCreationTimestamp = "creationTimestamp"
+	SortPhase             = "phase"
+	SortProvider          = "provider"
+	SortRegion            = "region"
+	// SortCluster orders the databases by host cluster
+	SortCluster = "cluster"
+)
+
+// Options select, sort and page a list
+type Options struct {
+	// Namespace of the objects, every namespace if empty
+	Namespace string
+	// LabelSelector of kubernetes, e.g. "env=prod,tier!=cache"
+	LabelSelector string
+	// Provider, or a cloud of placement, and Region of the clusters or of the host clusters of the databases
+	Provider string
+	Region   string
+	Phase    string
+	// Cluster is the host cluster of the databases, a name or namespace/name
+	Cluster string
+	// SortBy is a sort key, SortName if empty
+	SortBy string
+	// Limit is the size of a page, everything is listed if 0
+	Limit int64
+	// Continue is the token of the previous page
+	Continue string
+}
+
+// fields are the values of an object the filters and the sort keys apply to
+type fields struct {
+	provider, region, phase, cluster, creationTimestamp string
+}
+
+func (f fields) get(key string) string {
+	switch key {
+	case SortProvider:
+		return f.provider
+	case SortRegion:
+		return f.region
+	case SortPhase:
+		return f.phase
+	case SortCluster:
+		return f.cluster
+	case SortCreationTimestamp:
+		return f.creationTimestamp
+	}
+	return ""
+}
+
+// kind lists the objects of a kind
+type kind struct {
+	newList  func() client.ObjectList
+	sortKeys []string
+	// fields returns the fields of an object, it is set up once per list
+	fields func(ctx context.Context) (func(client.Object) fields, error)
+}
+
+// Clusters returns a page of the clusters and the token of the next page, empty on the last page
+func Clusters(ctx context.Context, c client.Client, options Options) ([]v1.AeroClusterManager, string, error) {
+	if options.Cluster != "" {
+		return nil, "", fmt.Errorf("%w: clusters have no host cluster", ErrInvalid)
+	}
+
+	clusters := kind{
+		newList:  func() client.ObjectList { return &v1.AeroClusterManagerList{} },
+		sortKeys: []string{SortName, SortCreationTimestamp, SortPhase, SortProvider, SortRegion},
+		fields: func(context.Context) (func(client.Object) fields, error) {
+			return func(obj client.Object) fields {
+				cluster := obj.(*v1.AeroClusterManager)
+				return fields{
+					provider:          cluster.Spec.ClusterOptions.Provider,
+					region:            placement.Region(cluster.Spec.ClusterOptions),
+					phase:             cluster.Status.Phase,
+					creationTimestamp: timestamp(obj),
+				}
+			}, nil
+		},
+	}
+
+	objects, next, err := list(ctx, c, clusters, options)
+	if err != nil {
+		return nil, "", err
+	}
+	items := make([]v1.AeroClusterManager, 0, len(objects))
+	for _, obj := range objects {
+		items = append(items, *obj.(*v1.AeroClusterManager))
+	}
+	return items, next, nil
+}
+
+// Databases returns a page of the databases and the token of the next page, empty on the last page
+func Databases(ctx context.Context, c client.Client, options Options) ([]v1.AeroDatabase, string, error) {
+	byHost := options.Provider != "" || options.Region != "" || strings.TrimPrefix(options.SortBy, "-") == SortProvider ||
+		strings.TrimPrefix(options.SortBy, "-") == SortRegion
+
+	databases := kind{
+		newList:  func() client.ObjectList { return &v1.AeroDatabaseList{} },
+		sortKeys: []string{SortName, SortCreationTimestamp, SortPhase, SortProvider, SortRegion, SortCluster},
+		fields: func(ctx context.Context) (func(client.Object) fields, error) {
+			// the provider and the region are the ones of the host cluster
+			hosts := map[string]fields{}
+			if byHost {
+				clusters := &v1.AeroClusterManagerList{}
+				if err := c.List(ctx, clusters); err != nil {
+					return nil, err
+				}
+				for _, cluster := range clusters.Items {
+					hosts[cluster.Namespace+"/"+cluster.Name] = fields{
+						provider: cluster.Spec.ClusterOptions.Provider,
+						region:   placement.Region(cluster.Spec.ClusterOptions),
+					}
+				}
+			}
+
+			return func(obj client.Object) fields {
+				db := obj.(*v1.AeroDatabase)
+				namespace := db.Spec.Cluster.Namespace
+				if namespace == "" {
+					namespace = db.Namespace
+				}
+				cluster := namespace + "/" + db.Spec.Cluster.Name
+				host := hosts[cluster]
+				return fields{
+					provider:          host.provider,
+					region:            host.region,
+					phase:             db.Status.Phase,
+					cluster:           cluster,
+					creationTimestamp: timestamp(obj),
+				}
+			}, nil
+		},
+	}
+
+	objects, next, err := list(ctx, c, databases, options)
+	if err != nil {
+		return nil, "", err
+	}
+	items := make([]v1.AeroDatabase, 0, len(objects))
+	for _, obj := range objects {
+		items = append(items, *obj.(*v1.AeroDatabase))
+	}
+	return items, next, nil
+}
+
+// token is the continue token of a page. A list in the order of kubernetes resumes in a chunk of kubernetes: the chunk
+// of Continue is listed again with the same Chunk size and its first Skip objects are skipped. A sorted list resumes
+// after the object with the values of After.
+type token struct {
+	Continue string `json:"c,omitempty"`
+	Chunk    int64  `json:"l,omitempty"`
+	Skip     int    `json:"s,omitempty"`
+
+	SortBy string   `json:"o,omitempty"`
+	After  []string `json:"a,omitempty"`
+}
+
+func (t token) encode() string {
+	data, _ := json.Marshal(t)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decode(s string) (token, error) {
+	t := token{}
+	if s == "" {
+		return t, nil
+	}
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return t, fmt.Errorf("%w: malformed continue token", ErrInvalid)
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		return t, fmt.Errorf("%w: malformed continue token", ErrInvalid)
+	}
+	return t, nil
+}
+
+// filter tells if an object matches the field filters of the options
+type filter func(fields) bool
+
+func newFilter(options Options) (filter, error) {
+	provider := ""
+	if options.Provider != "" {
+		var err error
+		if provider, err = placement.Provider(options.Provider); err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrInvalid, err.Error())
+		}
+	}
+
+	cluster := options.Cluster
+	return func(f fields) bool {
+		switch {
+		case provider != "" && f.provider != provider:
+			return false
+		case options.Region != "" && f.region != options.Region:
+			return false
+		case options.Phase != "" && !strings.EqualFold(f.phase, options.Phase):
+			return false
+		case cluster != "" && f.cluster != cluster && !strings.HasSuffix(f.cluster, "/"+cluster):
+			return false
+		}
+		return true
+	}, nil
+}
+
+func list(ctx context.Context, c client.Client, k kind, options Options) ([]client.Object, string, error) {
+	if options.Limit < 0 {
+		return nil, "", fmt.Errorf("%w: limit must not be negative", ErrInvalid)
+	}
+	if options.Limit > MaxLimit {
+		options.Limit = MaxLimit
+	}
+
+	sortBy := options.SortBy
+	if sortBy == "" {
+		sortBy = SortName
+	}
+	if !contains(k.sortKeys, strings.TrimPrefix(sortBy, "-")) {
+		return nil, "", fmt.Errorf("%w: unknown sort key %q, one of %s", ErrInvalid, sortBy, strings.Join(k.sortKeys, ", "))
+	}
+
+	selector, err := labels.Parse(options.LabelSelector)
+	if err != nil {
+		return nil, "", fmt.Errorf("%w: %s", ErrInvalid, err.Error())
+	}
+	match, err := newFilter(options)
+	if err != nil {
+		return nil, "", err
+	}
+	t, err := decode(options.Continue)
+	if err != nil {
+		return nil, "", err
+	}
+	fieldsOf, err := k.fields(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	opts := []client.ListOption{client.InNamespace(options.Namespace), client.MatchingLabelsSelector{Selector: selector}}
+	if sortBy == SortName {
+		return listChunks(ctx, c, k, opts, func(obj client.Object) bool { return match(fieldsOf(obj)) }, options.Limit, t)
+	}
+	return listSorted(ctx, c, k, opts, match, fieldsOf, sortBy, options.Limit, t)
+}
+
+// listChunks pages in the order of kubernetes, with its list continuation: the chunks of kubernetes are as large as a
+// page and are listed until a page is filled with the objects that match
+func listChunks(ctx context.Context, c client.Client, k kind, opts []client.ListOption, match func(client.Object) bool,
+	limit int64, t token) ([]client.Object, string, error) {
+	if t.SortBy != "" {
+		return nil, "", fmt.Errorf("%w: the continue token is of a list sorted by %s", ErrInvalid, t.SortBy)
+	}
+	chunk := limit
+	if t.Chunk != 0 {
+		chunk = t.Chunk
+	}
+
+	var page []client.Object
+	continueToken, skip := t.Continue, t.Skip
+	for {
+		objects, next, err := listChunk(ctx, c, k, append(opts, client.Limit(chunk), client.Continue(continueToken)))
+		if err != nil {
+			return nil, "", err
+		}
+
+		for i := skip; i < len(objects); i++ {
+			if !match(objects[i]) {
+				continue
+			}
+			page = append(page, objects[i])
+			if limit == 0 || int64(len(page)) < limit {
+				continue
+			}
+			switch {
+			case i+1 < len(objects):
+				return page, token{Continue: continueToken, Chunk: chunk, Skip: i + 1}.encode(), nil
+			case next != "":
+				return page, token{Continue: next, Chunk: chunk}.encode(), nil
+			}
+			return page, "", nil
+		}
+
+		if next == "" {
+			return page, "", nil
+		}
+		continueToken, skip = next, 0
+	}
+}
+
+// listSorted lists every object, a page resumes after the sort values of the last object of the previous page
+func listSorted(ctx context.Context, c client.Client, k kind, opts []client.ListOption, match filter,
+	fieldsOf func(client.Object) fields, sortBy string, limit int64, t token) ([]client.Object, string, error) {
+	if t.SortBy != "" && t.SortBy != sortBy || t.SortBy == "" && (t.Continue != "" || t.Skip != 0) {
+		return nil, "", fmt.Errorf("%w: the continue token is of a list sorted differently", ErrInvalid)
+	}
+
+	objects, _, err := listChunk(ctx, c, k, opts)
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := strings.TrimPrefix(sortBy, "-")
+	descending := key != sortBy
+	type entry struct {
+		object client.Object
+		values []string
+	}
+	var entries []entry
+	for _, obj := range objects {
+		f := fieldsOf(obj)
+		if match(f) {
+			entries = append(entries, entry{obj, []string{f.get(key), obj.GetNamespace(), obj.GetName()}})
+		}
+	}
+	less := func(a, b []string) bool {
+		if descending {
+			a, b = b, a
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return a[i] < b[i]
+			}
+		}
+		return false
+	}
+	sort.SliceStable(entries, func(i, j int) bool { return less(entries[i].values, entries[j].values) })
+
+	start := 0
+	if len(t.After) == 3 {
+		start = sort.Search(len(entries), func(i int) bool { return less(t.After, entries[i].values) })
+	}
+
+	var page []client.Object
+	for i := start; i < len(entries); i++ {
+		if limit != 0 && int64(len(page)) == limit {
+			last := entries[i-1].values
+			return page, token{SortBy: sortBy, After: last}.encode(), nil
+		}
+		page = append(page, entries[i].object)
+	}
+	return page, "", nil
+}
+
+func listChunk(ctx context.Context, c client.Client, k kind, opts []client.ListOption) ([]client.Object, string, error) {
+	list := k.newList()
+	if err := c.List(ctx, list, opts...); err != nil {
+		if apierrors.IsResourceExpired(err) || apierrors.IsGone(err) {
+			return nil, "", ErrExpired
+		}
+		return nil, "", err
+	}
+
+	var objects []client.Object
+	if err := meta.EachListItem(list, func(obj runtime.Object) error {
+		objects = append(objects, obj.(client.Object))
+		return nil
+	}); err != nil {
+		return nil, "", err
+	}
+	return objects, list.GetContinue(), nil
+}
+
+func timestamp(obj client.Object) string {
+	return obj.GetCreationTimestamp().UTC().Format(time.RFC3339)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
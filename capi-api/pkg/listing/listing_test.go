@@ -0,0 +1,188 @@
+package listing
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+var created = time.Date(2021, 11, 2, 15, 0, 0, 0, time.UTC)
+
+func eksCluster(namespace, name, region, phase string, age int, labels map[string]string) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Labels: labels,
+			CreationTimestamp: metav1.NewTime(created.Add(-time.Duration(age) * time.Hour))},
+		Spec: v1.AeroClusterManagerSpec{
+			ClusterOptions: v1.ClusterOptions{
+				Provider:   v1.ProviderEKS,
+				EKSOptions: &v1.EKSOptions{Region: region},
+			},
+		},
+		Status: v1.AeroClusterManagerStatus{Phase: phase},
+	}
+}
+
+func database(namespace, name, cluster, phase string) *v1.AeroDatabase {
+	db := &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}}
+	db.Spec.Cluster = v1.ClusterKey{Name: cluster, Namespace: "default"}
+	db.Status.Phase = phase
+	return db
+}
+
+func newClient(t *testing.T, objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+}
+
+func testClusters() []client.Object {
+	provisioned, creating := string(v1.ManagerPhaseProvisioned), string(v1.ManagerPhaseClusterCreating)
+	prod := map[string]string{"env": "prod"}
+
+	docker := eksCluster("default", "docker", "", provisioned, 5, nil)
+	docker.Spec.ClusterOptions = v1.ClusterOptions{Provider: v1.ProviderDocker, DockerOptions: &v1.DockerOptions{}}
+	return []client.Object{
+		eksCluster("default", "a", "us-west-2", provisioned, 1, prod),
+		eksCluster("default", "b", "us-east-1", creating, 3, prod),
+		eksCluster("default", "c", "us-west-2", provisioned, 2, nil),
+		eksCluster("team-a", "a", "eu-west-1", provisioned, 4, prod),
+		docker,
+	}
+}
+
+func names(clusters []v1.AeroClusterManager) []string {
+	var names []string
+	for _, cluster := range clusters {
+		names = append(names, cluster.Namespace+"/"+cluster.Name)
+	}
+	return names
+}
+
+func TestClustersFilter(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := newClient(t, testClusters()...)
+
+	clusters, next, err := Clusters(ctx, c, Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(next).To(BeEmpty())
+	g.Expect(names(clusters)).To(Equal([]string{"default/a", "default/b", "default/c", "default/docker", "team-a/a"}))
+
+	clusters, _, err = Clusters(ctx, c, Options{Namespace: "default", LabelSelector: "env=prod"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(clusters)).To(Equal([]string{"default/a", "default/b"}))
+
+	// a cloud is accepted as provider
+	clusters, _, err = Clusters(ctx, c, Options{Provider: "aws", Region: "us-west-2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(clusters)).To(Equal([]string{"default/a", "default/c"}))
+
+	clusters, _, err = Clusters(ctx, c, Options{Phase: "clustercreating"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(clusters)).To(Equal([]string{"default/b"}))
+
+	for _, options := range []Options{
+		{LabelSelector: "env in (prod"},
+		{Provider: "openstack"},
+		{SortBy: "size"},
+		{SortBy: SortCluster},
+		{Cluster: "a"},
+		{Limit: -1},
+		{Continue: "not a token"},
+	} {
+		_, _, err = Clusters(ctx, c, options)
+		g.Expect(errors.Is(err, ErrInvalid)).To(BeTrue(), "%+v", options)
+	}
+}
+
+func TestClustersPages(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := newClient(t, testClusters()...)
+
+	var all []string
+	options := Options{Provider: "eks", Limit: 2}
+	for page := 0; ; page++ {
+		g.Expect(page).To(BeNumerically("<", 3))
+		clusters, next, err := Clusters(ctx, c, options)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(len(clusters)).To(BeNumerically("<=", 2))
+		all = append(all, names(clusters)...)
+		if next == "" {
+			break
+		}
+		options.Continue = next
+	}
+	g.Expect(all).To(Equal([]string{"default/a", "default/b", "default/c", "team-a/a"}))
+}
+
+func TestClustersSorted(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	c := newClient(t, testClusters()...)
+
+	clusters, _, err := Clusters(ctx, c, Options{SortBy: "-" + SortCreationTimestamp})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(clusters)).To(Equal([]string{"default/a", "default/c", "default/b", "team-a/a", "default/docker"}))
+
+	// the pages of a sorted list resume after the last cluster, ties are ordered by namespace and name
+	var all []string
+	options := Options{SortBy: SortRegion, Limit: 2}
+	for {
+		clusters, next, err := Clusters(ctx, c, options)
+		g.Expect(err).NotTo(HaveOccurred())
+		all = append(all, names(clusters)...)
+		if next == "" {
+			break
+		}
+		options.Continue = next
+	}
+	g.Expect(all).To(Equal([]string{"default/docker", "team-a/a", "default/b", "default/a", "default/c"}))
+
+	// a token is only valid for the order it was issued for
+	_, next, err := Clusters(ctx, c, Options{SortBy: SortRegion, Limit: 1})
+	g.Expect(err).NotTo(HaveOccurred())
+	_, _, err = Clusters(ctx, c, Options{SortBy: SortPhase, Continue: next})
+	g.Expect(errors.Is(err, ErrInvalid)).To(BeTrue())
+	_, _, err = Clusters(ctx, c, Options{Continue: next})
+	g.Expect(errors.Is(err, ErrInvalid)).To(BeTrue())
+}
+
+func TestDatabases(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	objects := append(testClusters(),
+		database("default", "orders", "a", string(v1.DBPhaseRunning)),
+		database("default", "users", "b", string(v1.DBPhaseDeployed)),
+		database("team-a", "cache", "c", string(v1.DBPhaseRunning)),
+		database("team-a", "local", "docker", string(v1.DBPhaseRunning)),
+	)
+	c := newClient(t, objects...)
+
+	dbNames := func(options Options) []string {
+		dbs, _, err := Databases(ctx, c, options)
+		g.Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, db := range dbs {
+			names = append(names, db.Namespace+"/"+db.Name)
+		}
+		return names
+	}
+
+	g.Expect(dbNames(Options{Namespace: "team-a"})).To(Equal([]string{"team-a/cache", "team-a/local"}))
+	g.Expect(dbNames(Options{Region: "us-west-2"})).To(Equal([]string{"default/orders", "team-a/cache"}))
+	g.Expect(dbNames(Options{Provider: "docker"})).To(Equal([]string{"team-a/local"}))
+	g.Expect(dbNames(Options{Cluster: "b"})).To(Equal([]string{"default/users"}))
+	g.Expect(dbNames(Options{Cluster: "default/docker", Phase: "running"})).To(Equal([]string{"team-a/local"}))
+	g.Expect(dbNames(Options{SortBy: "-" + SortCluster})).To(Equal(
+		[]string{"team-a/local", "team-a/cache", "default/users", "default/orders"}))
+}
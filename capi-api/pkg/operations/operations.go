@@ -0,0 +1,365 @@
+// Package operations tracks the create, update, upgrade and delete calls of the capi-api until the controllers are done
+// with them. An operation is an AeroOperation next to its target, its progress is derived from the phase and the
+// conditions of the target when it is read, so the operations survive restarts of the api-server and the capi-api. The
+// capi-api sweeps them periodically, so the operations nobody reads are deleted once they are done.
+package operations
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultRetention is how long the operations are kept once they are done
+const DefaultRetention = 7 * 24 * time.Hour
+
+// DefaultSweepInterval is how often the operations nobody reads are refreshed and the expired ones deleted
+const DefaultSweepInterval = 10 * time.Minute
+
+// The kinds of the targets
+const (
+	KindCluster  = "AeroClusterManager"
+	KindDatabase = "AeroDatabase"
+)
+
+// clusterConditions are the conditions of a provisioned cluster, each is a step of its creation
+var clusterConditions = []string{
+	v1.InfrastructureReadyCondition,
+	v1.ControlPlaneReadyCondition,
+	v1.CNIInstalledCondition,
+	v1.CertManagerInstalledCondition,
+	v1.OperatorInstalledCondition,
+}
+
+// Tracker starts the operations and refreshes them from their target
+type Tracker struct {
+	Client client.Client
+	// Retention is how long the operations are kept once they are done, they are deleted by the next List or Sweep
+	Retention time.Duration
+}
+
+// NewTracker returns a Tracker keeping the operations for the DefaultRetention
+func NewTracker(c client.Client) *Tracker {
+	return &Tracker{Client: c, Retention: DefaultRetention}
+}
+
+// Start records an operation of a call that changed target, target is the object returned by the call
+func (t *Tracker) Start(ctx context.Context, verb string, target client.Object) (*v1.AeroOperation, error) {
+	kind, err := kindOf(target)
+	if err != nil {
+		return nil, err
+	}
+
+	now := metav1.Now()
+	op := &v1.AeroOperation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "op-" + rand.String(10),
+			Namespace: target.GetNamespace(),
+		},
+		Spec: v1.AeroOperationSpec{
+			Verb: verb,
+			Target: v1.OperationTarget{
+				Kind:       kind,
+				Name:       target.GetName(),
+				Generation: target.GetGeneration(),
+				UID:        target.GetUID(),
+			},
+		},
+	}
+	if err := t.Client.Create(ctx, op); err != nil {
+		return nil, err
+	}
+
+	op.Status = Evaluate(op, target)
+	op.Status.UpdateTime = &now
+	if op.Status.Done {
+		op.Status.EndTime = &now
+	}
+	// the status is derived again whenever the operation is read, the operation that is stored without it is returned
+	// as such rather than left behind by an error
+	if err := t.Client.Status().Update(ctx, op); err != nil && !apierrors.IsConflict(err) {
+		op.Status = v1.AeroOperationStatus{}
+	}
+	return op, nil
+}
+
+// Get returns an operation refreshed from its target, a NotFound error of the api server if there is none
+func (t *Tracker) Get(ctx context.Context, namespace, id string) (*v1.AeroOperation, error) {
+	op := &v1.AeroOperation{}
+	if err := t.Client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: id}, op); err != nil {
+		return nil, err
+	}
+	if err := t.refresh(ctx, op); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// List returns the operations of a namespace, of every namespace if empty, refreshed from their target. The operations
+// done for longer than the Retention are deleted.
+func (t *Tracker) List(ctx context.Context, namespace string) ([]v1.AeroOperation, error) {
+	list := &v1.AeroOperationList{}
+	if err := t.Client.List(ctx, list, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	ops := make([]v1.AeroOperation, 0, len(list.Items))
+	for i := range list.Items {
+		op := &list.Items[i]
+		deleted, err := t.deleteExpired(ctx, op)
+		if err != nil {
+			return nil, err
+		}
+		if deleted {
+			continue
+		}
+		if err := t.refresh(ctx, op); err != nil {
+			return nil, err
+		}
+		ops = append(ops, *op)
+	}
+	return ops, nil
+}
+
+// Sweep refreshes the operations of every namespace and deletes the ones done for longer than the Retention, so the
+// operations nobody reads are done and deleted as well. It goes through every operation before returning the errors.
+func (t *Tracker) Sweep(ctx context.Context) error {
+	list := &v1.AeroOperationList{}
+	if err := t.Client.List(ctx, list); err != nil {
+		return err
+	}
+
+	var errs []error
+	for i := range list.Items {
+		op := &list.Items[i]
+		if err := t.refresh(ctx, op); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if _, err := t.deleteExpired(ctx, op); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return kerrors.NewAggregate(errs)
+}
+
+// Run sweeps the operations every interval until ctx is done
+func (t *Tracker) Run(ctx context.Context, interval time.Duration) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := t.Sweep(ctx); err != nil {
+			fmt.Printf("[ERROR] unable to sweep the operations: %v \n", err)
+		}
+	}, interval)
+}
+
+// deleteExpired deletes an operation done for longer than the Retention
+func (t *Tracker) deleteExpired(ctx context.Context, op *v1.AeroOperation) (bool, error) {
+	if t.Retention <= 0 || !op.Status.Done || op.Status.EndTime == nil || time.Since(op.Status.EndTime.Time) <= t.Retention {
+		return false, nil
+	}
+	if err := t.Client.Delete(ctx, op); err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	return true, nil
+}
+
+// refresh evaluates an operation that is not done and stores its status when it changed. Concurrent refreshes
+// compute the same status, the conflicts are dropped.
+func (t *Tracker) refresh(ctx context.Context, op *v1.AeroOperation) error {
+	if op.Status.Done {
+		return nil
+	}
+
+	target, err := t.target(ctx, op)
+	if err != nil {
+		return err
+	}
+
+	status := Evaluate(op, target)
+	status.UpdateTime, status.EndTime = op.Status.UpdateTime, op.Status.EndTime
+	if equality.Semantic.DeepEqual(status, op.Status) {
+		return nil
+	}
+
+	now := metav1.Now()
+	status.UpdateTime = &now
+	if status.Done {
+		status.EndTime = &now
+	}
+	op.Status = status
+	if err := t.Client.Status().Update(ctx, op); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// target returns the target of an operation, nil if it does not exist anymore
+func (t *Tracker) target(ctx context.Context, op *v1.AeroOperation) (client.Object, error) {
+	var target client.Object
+	switch op.Spec.Target.Kind {
+	case KindCluster:
+		target = &v1.AeroClusterManager{}
+	case KindDatabase:
+		target = &v1.AeroDatabase{}
+	default:
+		return nil, fmt.Errorf("operation %s has an unknown target kind %q", op.Name, op.Spec.Target.Kind)
+	}
+
+	if err := t.Client.Get(ctx, client.ObjectKey{Namespace: op.Namespace, Name: op.Spec.Target.Name}, target); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return target, nil
+}
+
+func kindOf(target client.Object) (string, error) {
+	switch target.(type) {
+	case *v1.AeroClusterManager:
+		return KindCluster, nil
+	case *v1.AeroDatabase:
+		return KindDatabase, nil
+	}
+	return "", fmt.Errorf("operations are not tracked for %T", target)
+}
+
+// Evaluate returns the status of an operation from its target, target is nil if it does not exist. The failures the
+// controllers retry are reported in the message, an operation only fails when its target is deleted or replaced.
+func Evaluate(op *v1.AeroOperation, target client.Object) v1.AeroOperationStatus {
+	if op.Status.Done {
+		return op.Status
+	}
+
+	spec := op.Spec.Target
+	if target == nil || (spec.UID != "" && target.GetUID() != spec.UID) {
+		if op.Spec.Verb == v1.OperationVerbDelete {
+			return v1.AeroOperationStatus{Done: true, Progress: 100, Phase: "Deleted"}
+		}
+		return v1.AeroOperationStatus{Done: true, Error: fmt.Sprintf("%s %s was deleted", spec.Kind, spec.Name)}
+	}
+
+	var status v1.AeroOperationStatus
+	switch t := target.(type) {
+	case *v1.AeroClusterManager:
+		status = evaluateCluster(op, t)
+	case *v1.AeroDatabase:
+		status = evaluateDatabase(op, t)
+	}
+	if status.Done {
+		status.Progress, status.Message = 100, ""
+	}
+	return status
+}
+
+// evaluateDelete is the progress of a target that still exists, it is gone once the finalizers are done
+func evaluateDelete(target client.Object, conditions []metav1.Condition) v1.AeroOperationStatus {
+	if target.GetDeletionTimestamp() == nil {
+		return v1.AeroOperationStatus{Message: "waiting for the deletion to start"}
+	}
+
+	status := v1.AeroOperationStatus{Progress: 50, Message: "waiting for the finalizers"}
+	if blocked := meta.FindStatusCondition(conditions, v1.DeletionBlockedCondition); blocked != nil && blocked.Status == metav1.ConditionTrue {
+		status.Message = conditionMessage(blocked)
+	}
+	return status
+}
+
+func evaluateCluster(op *v1.AeroOperation, cluster *v1.AeroClusterManager) v1.AeroOperationStatus {
+	conditions := cluster.Status.Conditions
+	if op.Spec.Verb == v1.OperationVerbDelete {
+		status := evaluateDelete(cluster, conditions)
+		status.Phase = cluster.Status.Phase
+		return status
+	}
+
+	status := v1.AeroOperationStatus{Phase: cluster.Status.Phase}
+	generation := op.Spec.Target.Generation
+
+	if op.Spec.Verb == v1.OperationVerbUpgrade {
+		upgraded := meta.FindStatusCondition(conditions, v1.KubeVersionUpgradedCondition)
+		if upgraded == nil || upgraded.ObservedGeneration < generation {
+			status.Message = "waiting for the controller"
+			return status
+		}
+		status.Message = conditionMessage(upgraded)
+		switch {
+		case upgraded.Status == metav1.ConditionTrue:
+			status.Done = true
+		case upgraded.Reason == v1.UpgradingControlPlaneReason:
+			status.Progress = 25
+		case upgraded.Reason == v1.UpgradingWorkersReason:
+			status.Progress = 60
+		}
+		return status
+	}
+
+	// every condition is a step of the creation, an update is done once they are all true again
+	var ready int32
+	for _, conditionType := range clusterConditions {
+		condition := meta.FindStatusCondition(conditions, conditionType)
+		switch {
+		case condition == nil || condition.ObservedGeneration < generation:
+			if status.Message == "" {
+				status.Message = "waiting for " + conditionType
+			}
+		case condition.Status == metav1.ConditionTrue:
+			ready++
+		case status.Message == "":
+			status.Message = conditionType + ": " + conditionMessage(condition)
+		}
+	}
+	status.Progress = 100 * ready / int32(len(clusterConditions))
+	status.Done = ready == int32(len(clusterConditions)) && cluster.Status.Phase == string(v1.ManagerPhaseProvisioned)
+	return status
+}
+
+func evaluateDatabase(op *v1.AeroOperation, db *v1.AeroDatabase) v1.AeroOperationStatus {
+	conditions := db.Status.Conditions
+	if op.Spec.Verb == v1.OperationVerbDelete {
+		status := evaluateDelete(db, conditions)
+		status.Phase = db.Status.Phase
+		return status
+	}
+
+	status := v1.AeroOperationStatus{Phase: db.Status.Phase, Message: db.Status.LastError}
+	ready := meta.FindStatusCondition(conditions, v1.DatabaseReadyCondition)
+	if ready == nil || ready.ObservedGeneration < op.Spec.Target.Generation {
+		if status.Message == "" {
+			status.Message = "waiting for the controller"
+		}
+		return status
+	}
+	if ready.Message != "" {
+		status.Message = conditionMessage(ready)
+	}
+
+	switch db.Status.Phase {
+	case string(v1.DBPhaseRunning):
+		status.Done = ready.Status == metav1.ConditionTrue
+		status.Progress = 90
+	case string(v1.DBPhaseDeployed):
+		status.Progress = 50
+		if db.Status.Size > 0 {
+			status.Progress += 40 * db.Status.ReadyPods / db.Status.Size
+		}
+	}
+	return status
+}
+
+func conditionMessage(condition *metav1.Condition) string {
+	if condition.Message == "" {
+		return condition.Reason
+	}
+	return condition.Reason + ": " + condition.Message
+}
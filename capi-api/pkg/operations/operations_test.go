@@ -0,0 +1,283 @@
+package operations
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func operation(verb, kind string, generation int64) *v1.AeroOperation {
+	return &v1.AeroOperation{
+		ObjectMeta: metav1.ObjectMeta{Name: "op-test", Namespace: "default"},
+		Spec: v1.AeroOperationSpec{
+			Verb:   verb,
+			Target: v1.OperationTarget{Kind: kind, Name: "target", Generation: generation, UID: "uid-1"},
+		},
+	}
+}
+
+func condition(conditionType string, status metav1.ConditionStatus, reason string, generation int64) metav1.Condition {
+	return metav1.Condition{Type: conditionType, Status: status, Reason: reason, ObservedGeneration: generation}
+}
+
+func cluster(generation int64, phase v1.ClusterPhase, conditions ...metav1.Condition) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "target", Namespace: "default", Generation: generation, UID: "uid-1"},
+		Status:     v1.AeroClusterManagerStatus{Phase: string(phase), Conditions: conditions},
+	}
+}
+
+func database(generation int64, phase v1.ClusterPhase, size, readyPods int32, conditions ...metav1.Condition) *v1.AeroDatabase {
+	return &v1.AeroDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "target", Namespace: "default", Generation: generation, UID: "uid-1"},
+		Status:     v1.AeroDatabaseStatus{Phase: string(phase), Size: size, ReadyPods: readyPods, Conditions: conditions},
+	}
+}
+
+func provisioned(generation int64) []metav1.Condition {
+	var conditions []metav1.Condition
+	for _, conditionType := range clusterConditions {
+		conditions = append(conditions, condition(conditionType, metav1.ConditionTrue, v1.InstalledReason, generation))
+	}
+	return conditions
+}
+
+func TestEvaluateCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	create := operation(v1.OperationVerbCreate, KindCluster, 1)
+	status := Evaluate(create, cluster(1, v1.ManagerPhasePending))
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Progress).To(BeZero())
+	g.Expect(status.Message).To(Equal("waiting for " + v1.InfrastructureReadyCondition))
+
+	creating := cluster(1, v1.ManagerPhaseClusterCreating,
+		condition(v1.InfrastructureReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason, 1),
+		condition(v1.ControlPlaneReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason, 1),
+		condition(v1.CNIInstalledCondition, metav1.ConditionFalse, v1.InstallFailedReason, 1))
+	creating.Status.Conditions[2].Message = "calico not reachable"
+	status = Evaluate(create, creating)
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Progress).To(Equal(int32(40)))
+	g.Expect(status.Phase).To(Equal(string(v1.ManagerPhaseClusterCreating)))
+	g.Expect(status.Message).To(Equal("CNIInstalled: InstallFailed: calico not reachable"))
+
+	status = Evaluate(create, cluster(1, v1.ManagerPhaseProvisioned, provisioned(1)...))
+	g.Expect(status).To(Equal(v1.AeroOperationStatus{Done: true, Progress: 100, Phase: string(v1.ManagerPhaseProvisioned)}))
+
+	// an update is done once the controller reconciled its generation
+	update := operation(v1.OperationVerbUpdate, KindCluster, 2)
+	status = Evaluate(update, cluster(2, v1.ManagerPhaseProvisioned, provisioned(1)...))
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Progress).To(BeZero())
+	status = Evaluate(update, cluster(2, v1.ManagerPhaseProvisioned, provisioned(2)...))
+	g.Expect(status.Done).To(BeTrue())
+}
+
+func TestEvaluateUpgrade(t *testing.T) {
+	g := NewWithT(t)
+
+	upgrade := operation(v1.OperationVerbUpgrade, KindCluster, 3)
+	upgraded := func(status metav1.ConditionStatus, reason string, generation int64) *v1.AeroClusterManager {
+		return cluster(3, v1.ManagerPhaseUpgrading, condition(v1.KubeVersionUpgradedCondition, status, reason, generation))
+	}
+
+	g.Expect(Evaluate(upgrade, upgraded(metav1.ConditionTrue, v1.UpgradedReason, 2)).Done).To(BeFalse())
+	g.Expect(Evaluate(upgrade, upgraded(metav1.ConditionFalse, v1.UpgradingControlPlaneReason, 3)).Progress).To(Equal(int32(25)))
+	g.Expect(Evaluate(upgrade, upgraded(metav1.ConditionFalse, v1.UpgradingWorkersReason, 3)).Progress).To(Equal(int32(60)))
+
+	// a blocked upgrade is retried, it is reported but not failed
+	status := Evaluate(upgrade, upgraded(metav1.ConditionFalse, v1.UpgradeBlockedReason, 3))
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Message).To(Equal(v1.UpgradeBlockedReason))
+
+	status = Evaluate(upgrade, upgraded(metav1.ConditionTrue, v1.UpgradedReason, 3))
+	g.Expect(status.Done).To(BeTrue())
+	g.Expect(status.Progress).To(Equal(int32(100)))
+}
+
+func TestEvaluateDatabase(t *testing.T) {
+	g := NewWithT(t)
+
+	create := operation(v1.OperationVerbCreate, KindDatabase, 1)
+	status := Evaluate(create, database(1, v1.DBPhasePending, 0, 0))
+	g.Expect(status.Progress).To(BeZero())
+	g.Expect(status.Message).To(Equal("waiting for the controller"))
+
+	deployed := database(1, v1.DBPhaseDeployed, 4, 2,
+		condition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason, 1))
+	status = Evaluate(create, deployed)
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Progress).To(Equal(int32(70)))
+	g.Expect(status.Phase).To(Equal(string(v1.DBPhaseDeployed)))
+
+	failed := database(1, v1.DBPhasePending, 0, 0)
+	failed.Status.LastError = "cluster is not provisioned"
+	g.Expect(Evaluate(create, failed).Message).To(Equal("cluster is not provisioned"))
+
+	running := database(1, v1.DBPhaseRunning, 4, 4, condition(v1.DatabaseReadyCondition, metav1.ConditionTrue, v1.RunningReason, 1))
+	status = Evaluate(create, running)
+	g.Expect(status.Done).To(BeTrue())
+	g.Expect(status.Error).To(BeEmpty())
+	g.Expect(status.Progress).To(Equal(int32(100)))
+
+	// the controller has not seen the new replicas yet
+	update := operation(v1.OperationVerbUpdate, KindDatabase, 2)
+	g.Expect(Evaluate(update, running).Done).To(BeFalse())
+}
+
+func TestEvaluateDeleted(t *testing.T) {
+	g := NewWithT(t)
+
+	deleting := database(1, v1.DBPhaseRunning, 2, 2,
+		condition(v1.DeletionBlockedCondition, metav1.ConditionTrue, v1.RemoteDeleteFailedReason, 1))
+	now := metav1.Now()
+	deleting.DeletionTimestamp = &now
+
+	del := operation(v1.OperationVerbDelete, KindDatabase, 1)
+	status := Evaluate(del, deleting)
+	g.Expect(status.Done).To(BeFalse())
+	g.Expect(status.Progress).To(Equal(int32(50)))
+	g.Expect(status.Message).To(Equal(v1.RemoteDeleteFailedReason))
+
+	g.Expect(Evaluate(del, nil)).To(Equal(v1.AeroOperationStatus{Done: true, Progress: 100, Phase: "Deleted"}))
+
+	// the target of a create is gone, or was replaced by another object of the same name
+	create := operation(v1.OperationVerbCreate, KindDatabase, 1)
+	status = Evaluate(create, nil)
+	g.Expect(status.Done).To(BeTrue())
+	g.Expect(status.Error).To(Equal("AeroDatabase target was deleted"))
+
+	replaced := database(1, v1.DBPhaseRunning, 2, 2)
+	replaced.UID = "uid-2"
+	g.Expect(Evaluate(create, replaced).Error).To(Equal("AeroDatabase target was deleted"))
+}
+
+func newTracker(t *testing.T, objects ...client.Object) *Tracker {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	return NewTracker(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build())
+}
+
+func TestTracker(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	db := database(1, v1.DBPhasePending, 0, 0)
+	tracker := newTracker(t, db)
+
+	op, err := tracker.Start(ctx, v1.OperationVerbCreate, db)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(op.Name).To(HavePrefix("op-"))
+	g.Expect(op.Spec.Target).To(Equal(v1.OperationTarget{Kind: KindDatabase, Name: "target", Generation: 1, UID: "uid-1"}))
+	g.Expect(op.Status.UpdateTime).NotTo(BeNil())
+
+	// the operation is read back with the progress of the target
+	db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseRunning))
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionTrue, v1.RunningReason, "", 1)
+	g.Expect(tracker.Client.Status().Update(ctx, db)).To(Succeed())
+
+	got, err := tracker.Get(ctx, "default", op.Name)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(got.Status.Done).To(BeTrue())
+	g.Expect(got.Status.EndTime).NotTo(BeNil())
+
+	stored := &v1.AeroOperation{}
+	g.Expect(tracker.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: op.Name}, stored)).To(Succeed())
+	g.Expect(stored.Status.Done).To(BeTrue())
+
+	_, err = tracker.Get(ctx, "other", op.Name)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	ops, err := tracker.List(ctx, "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ops).To(HaveLen(1))
+
+	// the operations done for longer than the retention are dropped
+	expired := metav1.NewTime(time.Now().Add(-2 * DefaultRetention))
+	stored.Status.EndTime = &expired
+	g.Expect(tracker.Client.Status().Update(ctx, stored)).To(Succeed())
+	ops, err = tracker.List(ctx, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ops).To(BeEmpty())
+	_, err = tracker.Get(ctx, "default", op.Name)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	_, err = tracker.Start(ctx, v1.OperationVerbCreate, &v1.AeroOperation{})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestTrackerSweep(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	db := database(1, v1.DBPhasePending, 0, 0)
+	tracker := newTracker(t, db)
+	op, err := tracker.Start(ctx, v1.OperationVerbCreate, db)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// nobody reads the operation, the sweep marks it done
+	db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseRunning))
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionTrue, v1.RunningReason, "", 1)
+	g.Expect(tracker.Client.Status().Update(ctx, db)).To(Succeed())
+	g.Expect(tracker.Sweep(ctx)).To(Succeed())
+
+	stored := &v1.AeroOperation{}
+	g.Expect(tracker.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: op.Name}, stored)).To(Succeed())
+	g.Expect(stored.Status.Done).To(BeTrue())
+	g.Expect(stored.Status.EndTime).NotTo(BeNil())
+
+	// and deletes it once the retention is over
+	g.Expect(tracker.Sweep(ctx)).To(Succeed())
+	g.Expect(tracker.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: op.Name}, stored)).To(Succeed())
+	expired := metav1.NewTime(time.Now().Add(-2 * DefaultRetention))
+	stored.Status.EndTime = &expired
+	g.Expect(tracker.Client.Status().Update(ctx, stored)).To(Succeed())
+	g.Expect(tracker.Sweep(ctx)).To(Succeed())
+	err = tracker.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: op.Name}, stored)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
+
+// failingStatus fails the status updates
+type failingStatus struct {
+	client.Client
+}
+
+func (c failingStatus) Status() client.StatusWriter {
+	return failingStatusWriter{c.Client.Status()}
+}
+
+type failingStatusWriter struct {
+	client.StatusWriter
+}
+
+func (failingStatusWriter) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	return apierrors.NewServiceUnavailable("etcd is down")
+}
+
+func TestTrackerStartWithoutStatus(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	db := database(1, v1.DBPhasePending, 0, 0)
+	tracker := newTracker(t, db)
+	tracker.Client = failingStatus{tracker.Client}
+
+	// the operation is created, its status is derived when it is read
+	op, err := tracker.Start(ctx, v1.OperationVerbCreate, db)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(op.Status).To(Equal(v1.AeroOperationStatus{}))
+
+	stored := &v1.AeroOperation{}
+	g.Expect(tracker.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: op.Name}, stored)).To(Succeed())
+	g.Expect(stored.Spec.Target.Name).To(Equal("target"))
+}
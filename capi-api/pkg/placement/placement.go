@@ -0,0 +1,324 @@
+// Package placement picks the workload cluster a database is created on from a cloud and a region.
+package placement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	// ErrUnknownCloud is returned for clouds that are not backed by a provider
+	ErrUnknownCloud = errors.New("unknown cloud")
+
+	// ErrRegionRequired is returned when the region of a cloud provider is missing
+	ErrRegionRequired = errors.New("region is required")
+
+	// ErrNoCluster is returned when no cluster has room for a database and none is created
+	ErrNoCluster = errors.New("no cluster with capacity in the region")
+
+	// ErrNoTemplate is returned when a cluster has to be created but the provider has no template
+	ErrNoTemplate = errors.New("no cluster template for the provider")
+)
+
+// clouds maps the names of the clouds to the providers, the providers are accepted as well
+var clouds = map[string]string{
+	"aws":             v1.ProviderEKS,
+	"azure":           v1.ProviderAKS,
+	"gcp":             v1.ProviderGKE,
+	"google":          v1.ProviderGKE,
+	v1.ProviderEKS:    v1.ProviderEKS,
+	v1.ProviderAKS:    v1.ProviderAKS,
+	v1.ProviderGKE:    v1.ProviderGKE,
+	v1.ProviderDocker: v1.ProviderDocker,
+}
+
+// Provider returns the provider of a cloud
+func Provider(cloud string) (string, error) {
+	provider, ok := clouds[strings.ToLower(cloud)]
+	if !ok {
+		return "", fmt.Errorf("%w %q", ErrUnknownCloud, cloud)
+	}
+	return provider, nil
+}
+
+// Region returns the region a cluster runs in, docker clusters run everywhere
+func Region(options v1.ClusterOptions) string {
+	switch {
+	case options.EKSOptions != nil:
+		return options.EKSOptions.Region
+	case options.AKSOptions != nil:
+		return options.AKSOptions.Location
+	case options.GKEOptions != nil:
+		return options.GKEOptions.Region
+	}
+	return ""
+}
+
+// Request selects the cluster of a database
+type Request struct {
+	Cloud  string
+	Region string
+	// Tenant is the user namespace for a dedicated cluster, a shared cluster is placed on if empty
+	Tenant string
+	// Create a cluster from the template of the provider when none has capacity
+	Create bool
+}
+
+// Result is the cluster a database is placed on
+type Result struct {
+	Cluster client.ObjectKey
+	// Created is true for a new cluster, it is not provisioned yet
+	Created bool
+}
+
+// ReservationTTL is how long a placement holds its room on a cluster for a database that is not created yet
+const ReservationTTL = 5 * time.Minute
+
+// Placer places databases on the AeroClusterManagers labeled with v1.PlacementLabel. The placements are serialized and
+// every placement reserves its room on the cluster until the database is created, or for ReservationTTL: concurrent
+// placements neither overcommit a cluster nor create a cluster each. The reservations are kept in memory, a single
+// Placer places the databases.
+type Placer struct {
+	Client client.Client
+	// Namespace the clusters are created in
+	Namespace string
+	// Templates are the options of the clusters created per provider, their region is set from the request
+	Templates map[string]v1.ClusterOptions
+
+	mu           sync.Mutex
+	reservations map[client.ObjectKey][]reservation
+	// now is time.Now, for the tests
+	now func() time.Time
+}
+
+// reservation is the room of a placed database, held until the cluster has load databases or until expires
+type reservation struct {
+	load    int
+	expires time.Time
+}
+
+// NewPlacer returns a Placer that creates the clusters in namespace from templates
+func NewPlacer(c client.Client, namespace string, templates map[string]v1.ClusterOptions) *Placer {
+	return &Placer{Client: c, Namespace: namespace, Templates: templates}
+}
+
+// Place returns the cluster with the most free capacity in the region. Provisioned clusters are preferred, clusters
+// created by an earlier placement that are still provisioning are used before another one is created.
+func (p *Placer) Place(ctx context.Context, request Request) (*Result, error) {
+	provider, err := Provider(request.Cloud)
+	if err != nil {
+		return nil, err
+	}
+	if request.Region == "" && provider != v1.ProviderDocker {
+		return nil, ErrRegionRequired
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	managers := &v1.AeroClusterManagerList{}
+	if err := p.Client.List(ctx, managers, client.MatchingLabels(placementLabels(request.Tenant))); err != nil {
+		return nil, err
+	}
+
+	load, err := p.load(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []candidate
+	for i := range managers.Items {
+		manager := &managers.Items[i]
+		if !matches(manager, provider, request.Region) {
+			continue
+		}
+		key := client.ObjectKeyFromObject(manager)
+		free := capacity(manager) - load[key]
+		if free <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			key:         key,
+			free:        free,
+			provisioned: manager.Status.Phase == string(v1.ManagerPhaseProvisioned),
+		})
+	}
+
+	if len(candidates) > 0 {
+		sort.Slice(candidates, func(i, j int) bool {
+			a, b := candidates[i], candidates[j]
+			if a.provisioned != b.provisioned {
+				return a.provisioned
+			}
+			if a.free != b.free {
+				return a.free > b.free
+			}
+			return a.key.String() < b.key.String()
+		})
+		p.reserve(candidates[0].key, load)
+		return &Result{Cluster: candidates[0].key}, nil
+	}
+
+	if !request.Create {
+		return nil, ErrNoCluster
+	}
+
+	manager, err := p.create(ctx, provider, request)
+	if err != nil {
+		return nil, err
+	}
+	p.reserve(client.ObjectKeyFromObject(manager), load)
+	return &Result{Cluster: client.ObjectKeyFromObject(manager), Created: true}, nil
+}
+
+type candidate struct {
+	key         client.ObjectKey
+	free        int
+	provisioned bool
+}
+
+func placementLabels(tenant string) map[string]string {
+	if tenant == "" {
+		return map[string]string{v1.PlacementLabel: v1.PlacementShared}
+	}
+	return map[string]string{v1.PlacementLabel: v1.PlacementDedicated, v1.TenantLabel: tenant}
+}
+
+func matches(manager *v1.AeroClusterManager, provider, region string) bool {
+	options := manager.Spec.ClusterOptions
+	if options.Provider != provider || manager.Spec.Suspend || !manager.DeletionTimestamp.IsZero() {
+		return false
+	}
+	if manager.Status.Phase == string(v1.ManagerPhaseDeleting) || manager.Status.Phase == string(v1.ManagerPhaseUnknown) {
+		return false
+	}
+	return provider == v1.ProviderDocker || Region(options) == region
+}
+
+// capacity is the CapacityAnnotation of the cluster, invalid values fall back to v1.DefaultCapacity
+func capacity(manager *v1.AeroClusterManager) int {
+	if value, ok := manager.Annotations[v1.CapacityAnnotation]; ok {
+		if n, err := strconv.Atoi(value); err == nil && n >= 0 {
+			return n
+		}
+	}
+	return v1.DefaultCapacity
+}
+
+// load counts the databases of every cluster, with the ones placed that are not created yet. The reservations that
+// are fulfilled or expired are dropped.
+func (p *Placer) load(ctx context.Context) (map[client.ObjectKey]int, error) {
+	dbs := &v1.AeroDatabaseList{}
+	if err := p.Client.List(ctx, dbs); err != nil {
+		return nil, err
+	}
+
+	load := map[client.ObjectKey]int{}
+	for _, db := range dbs.Items {
+		load[db.Spec.Cluster.ToObjectKey()]++
+	}
+
+	now := p.clock()
+	for key, reservations := range p.reservations {
+		created := load[key]
+		var pending []reservation
+		for _, r := range reservations {
+			if r.load > created && now.Before(r.expires) {
+				pending = append(pending, r)
+				if r.load > load[key] {
+					load[key] = r.load
+				}
+			}
+		}
+		if len(pending) == 0 {
+			delete(p.reservations, key)
+			continue
+		}
+		p.reservations[key] = pending
+	}
+	return load, nil
+}
+
+// reserve holds the room of a database placed on a cluster, the load counts it until it is created
+func (p *Placer) reserve(key client.ObjectKey, load map[client.ObjectKey]int) {
+	if p.reservations == nil {
+		p.reservations = map[client.ObjectKey][]reservation{}
+	}
+	p.reservations[key] = append(p.reservations[key], reservation{load: load[key] + 1, expires: p.clock().Add(ReservationTTL)})
+}
+
+func (p *Placer) clock() time.Time {
+	if p.now != nil {
+		return p.now()
+	}
+	return time.Now()
+}
+
+func (p *Placer) create(ctx context.Context, provider string, request Request) (*v1.AeroClusterManager, error) {
+	template, ok := p.Templates[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrNoTemplate, provider)
+	}
+
+	prefix := v1.PlacementShared
+	if request.Tenant != "" {
+		prefix = request.Tenant
+	}
+	name := strings.Trim(strings.ToLower(fmt.Sprintf("%s-%s-%s-%s", prefix, provider, request.Region, rand.String(5))), "-")
+	name = strings.ReplaceAll(name, "--", "-")
+
+	options := *template.DeepCopy()
+	options.Name = name
+	options.Provider = provider
+	switch provider {
+	case v1.ProviderEKS:
+		if options.EKSOptions == nil {
+			options.EKSOptions = &v1.EKSOptions{}
+		}
+		options.EKSOptions.Region = request.Region
+	case v1.ProviderAKS:
+		if options.AKSOptions == nil {
+			options.AKSOptions = &v1.AKSOptions{}
+		}
+		options.AKSOptions.Location = request.Region
+	case v1.ProviderGKE:
+		if options.GKEOptions == nil {
+			options.GKEOptions = &v1.GKEOptions{}
+		}
+		options.GKEOptions.Region = request.Region
+	case v1.ProviderDocker:
+		options.DockerOptions = &v1.DockerOptions{}
+	}
+
+	manager := &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: p.Namespace,
+			Labels:    placementLabels(request.Tenant),
+		},
+		Spec: v1.AeroClusterManagerSpec{
+			Name:           name,
+			ClusterOptions: options,
+		},
+	}
+	manager.Default()
+	if err := manager.ValidateCreate(); err != nil {
+		return nil, fmt.Errorf("invalid template of provider %s: %w", provider, err)
+	}
+
+	if err := p.Client.Create(ctx, manager); err != nil {
+		return nil, err
+	}
+	return manager, nil
+}
@@ -0,0 +1,225 @@
+package placement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func eksCluster(name, region, phase string, labels map[string]string) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: labels},
+		Spec: v1.AeroClusterManagerSpec{
+			ClusterOptions: v1.ClusterOptions{
+				Provider:   v1.ProviderEKS,
+				EKSOptions: &v1.EKSOptions{Region: region, InstanceType: "t3.large"},
+			},
+		},
+		Status: v1.AeroClusterManagerStatus{Phase: phase},
+	}
+}
+
+func databases(cluster string, n int) []client.Object {
+	var dbs []client.Object
+	for i := 0; i < n; i++ {
+		db := &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-db-%d", cluster, i), Namespace: "default"}}
+		db.Spec.Cluster = v1.ClusterKey{Name: cluster, Namespace: "default"}
+		dbs = append(dbs, db)
+	}
+	return dbs
+}
+
+func newPlacer(t *testing.T, templates map[string]v1.ClusterOptions, objects ...client.Object) *Placer {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	return NewPlacer(c, "default", templates)
+}
+
+var (
+	shared = map[string]string{v1.PlacementLabel: v1.PlacementShared}
+	tenant = map[string]string{v1.PlacementLabel: v1.PlacementDedicated, v1.TenantLabel: "team-a"}
+)
+
+func TestPlaceShared(t *testing.T) {
+	provisioned := string(v1.ManagerPhaseProvisioned)
+
+	full := eksCluster("full", "us-west-2", provisioned, shared)
+	full.Annotations = map[string]string{v1.CapacityAnnotation: "2"}
+
+	objects := []client.Object{
+		full,
+		eksCluster("busy", "us-west-2", provisioned, shared),
+		eksCluster("idle", "us-west-2", provisioned, shared),
+		eksCluster("creating", "us-west-2", string(v1.ManagerPhaseClusterCreating), shared),
+		eksCluster("other-region", "us-east-1", provisioned, shared),
+		eksCluster("unlabeled", "us-west-2", provisioned, nil),
+		eksCluster("dedicated", "us-west-2", provisioned, tenant),
+	}
+	objects = append(objects, databases("full", 2)...)
+	objects = append(objects, databases("busy", 3)...)
+	objects = append(objects, databases("idle", 1)...)
+
+	g := NewWithT(t)
+	result, err := newPlacer(t, nil, objects...).Place(context.Background(), Request{Cloud: "aws", Region: "us-west-2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Cluster).To(Equal(client.ObjectKey{Name: "idle", Namespace: "default"}))
+	g.Expect(result.Created).To(BeFalse())
+}
+
+func TestPlaceDedicated(t *testing.T) {
+	g := NewWithT(t)
+
+	placer := newPlacer(t, nil,
+		eksCluster("shared", "us-west-2", string(v1.ManagerPhaseProvisioned), shared),
+		eksCluster("team-a", "us-west-2", string(v1.ManagerPhaseProvisioned), tenant),
+	)
+
+	result, err := placer.Place(context.Background(), Request{Cloud: "eks", Region: "us-west-2", Tenant: "team-a"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Cluster.Name).To(Equal("team-a"))
+
+	_, err = placer.Place(context.Background(), Request{Cloud: "eks", Region: "us-west-2", Tenant: "team-b"})
+	g.Expect(errors.Is(err, ErrNoCluster)).To(BeTrue())
+}
+
+func TestPlacePrefersProvisioned(t *testing.T) {
+	g := NewWithT(t)
+
+	placer := newPlacer(t, nil,
+		eksCluster("creating", "us-west-2", string(v1.ManagerPhaseClusterCreating), shared),
+		eksCluster("loaded", "us-west-2", string(v1.ManagerPhaseProvisioned), shared),
+	)
+	for _, db := range databases("loaded", 5) {
+		g.Expect(placer.Client.Create(context.Background(), db)).To(Succeed())
+	}
+
+	result, err := placer.Place(context.Background(), Request{Cloud: "aws", Region: "us-west-2"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Cluster.Name).To(Equal("loaded"))
+}
+
+func TestPlaceCreates(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	templates := map[string]v1.ClusterOptions{
+		v1.ProviderEKS: {KubeVersion: "v1.21.2", Replicas: 3, EKSOptions: &v1.EKSOptions{InstanceType: "t3.large", SSHKey: "default"}},
+	}
+	placer := newPlacer(t, templates)
+
+	_, err := placer.Place(ctx, Request{Cloud: "aws", Region: "us-west-2"})
+	g.Expect(errors.Is(err, ErrNoCluster)).To(BeTrue())
+
+	result, err := placer.Place(ctx, Request{Cloud: "aws", Region: "us-west-2", Tenant: "team-a", Create: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result.Created).To(BeTrue())
+
+	manager := &v1.AeroClusterManager{}
+	g.Expect(placer.Client.Get(ctx, result.Cluster, manager)).To(Succeed())
+	g.Expect(manager.Labels).To(Equal(tenant))
+	g.Expect(manager.Spec.ClusterOptions.Provider).To(Equal(v1.ProviderEKS))
+	g.Expect(manager.Spec.ClusterOptions.EKSOptions.Region).To(Equal("us-west-2"))
+	g.Expect(manager.Spec.ClusterOptions.Replicas).To(Equal(int32(3)))
+	// the template is not modified
+	g.Expect(templates[v1.ProviderEKS].EKSOptions.Region).To(BeEmpty())
+
+	// the cluster is reused while it provisions
+	again, err := placer.Place(ctx, Request{Cloud: "aws", Region: "us-west-2", Tenant: "team-a", Create: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again.Cluster).To(Equal(result.Cluster))
+	g.Expect(again.Created).To(BeFalse())
+
+	_, err = placer.Place(ctx, Request{Cloud: "gcp", Region: "us-central1", Create: true})
+	g.Expect(errors.Is(err, ErrNoTemplate)).To(BeTrue())
+}
+
+func TestPlaceInvalid(t *testing.T) {
+	g := NewWithT(t)
+	placer := newPlacer(t, nil)
+
+	_, err := placer.Place(context.Background(), Request{Cloud: "openstack", Region: "r1"})
+	g.Expect(errors.Is(err, ErrUnknownCloud)).To(BeTrue())
+
+	_, err = placer.Place(context.Background(), Request{Cloud: "aws"})
+	g.Expect(errors.Is(err, ErrRegionRequired)).To(BeTrue())
+}
+
+func TestPlaceReserves(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	small := eksCluster("small", "us-west-2", string(v1.ManagerPhaseProvisioned), shared)
+	small.Annotations = map[string]string{v1.CapacityAnnotation: "2"}
+	placer := newPlacer(t, nil, small)
+	now := time.Now()
+	placer.now = func() time.Time { return now }
+	request := Request{Cloud: "aws", Region: "us-west-2"}
+
+	// concurrent placements do not overcommit the cluster before the databases are created
+	results := make(chan error, 3)
+	for i := 0; i < 3; i++ {
+		go func() {
+			_, err := placer.Place(ctx, request)
+			results <- err
+		}()
+	}
+	placed := 0
+	for i := 0; i < 3; i++ {
+		if err := <-results; err == nil {
+			placed++
+		} else {
+			g.Expect(errors.Is(err, ErrNoCluster)).To(BeTrue())
+		}
+	}
+	g.Expect(placed).To(Equal(2))
+
+	// a created database fulfills its reservation, the other one is held until it expires
+	g.Expect(placer.Client.Create(ctx, databases("small", 1)[0])).To(Succeed())
+	_, err := placer.Place(ctx, request)
+	g.Expect(errors.Is(err, ErrNoCluster)).To(BeTrue())
+
+	now = now.Add(ReservationTTL)
+	_, err = placer.Place(ctx, request)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(placer.reservations).To(HaveLen(1))
+}
+
+func TestPlaceCreatesOnce(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	templates := map[string]v1.ClusterOptions{
+		v1.ProviderEKS: {KubeVersion: "v1.21.2", Replicas: 3, EKSOptions: &v1.EKSOptions{InstanceType: "t3.large", SSHKey: "default"}},
+	}
+	placer := newPlacer(t, templates)
+
+	results := make(chan bool, 4)
+	for i := 0; i < 4; i++ {
+		go func() {
+			result, err := placer.Place(ctx, Request{Cloud: "aws", Region: "us-west-2", Create: true})
+			results <- err == nil && result.Created
+		}()
+	}
+	created := 0
+	for i := 0; i < 4; i++ {
+		if <-results {
+			created++
+		}
+	}
+	g.Expect(created).To(Equal(1))
+
+	managers := &v1.AeroClusterManagerList{}
+	g.Expect(placer.Client.List(ctx, managers)).To(Succeed())
+	g.Expect(managers.Items).To(HaveLen(1))
+}
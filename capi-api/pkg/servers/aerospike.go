@@ -7,6 +7,12 @@ import (
 
 	v1 "github.com/aerospike/aerostation/api/v1"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/listing"
+	"github.com/aerospike/aerostation/capi-api/pkg/operations"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
+	"github.com/aerospike/aerostation/pkg/manifests"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"github.com/aerospike/aerostation/pkg/utils/ako"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,14 +24,22 @@ import (
 type AerospikeServer struct {
 	pb.AerostationAerospikeAPIServer
 	Client client.Client
+	// Databases feeds WatchDatabases, watching is unimplemented if nil
+	Databases *watch.Broadcaster
+	// Operations tracks the create, update and delete calls
+	Operations *operations.Tracker
 }
 
 func NewAerospikeServer(client client.Client) *AerospikeServer {
 
-	return &AerospikeServer{Client: client}
+	return &AerospikeServer{Client: client, Operations: operations.NewTracker(client)}
 }
 
 func (a *AerospikeServer) ValidateAerospikeCluster(ctx context.Context, db *v1.AeroDatabase) error {
+	if err := ako.ValidateDatabaseType(db.Spec.DatabaseType); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid Argument: %s", err)
+	}
+
 	cluster := &v1.AeroClusterManager{}
 	err := a.Client.Get(ctx, db.Spec.Cluster.ToObjectKey(), cluster)
 
@@ -33,10 +47,23 @@ func (a *AerospikeServer) ValidateAerospikeCluster(ctx context.Context, db *v1.A
 		return err
 	}
 
-	return nil
+	if err := authorizeTenantCluster(ctx, cluster); err != nil {
+		return err
+	}
+	if ako.RequiresStorage(db.Spec.DatabaseType) {
+		if _, err := manifests.GetStorage(cluster.Spec.ClusterOptions.Provider); err != nil {
+			return status.Errorf(codes.InvalidArgument, "Invalid Argument: %s", err)
+		}
+	}
+	return scopeTenantTarget(ctx, cluster, db)
 }
 
 func (a *AerospikeServer) CreateCluster(ctx context.Context, request *pb.CreateAerospikeClusterRequest) (*pb.CreateAerospikeClusterResponse, error) {
+	databaseType := request.DatabaseType
+	if databaseType == "" {
+		databaseType = v1.DatabaseTypeMemory
+	}
+
 	database := &v1.AeroDatabase{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      request.Name,
@@ -51,7 +78,7 @@ func (a *AerospikeServer) CreateCluster(ctx context.Context, request *pb.CreateA
 				Name:      request.KubernetesClusterName,
 				Namespace: request.KubernetesClusterNamespace, //TODO: pass in namespace
 			},
-			DatabaseType: "memory",
+			DatabaseType: databaseType,
 			Options: v1.DatabaseOptions{
 				Replicas: request.Options.Replicas,
 			},
@@ -67,6 +94,14 @@ func (a *AerospikeServer) CreateCluster(ctx context.Context, request *pb.CreateA
 		return nil, err
 	}
 
+	// the namespace of a tenant is created on sign up, users that signed up before are caught up here
+	if namespace, ok := tenant.FromIncomingContext(ctx); ok {
+		if err := tenant.CreateNamespace(ctx, a.Client, namespace, ""); err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Unable to create tenant namespace | Reason : %s", err.Error())
+		}
+	}
+
 	fmt.Printf("[DEBUG] creating cluster %s \n", request.Name)
 
 	if err := a.Client.Create(context.TODO(), database); err != nil {
@@ -74,7 +109,9 @@ func (a *AerospikeServer) CreateCluster(ctx context.Context, request *pb.CreateA
 			"Internal Service Error: %s", err)
 	}
 
-	return &pb.CreateAerospikeClusterResponse{}, nil
+	return &pb.CreateAerospikeClusterResponse{
+		OperationId: startOperation(ctx, a.Operations, v1.OperationVerbCreate, database),
+	}, nil
 }
 
 func (a *AerospikeServer) GetCluster(ctx context.Context, request *pb.GetAerospikeClusterRequest) (*pb.GetAerospikeClusterResponse, error) {
@@ -113,16 +150,22 @@ func (a *AerospikeServer) GetClustersByNamespace(ctx context.Context, request *p
 }
 
 func (a *AerospikeServer) GetAllClusters(ctx context.Context, request *pb.GetAllAerospikeClustersRequest) (*pb.GetAerospikeClustersResponse, error) {
-	db := &v1.AeroDatabaseList{}
-
-	if err := a.Client.List(ctx, db); err != nil {
-		return nil, status.Errorf(codes.Internal,
-			"Unable list clusters "+"| Reason : "+err.Error())
+	dbs, next, err := listing.Databases(ctx, a.Client, listing.Options{
+		LabelSelector: request.LabelSelector,
+		Provider:      request.Provider,
+		Region:        request.Region,
+		Phase:         request.Phase,
+		Cluster:       request.Cluster,
+		SortBy:        request.SortBy,
+		Limit:         request.Limit,
+		Continue:      request.Continue,
+	})
+	if err != nil {
+		return nil, listError("clusters", err)
 	}
 
-	var names []v1.NamespacedName
-	for _, v := range db.Items {
-		//obj, _ := json.Marshal(*v.GetNamespacedName())
+	names := []v1.NamespacedName{}
+	for _, v := range dbs {
 		names = append(names, v1.NamespacedName{Name: v.Name, Namespace: v.Namespace})
 	}
 
@@ -131,7 +174,7 @@ func (a *AerospikeServer) GetAllClusters(ctx context.Context, request *pb.GetAll
 		return nil, err
 	}
 
-	return &pb.GetAerospikeClustersResponse{Clusters: result}, nil
+	return &pb.GetAerospikeClustersResponse{Clusters: result, Continue: next}, nil
 }
 
 func (a *AerospikeServer) UpdateCluster(ctx context.Context, request *pb.UpdateAerospikeClusterRequest) (*pb.UpdateAerospikeClusterResponse, error) {
@@ -143,9 +186,14 @@ func (a *AerospikeServer) UpdateCluster(ctx context.Context, request *pb.UpdateA
 		},
 	}
 
+	namespace := request.ClusterNamespace
+	if namespace == "" {
+		namespace = metav1.NamespaceDefault
+	}
+
 	if err := a.Client.Get(context.Background(), client.ObjectKey{
 		Name:      request.ClusterName,
-		Namespace: metav1.NamespaceDefault,
+		Namespace: namespace,
 	}, db); err != nil {
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Unable to update cluster "+"| Reason : "+err.Error())
@@ -165,7 +213,9 @@ func (a *AerospikeServer) UpdateCluster(ctx context.Context, request *pb.UpdateA
 			"Unable to update cluster "+"|  Reason : "+err.Error())
 	}
 
-	return &pb.UpdateAerospikeClusterResponse{}, nil
+	return &pb.UpdateAerospikeClusterResponse{
+		OperationId: startOperation(ctx, a.Operations, v1.OperationVerbUpdate, db),
+	}, nil
 }
 
 func (k *AerospikeServer) DeleteCluster(ctx context.Context, request *pb.DeleteAerospikeClusterRequest) (*pb.DeleteAerospikeClusterResponse, error) {
@@ -194,5 +244,7 @@ func (k *AerospikeServer) DeleteCluster(ctx context.Context, request *pb.DeleteA
 			"unable to delete aerospike cluster "+request.AerospikeName+"| Reason : "+err.Error())
 	}
 
-	return &pb.DeleteAerospikeClusterResponse{}, nil
+	return &pb.DeleteAerospikeClusterResponse{
+		OperationId: startOperation(ctx, k.Operations, v1.OperationVerbDelete, cluster),
+	}, nil
 }
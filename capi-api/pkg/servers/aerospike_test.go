@@ -0,0 +1,43 @@
+package servers
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestValidateAerospikeClusterStorage(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	docker := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "docker", Namespace: "default"}}
+	docker.Spec.ClusterOptions = v1.ClusterOptions{Provider: v1.ProviderDocker, DockerOptions: &v1.DockerOptions{}}
+	aks := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "aks", Namespace: "default"}}
+	aks.Spec.ClusterOptions = v1.ClusterOptions{Provider: v1.ProviderAKS, AKSOptions: &v1.AKSOptions{Location: "eastus"}}
+	aero := NewAerospikeServer(fake.NewClientBuilder().WithScheme(scheme).WithObjects(docker, aks).Build())
+
+	database := func(cluster, databaseType string) *v1.AeroDatabase {
+		db := &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+		db.Spec.Cluster = v1.ClusterKey{Name: cluster, Namespace: "default"}
+		db.Spec.DatabaseType = databaseType
+		return db
+	}
+
+	g.Expect(aero.ValidateAerospikeCluster(ctx, database("docker", v1.DatabaseTypeMemory))).To(Succeed())
+	g.Expect(aero.ValidateAerospikeCluster(ctx, database("aks", v1.DatabaseTypeSSD))).To(Succeed())
+	// docker clusters cannot provision block volumes
+	for _, databaseType := range []string{v1.DatabaseTypeSSD, v1.DatabaseTypePerformance} {
+		err := aero.ValidateAerospikeCluster(ctx, database("docker", databaseType))
+		g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument), databaseType)
+	}
+}
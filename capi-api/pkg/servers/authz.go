@@ -0,0 +1,133 @@
+package servers
+
+import (
+	"context"
+
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/authz"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// permission is the verb of a method on the resources of authz, admin is the resource of the calls on every namespace
+// and tenant the resource of the calls scoped to the tenant namespace of the user, empty for the admin methods
+type permission struct {
+	verb   string
+	admin  string
+	tenant string
+}
+
+// methodPermissions are the permissions of every method, the methods that are missing are denied
+var methodPermissions = map[string]permission{
+	"/messages.AerostationKubernetesAPI/CreateCluster":       {authz.VerbCreate, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/GetCluster":          {authz.VerbGet, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/GetClusters":         {authz.VerbList, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/UpdateCluster":       {authz.VerbUpdate, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/UpgradeCluster":      {authz.VerbUpdate, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/DeleteCluster":       {authz.VerbDelete, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/WatchClusters":       {authz.VerbWatch, authz.ResourceAdminClusters, ""},
+	"/messages.AerostationKubernetesAPI/IsKubernetesCluster": {authz.VerbGet, authz.ResourceAdminClusters, ""},
+	// the kubeconfigs are issued to the user itself, tenants get them for their clusters only
+	"/messages.AerostationKubernetesAPI/GetKubeconfig": {authz.VerbCreate, authz.ResourceAdminClusters, authz.ResourceKubeconfigs},
+	// placing a database may create a shared cluster for the tenant
+	"/messages.AerostationKubernetesAPI/MapRegionToSharedCluster": {authz.VerbCreate, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationKubernetesAPI/MapRegionToCluster":       {authz.VerbCreate, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+
+	"/messages.AerostationAerospikeAPI/CreateCluster":  {authz.VerbCreate, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationAerospikeAPI/GetCluster":     {authz.VerbGet, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationAerospikeAPI/GetClusters":    {authz.VerbList, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationAerospikeAPI/GetAllClusters": {authz.VerbList, authz.ResourceAdminDatabases, ""},
+	"/messages.AerostationAerospikeAPI/UpdateCluster":  {authz.VerbUpdate, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationAerospikeAPI/DeleteCluster":  {authz.VerbDelete, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.AerostationAerospikeAPI/WatchDatabases": {authz.VerbWatch, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+
+	"/messages.AerostationOperationsAPI/GetOperation":   {authz.VerbGet, authz.ResourceAdminOperations, authz.ResourceOperations},
+	"/messages.AerostationOperationsAPI/ListOperations": {authz.VerbList, authz.ResourceAdminOperations, authz.ResourceOperations},
+
+	"/messages.v2.AerostationKubernetesAPI/GetCluster":   {authz.VerbGet, authz.ResourceAdminClusters, ""},
+	"/messages.v2.AerostationKubernetesAPI/ListClusters": {authz.VerbList, authz.ResourceAdminClusters, ""},
+	"/messages.v2.AerostationAerospikeAPI/GetDatabase":   {authz.VerbGet, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+	"/messages.v2.AerostationAerospikeAPI/ListDatabases": {authz.VerbList, authz.ResourceAdminDatabases, authz.ResourceDatabases},
+}
+
+// Authorization authenticates the credentials the api-server forwards and authorizes the calls with the policy of the
+// api-server. It runs before the tenant interceptors: the users that may only act on their tenant get their calls
+// scoped to their tenant namespace, whatever namespace the caller passed.
+type Authorization struct {
+	Strategy   auth.Strategy
+	Authorizer *authz.Authorizer
+}
+
+// NewAuthorization returns the interceptors of a strategy and a policy
+func NewAuthorization(strategy auth.Strategy, authorizer *authz.Authorizer) *Authorization {
+	return &Authorization{Strategy: strategy, Authorizer: authorizer}
+}
+
+func (a *Authorization) authorize(ctx context.Context, method string) (context.Context, error) {
+	info, err := authn.AuthenticateIncoming(ctx, a.Strategy)
+	if err != nil {
+		return nil, status.Errorf(codes.Unauthenticated, "Unable to call %s | Reason : %s", method, err)
+	}
+	ctx = auth.CtxWithUser(ctx, info)
+
+	p, ok := methodPermissions[method]
+	if !ok {
+		a.Authorizer.Audit.Deny(info, a.Authorizer.Roles(info), "", "", method)
+		return nil, status.Errorf(codes.PermissionDenied, "Unable to call %s: unknown method", method)
+	}
+
+	if a.Authorizer.Allowed(info, p.admin, p.verb) {
+		return ctx, nil
+	}
+
+	resource := p.admin
+	if p.tenant != "" {
+		resource = p.tenant
+		if a.Authorizer.Allowed(info, p.tenant, p.verb) {
+			namespace, err := tenant.Namespace(info.GetUserName(), authn.IdentityOf(info))
+			if err != nil {
+				return nil, status.Errorf(codes.PermissionDenied, "Unable to call %s | Reason : %s", method, err)
+			}
+			if requested, ok := tenant.FromIncomingContext(ctx); ok && requested != namespace {
+				a.Authorizer.Audit.Deny(info, a.Authorizer.Roles(info), p.tenant, p.verb, method)
+				return nil, status.Errorf(codes.PermissionDenied, "Unable to call %s: %q is not the tenant namespace of %s", method, requested, info.GetUserName())
+			}
+			return tenant.NewIncomingContext(ctx, namespace), nil
+		}
+	}
+
+	if err := a.Authorizer.Authorize(info, resource, p.verb, method); err != nil {
+		return nil, status.Errorf(codes.PermissionDenied, "Unable to call %s | Reason : %s", method, err)
+	}
+	return ctx, nil
+}
+
+// UnaryInterceptor authorizes the unary calls
+func (a *Authorization) UnaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// StreamInterceptor authorizes the streams when they are opened
+func (a *Authorization) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authorize(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authorizedStream{ServerStream: stream, ctx: ctx})
+}
+
+type authorizedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authorizedStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,39 @@
+package servers
+
+import (
+	"fmt"
+	"testing"
+
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+)
+
+func TestMethodPermissions(t *testing.T) {
+	g := NewWithT(t)
+
+	// a method missing from methodPermissions is denied to everyone, platform admins included
+	descs := []grpc.ServiceDesc{
+		pb.AerostationKubernetesAPI_ServiceDesc,
+		pb.AerostationAerospikeAPI_ServiceDesc,
+		pb.AerostationOperationsAPI_ServiceDesc,
+		pbv2.AerostationKubernetesAPI_ServiceDesc,
+		pbv2.AerostationAerospikeAPI_ServiceDesc,
+	}
+	for _, desc := range descs {
+		for _, method := range desc.Methods {
+			g.Expect(methodPermissions).To(HaveKey(fmt.Sprintf("/%s/%s", desc.ServiceName, method.MethodName)))
+		}
+		for _, stream := range desc.Streams {
+			g.Expect(methodPermissions).To(HaveKey(fmt.Sprintf("/%s/%s", desc.ServiceName, stream.StreamName)))
+		}
+	}
+
+	// the methods of tenants are scoped to their namespace
+	for method, p := range methodPermissions {
+		if p.tenant != "" {
+			g.Expect(tenantMethods).To(HaveKey(method))
+		}
+	}
+}
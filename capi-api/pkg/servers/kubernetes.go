@@ -3,12 +3,24 @@ package servers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
 	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/listing"
+	"github.com/aerospike/aerostation/capi-api/pkg/operations"
+	"github.com/aerospike/aerostation/capi-api/pkg/placement"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
+	"github.com/aerospike/aerostation/pkg/secrets"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"github.com/aerospike/aerostation/pkg/utils"
+	"github.com/aerospike/aerostation/pkg/utils/capi"
+	"github.com/shaj13/go-guardian/v2/auth"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -17,33 +29,97 @@ import (
 type KubernetesServer struct {
 	pb.AerostationKubernetesAPIServer
 	Client client.Client
+	// Clusters feeds WatchClusters, watching is unimplemented if nil
+	Clusters *watch.Broadcaster
+	// Placer maps regions to clusters, no clusters are created if nil
+	Placer *placement.Placer
+	// Operations tracks the create, update, upgrade and delete calls
+	Operations *operations.Tracker
 }
 
 func NewKubernetesServer(client client.Client) *KubernetesServer {
-	return &KubernetesServer{Client: client}
+	return &KubernetesServer{Client: client, Operations: operations.NewTracker(client)}
+}
+
+// startOperation returns the id of the operation tracking a call that changed target. The call succeeded, so an
+// operation that cannot be recorded is logged and the id is left empty.
+func startOperation(ctx context.Context, tracker *operations.Tracker, verb string, target client.Object) string {
+	if tracker == nil {
+		return ""
+	}
+	op, err := tracker.Start(ctx, verb, target)
+	if err != nil {
+		fmt.Printf("[ERROR] unable to track %s of %s/%s: %v \n", verb, target.GetNamespace(), target.GetName(), err)
+		return ""
+	}
+	return op.Name
+}
+
+// clusterOptions maps the provider specific part of the request onto the ClusterOptions
+func clusterOptions(request *pb.CreateKubernetesClusterRequest) (v1.ClusterOptions, error) {
+	options := v1.ClusterOptions{
+		Name:        request.Name,
+		Provider:    request.Provider,
+		Replicas:    request.Replicas,
+		KubeVersion: request.KubeVersion,
+	}
+
+	switch request.Provider {
+	case "docker":
+		options.DockerOptions = &v1.DockerOptions{}
+	case "eks":
+		options.EKSOptions = &v1.EKSOptions{
+			Region:       request.GetEks().GetRegion(),
+			InstanceType: request.GetEks().GetInstanceType(),
+			SSHKey:       request.GetEks().GetSSHKey(),
+		}
+	case "aks":
+		options.AKSOptions = &v1.AKSOptions{
+			Location:       request.GetAks().GetRegion(),
+			VMSize:         request.GetAks().GetInstanceType(),
+			ResourceGroup:  request.GetAks().GetResourceGroup(),
+			SubscriptionID: request.GetAks().GetSubscriptionID(),
+			IdentityRef: v1.NamespacedName{
+				Name:      request.GetAks().GetIdentityRef().GetName(),
+				Namespace: request.GetAks().GetIdentityRef().GetNamespace(),
+			},
+			SSHPublicKey: request.GetAks().GetSSHKey(),
+		}
+		if err := capi.ValidateAKSOptions(&options); err != nil {
+			return options, err
+		}
+	case "gke":
+		options.GKEOptions = &v1.GKEOptions{
+			Project:     request.GetGke().GetProject(),
+			Region:      request.GetGke().GetRegion(),
+			MachineType: request.GetGke().GetInstanceType(),
+			Network:     request.GetGke().GetNetwork(),
+		}
+		if err := capi.ValidateGKEOptions(&options); err != nil {
+			return options, err
+		}
+	default:
+		return options, fmt.Errorf("unsupported provider %q", request.Provider)
+	}
+
+	return options, nil
 }
 
 func (k *KubernetesServer) CreateCluster(ctx context.Context, request *pb.CreateKubernetesClusterRequest) (*pb.CreateKubernetesClusterResponse, error) {
+	options, err := clusterOptions(request)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to create cluster: %s", err.Error())
+	}
+
 	cluster := &v1.AeroClusterManager{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      request.Name,
 			Namespace: request.Namespace,
 		},
 		Spec: v1.AeroClusterManagerSpec{
-			Name:    request.Name,
-			Suspend: false,
-			ClusterOptions: v1.ClusterOptions{
-				Name:          request.Name,
-				Provider:      request.Provider,
-				Replicas:      request.Replicas,
-				KubeVersion:   request.KubeVersion,
-				DockerOptions: &v1.DockerOptions{},
-				EKSOptions: &v1.EKSOptions{
-					Region:       request.Eks.Region,
-					InstanceType: request.Eks.InstanceType,
-					SSHKey:       request.Eks.SSHKey,
-				},
-			},
+			Name:           request.Name,
+			Suspend:        false,
+			ClusterOptions: options,
 			ClusterID: v1.NamespacedName{
 				Namespace: request.Namespace,
 				Name:      request.Name,
@@ -58,7 +134,9 @@ func (k *KubernetesServer) CreateCluster(ctx context.Context, request *pb.Create
 		return nil, status.Errorf(codes.InvalidArgument, "Unable to create cluster: %s", err.Error())
 	}
 
-	return &pb.CreateKubernetesClusterResponse{}, nil
+	return &pb.CreateKubernetesClusterResponse{
+		OperationId: startOperation(ctx, k.Operations, v1.OperationVerbCreate, cluster),
+	}, nil
 }
 
 func (k *KubernetesServer) GetCluster(ctx context.Context, request *pb.GetKubernetesClusterRequest) (*pb.GetKubernetesClusterResponse, error) {
@@ -79,20 +157,27 @@ func (k *KubernetesServer) GetCluster(ctx context.Context, request *pb.GetKubern
 }
 
 func (k *KubernetesServer) GetClusters(ctx context.Context, request *pb.GetKubernetesClustersRequest) (*pb.GetKubernetesClustersResponse, error) {
-	clusters := &v1.AeroClusterManagerList{}
-	err := k.Client.List(context.Background(), clusters)
+	clusters, next, err := listing.Clusters(ctx, k.Client, listing.Options{
+		Namespace:     request.Namespace,
+		LabelSelector: request.LabelSelector,
+		Provider:      request.Provider,
+		Region:        request.Region,
+		Phase:         request.Phase,
+		SortBy:        request.SortBy,
+		Limit:         request.Limit,
+		Continue:      request.Continue,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "Internal service error")
+		return nil, listError("clusters", err)
 	}
 
-	var names []v1.NamespacedName
-	for _, v := range clusters.Items {
-		// obj, _ := json.Marshal(*v.GetNamespacedName())
+	names := []v1.NamespacedName{}
+	for _, v := range clusters {
 		names = append(names, *v.GetNamespacedName())
 	}
 
 	res, _ := json.Marshal(names)
-	return &pb.GetKubernetesClustersResponse{Clusters: res}, nil
+	return &pb.GetKubernetesClustersResponse{Clusters: res, Continue: next}, nil
 }
 
 func (k *KubernetesServer) UpdateCluster(ctx context.Context, request *pb.UpdateKubernetesClusterRequest) (*pb.UpdateKubernetesClusterResponse, error) {
@@ -129,7 +214,102 @@ func (k *KubernetesServer) UpdateCluster(ctx context.Context, request *pb.Update
 		return nil, status.Errorf(codes.NotFound, "Unable to update cluster "+"| Reason : "+err.Error())
 	}
 
-	return &pb.UpdateKubernetesClusterResponse{}, nil
+	return &pb.UpdateKubernetesClusterResponse{
+		OperationId: startOperation(ctx, k.Operations, v1.OperationVerbUpdate, manager),
+	}, nil
+}
+
+// UpgradeCluster moves the cluster to a new kubernetes version, the controller upgrades the control plane before the workers
+func (k *KubernetesServer) UpgradeCluster(ctx context.Context, request *pb.UpgradeKubernetesClusterRequest) (*pb.UpgradeKubernetesClusterResponse, error) {
+	if request.Name == "" || request.Namespace == "" || request.KubeVersion == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to upgrade cluster: name, namespace and kubeVersion are required")
+	}
+
+	manager := &v1.AeroClusterManager{}
+	if err := k.Client.Get(ctx, client.ObjectKey{Name: request.Name, Namespace: request.Namespace}, manager); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to upgrade cluster | Reason : %s", err.Error())
+	}
+
+	patchHelper, err := patch.NewHelper(manager, k.Client)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to upgrade cluster | Reason : %s", err.Error())
+	}
+
+	old := manager.DeepCopy()
+	manager.Default()
+	manager.Spec.ClusterOptions.KubeVersion = request.KubeVersion
+
+	// same checks as the webhook, so the caller learns about skew errors even when it is disabled
+	if err := manager.ValidateUpdate(old); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to upgrade cluster: %s", err.Error())
+	}
+
+	if err := patchHelper.Patch(ctx, manager); err != nil {
+		if apierrors.IsInvalid(err) {
+			return nil, status.Errorf(codes.InvalidArgument, "Unable to upgrade cluster: %s", err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to upgrade cluster | Reason : %s", err.Error())
+	}
+
+	return &pb.UpgradeKubernetesClusterResponse{
+		OperationId: startOperation(ctx, k.Operations, v1.OperationVerbUpgrade, manager),
+	}, nil
+}
+
+// GetKubeconfig issues a kubeconfig for the user signed by the cluster CA, the admin kubeconfig is never returned. The
+// kubeconfig is issued to the authenticated user, a tenant gets it for the clusters it may use and views its namespace
+// only on the shared clusters.
+func (k *KubernetesServer) GetKubeconfig(ctx context.Context, request *pb.GetKubeconfigRequest) (*pb.GetKubeconfigResponse, error) {
+	user := request.User
+	if info := auth.UserFromCtx(ctx); info != nil {
+		user = info.GetUserName()
+	}
+	if request.Name == "" || request.Namespace == "" || user == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get kubeconfig: name, namespace and user are required")
+	}
+
+	ttl := secrets.DefaultUserKubeconfigTTL
+	if request.TTLSeconds != 0 {
+		ttl = time.Duration(request.TTLSeconds) * time.Second
+	}
+	if err := secrets.ValidateUserKubeconfigTTL(ttl); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get kubeconfig: %s", err.Error())
+	}
+
+	manager := &v1.AeroClusterManager{}
+	if err := k.Client.Get(ctx, client.ObjectKey{Name: request.Name, Namespace: request.Namespace}, manager); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get kubeconfig | Reason : %s", err.Error())
+	}
+	if err := authorizeTenantCluster(ctx, manager); err != nil {
+		return nil, err
+	}
+	manager.Default()
+
+	scope := utils.KubeconfigScope{}
+	if namespace, ok := tenant.FromIncomingContext(ctx); ok {
+		scope.Tenant = namespace
+		scope.Namespaced = manager.Labels[v1.PlacementLabel] != v1.PlacementDedicated
+	}
+
+	kubeconfig, expiresAt, err := utils.IssueUserKubeconfig(ctx, k.Client, manager.Spec.ClusterID.ToObjectKey(), user, scope, ttl)
+	if err != nil {
+		switch {
+		case apierrors.IsNotFound(err), errors.Is(err, secrets.ErrDependentCertificateNotFound):
+			return nil, status.Errorf(codes.FailedPrecondition, "Unable to get kubeconfig: the cluster is not provisioned yet")
+		case errors.Is(err, secrets.ErrCAKeyNotFound):
+			return nil, status.Errorf(codes.FailedPrecondition, "Unable to get kubeconfig: the CA of provider %s clusters can not sign certificates",
+				manager.Spec.ClusterOptions.Provider)
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get kubeconfig | Reason : %s", err.Error())
+	}
+
+	return &pb.GetKubeconfigResponse{Kubeconfig: kubeconfig, ExpiresAt: expiresAt.Unix()}, nil
 }
 
 func (k *KubernetesServer) DeleteCluster(ctx context.Context, request *pb.DeleteKubernetesClusterRequest) (*pb.DeleteKubernetesClusterResponse, error) {
@@ -150,5 +330,7 @@ func (k *KubernetesServer) DeleteCluster(ctx context.Context, request *pb.Delete
 		return nil, status.Errorf(codes.InvalidArgument, "unable to delete cluster Test"+request.Name+"| Reason : "+err.Error())
 	}
 
-	return &pb.DeleteKubernetesClusterResponse{}, nil
+	return &pb.DeleteKubernetesClusterResponse{
+		OperationId: startOperation(ctx, k.Operations, v1.OperationVerbDelete, cluster),
+	}, nil
 }
@@ -0,0 +1,77 @@
+package servers
+
+import (
+	"context"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/operations"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OperationsServer reports the progress of the calls tracked by the KubernetesServer and the AerospikeServer
+type OperationsServer struct {
+	pb.UnimplementedAerostationOperationsAPIServer
+	Operations *operations.Tracker
+}
+
+func NewOperationsServer(tracker *operations.Tracker) *OperationsServer {
+	return &OperationsServer{Operations: tracker}
+}
+
+func (o *OperationsServer) GetOperation(ctx context.Context, request *pb.GetOperationRequest) (*pb.Operation, error) {
+	if request.Id == "" || request.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get operation: id and namespace are required")
+	}
+
+	op, err := o.Operations.Get(ctx, request.Namespace, request.Id)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "operation not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get operation %s | Reason : %s", request.Id, err.Error())
+	}
+	return operationToProto(op), nil
+}
+
+func (o *OperationsServer) ListOperations(ctx context.Context, request *pb.ListOperationsRequest) (*pb.ListOperationsResponse, error) {
+	ops, err := o.Operations.List(ctx, request.Namespace)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to list operations | Reason : %s", err.Error())
+	}
+
+	response := &pb.ListOperationsResponse{Operations: make([]*pb.Operation, 0, len(ops))}
+	for i := range ops {
+		response.Operations = append(response.Operations, operationToProto(&ops[i]))
+	}
+	return response, nil
+}
+
+func operationToProto(op *v1.AeroOperation) *pb.Operation {
+	return &pb.Operation{
+		Id:         op.Name,
+		Namespace:  op.Namespace,
+		Verb:       op.Spec.Verb,
+		Kind:       op.Spec.Target.Kind,
+		Name:       op.Spec.Target.Name,
+		Done:       op.Status.Done,
+		Progress:   op.Status.Progress,
+		Phase:      op.Status.Phase,
+		Message:    op.Status.Message,
+		Error:      op.Status.Error,
+		CreateTime: formatTime(&op.CreationTimestamp),
+		UpdateTime: formatTime(op.Status.UpdateTime),
+		EndTime:    formatTime(op.Status.EndTime),
+	}
+}
+
+func formatTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,92 @@
+package servers
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/placement"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// IsKubernetesCluster tells if the AeroClusterManager exists
+func (k *KubernetesServer) IsKubernetesCluster(ctx context.Context, request *pb.IsKubernetesClusterRequest) (*pb.IsKubernetesClusterResponse, error) {
+	if request.GetCluster().GetName() == "" || request.GetCluster().GetNamespace() == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to find cluster: name and namespace are required")
+	}
+
+	manager := &v1.AeroClusterManager{}
+	err := k.Client.Get(ctx, client.ObjectKey{Name: request.Cluster.Name, Namespace: request.Cluster.Namespace}, manager)
+	if apierrors.IsNotFound(err) {
+		return &pb.IsKubernetesClusterResponse{IsKubernetesCluster: false}, nil
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Unable to find cluster | Reason : %s", err.Error())
+	}
+
+	return &pb.IsKubernetesClusterResponse{IsKubernetesCluster: true}, nil
+}
+
+// MapRegionToSharedCluster returns the shared cluster of the region a database is created on
+func (k *KubernetesServer) MapRegionToSharedCluster(ctx context.Context, request *pb.MapRegionToSharedClusterRequest) (*pb.MapRegionToSharedClusterResponse, error) {
+	result, err := k.place(ctx, placement.Request{
+		Cloud:  request.Cloud,
+		Region: request.Region,
+		Create: request.Create,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MapRegionToSharedClusterResponse{
+		Cluster: &pb.NamespacedName{Name: result.Cluster.Name, Namespace: result.Cluster.Namespace},
+		Created: result.Created,
+	}, nil
+}
+
+// MapRegionToCluster returns the cluster of the region dedicated to the user namespace a database is created on
+func (k *KubernetesServer) MapRegionToCluster(ctx context.Context, request *pb.MapRegionToClusterRequest) (*pb.MapRegionToClusterResponse, error) {
+	if request.UserNamespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to place database: user namespace is required")
+	}
+
+	result, err := k.place(ctx, placement.Request{
+		Cloud:  request.Cloud,
+		Region: request.Region,
+		Tenant: request.UserNamespace,
+		Create: request.Create,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.MapRegionToClusterResponse{
+		Cluster: &pb.NamespacedName{Name: result.Cluster.Name, Namespace: result.Cluster.Namespace},
+		Created: result.Created,
+	}, nil
+}
+
+func (k *KubernetesServer) place(ctx context.Context, request placement.Request) (*placement.Result, error) {
+	placer := k.Placer
+	if placer == nil {
+		// place on the existing clusters only
+		placer = placement.NewPlacer(k.Client, "", nil)
+	}
+
+	result, err := placer.Place(ctx, request)
+	switch {
+	case err == nil:
+		return result, nil
+	case errors.Is(err, placement.ErrNoCluster):
+		return nil, status.Errorf(codes.NotFound, "Unable to place database: %s %s", err.Error(), request.Region)
+	case errors.Is(err, placement.ErrNoTemplate):
+		return nil, status.Errorf(codes.FailedPrecondition, "Unable to place database: %s", err.Error())
+	case errors.Is(err, placement.ErrUnknownCloud), errors.Is(err, placement.ErrRegionRequired):
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to place database: %s", err.Error())
+	}
+	return nil, status.Errorf(codes.Internal, "Unable to place database | Reason : %s", err.Error())
+}
@@ -0,0 +1,146 @@
+package servers
+
+import (
+	"context"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/pkg/tenant"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// tenantNamespace returns the namespace a request of a method is scoped to, false if the request is not scoped
+type tenantNamespace func(request interface{}) (string, bool)
+
+// tenantMethods are the methods tenants may call, the methods that are missing are for admins only
+var tenantMethods = map[string]tenantNamespace{
+	"/messages.AerostationAerospikeAPI/CreateCluster": func(r interface{}) (string, bool) {
+		return r.(*pb.CreateAerospikeClusterRequest).Namespace, true
+	},
+	"/messages.AerostationAerospikeAPI/GetCluster": func(r interface{}) (string, bool) {
+		return r.(*pb.GetAerospikeClusterRequest).AerospikeNamespace, true
+	},
+	"/messages.AerostationAerospikeAPI/GetClusters": func(r interface{}) (string, bool) {
+		return r.(*pb.GetAerospikeClustersByNamespaceRequest).AerospikeNamespace, true
+	},
+	"/messages.AerostationAerospikeAPI/UpdateCluster": func(r interface{}) (string, bool) {
+		return r.(*pb.UpdateAerospikeClusterRequest).ClusterNamespace, true
+	},
+	"/messages.AerostationAerospikeAPI/DeleteCluster": func(r interface{}) (string, bool) {
+		return r.(*pb.DeleteAerospikeClusterRequest).AerospikeNamespace, true
+	},
+	"/messages.AerostationAerospikeAPI/WatchDatabases": func(r interface{}) (string, bool) {
+		return r.(*pb.WatchDatabasesRequest).Namespace, true
+	},
+	"/messages.v2.AerostationAerospikeAPI/GetDatabase": func(r interface{}) (string, bool) {
+		return r.(*pbv2.GetDatabaseRequest).Namespace, true
+	},
+	"/messages.v2.AerostationAerospikeAPI/ListDatabases": func(r interface{}) (string, bool) {
+		return r.(*pbv2.ListDatabasesRequest).Namespace, true
+	},
+	"/messages.AerostationOperationsAPI/GetOperation": func(r interface{}) (string, bool) {
+		return r.(*pb.GetOperationRequest).Namespace, true
+	},
+	"/messages.AerostationOperationsAPI/ListOperations": func(r interface{}) (string, bool) {
+		return r.(*pb.ListOperationsRequest).Namespace, true
+	},
+	"/messages.AerostationKubernetesAPI/MapRegionToCluster": func(r interface{}) (string, bool) {
+		return r.(*pb.MapRegionToClusterRequest).UserNamespace, true
+	},
+	// the shared clusters are not owned by a tenant
+	"/messages.AerostationKubernetesAPI/MapRegionToSharedCluster": func(r interface{}) (string, bool) {
+		return "", false
+	},
+	// the namespace is the one of the cluster, the server checks that the tenant may use the cluster
+	"/messages.AerostationKubernetesAPI/GetKubeconfig": func(r interface{}) (string, bool) {
+		return "", false
+	},
+}
+
+// authorizeTenant rejects the calls made for a tenant outside of its namespace, calls that are not made for a tenant
+// are the calls of admins and pass
+func authorizeTenant(ctx context.Context, method string, request interface{}) error {
+	namespace, ok := tenant.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	scope, ok := tenantMethods[method]
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "Unable to call %s: not allowed for tenants", method)
+	}
+	if requested, scoped := scope(request); scoped && requested != namespace {
+		return status.Errorf(codes.PermissionDenied, "Unable to call %s: namespace %q is not the tenant namespace", method, requested)
+	}
+	return nil
+}
+
+// TenantUnaryInterceptor scopes the unary calls made for a tenant to its namespace
+func TenantUnaryInterceptor(ctx context.Context, request interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if err := authorizeTenant(ctx, info.FullMethod, request); err != nil {
+		return nil, err
+	}
+	return handler(ctx, request)
+}
+
+// TenantStreamInterceptor scopes the streams opened for a tenant to its namespace, the request of a server stream is
+// authorized once it is received
+func TenantStreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := tenant.FromIncomingContext(stream.Context()); !ok {
+		return handler(srv, stream)
+	}
+	return handler(srv, &tenantStream{ServerStream: stream, method: info.FullMethod})
+}
+
+type tenantStream struct {
+	grpc.ServerStream
+	method string
+}
+
+func (s *tenantStream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	return authorizeTenant(s.Context(), s.method, m)
+}
+
+// authorizeTenantCluster rejects the clusters a tenant may not place a database on or get a kubeconfig of, only the
+// shared clusters and the clusters dedicated to the tenant are
+func authorizeTenantCluster(ctx context.Context, cluster *v1.AeroClusterManager) error {
+	namespace, ok := tenant.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	switch cluster.Labels[v1.PlacementLabel] {
+	case v1.PlacementShared:
+		return nil
+	case v1.PlacementDedicated:
+		if cluster.Labels[v1.TenantLabel] == namespace {
+			return nil
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "Unable to use cluster %s/%s: not available to the tenant", cluster.Namespace, cluster.Name)
+}
+
+// scopeTenantTarget keeps the databases a tenant places on a shared cluster in the tenant namespace of the workload
+// cluster, the target namespace defaults to it and any other is rejected. The tenant owns its dedicated clusters.
+func scopeTenantTarget(ctx context.Context, cluster *v1.AeroClusterManager, db *v1.AeroDatabase) error {
+	namespace, ok := tenant.FromIncomingContext(ctx)
+	if !ok || cluster.Labels[v1.PlacementLabel] == v1.PlacementDedicated {
+		return nil
+	}
+
+	if db.Spec.TargetNamespace == "" {
+		db.Spec.TargetNamespace = namespace
+		return nil
+	}
+	if db.Spec.TargetNamespace != namespace {
+		return status.Errorf(codes.PermissionDenied, "Unable to use namespace %q of shared cluster %s/%s: not the tenant namespace",
+			db.Spec.TargetNamespace, cluster.Namespace, cluster.Name)
+	}
+	return nil
+}
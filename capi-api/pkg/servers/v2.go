@@ -0,0 +1,123 @@
+package servers
+
+import (
+	"context"
+	"errors"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	"github.com/aerospike/aerostation/capi-api/pkg/convert"
+	"github.com/aerospike/aerostation/capi-api/pkg/listing"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubernetesServerV2 serves the typed clusters of the v2 api
+type KubernetesServerV2 struct {
+	pbv2.UnimplementedAerostationKubernetesAPIServer
+	Client client.Client
+}
+
+func NewKubernetesServerV2(client client.Client) *KubernetesServerV2 {
+	return &KubernetesServerV2{Client: client}
+}
+
+func (k *KubernetesServerV2) GetCluster(ctx context.Context, request *pbv2.GetClusterRequest) (*pbv2.Cluster, error) {
+	if request.Name == "" || request.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get cluster: name and namespace are required")
+	}
+
+	manager := &v1.AeroClusterManager{}
+	if err := k.Client.Get(ctx, client.ObjectKey{Name: request.Name, Namespace: request.Namespace}, manager); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "cluster not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get cluster %s | Reason : %s", request.Name, err.Error())
+	}
+
+	return convert.ClusterToProto(manager), nil
+}
+
+func (k *KubernetesServerV2) ListClusters(ctx context.Context, request *pbv2.ListClustersRequest) (*pbv2.ListClustersResponse, error) {
+	managers, next, err := listing.Clusters(ctx, k.Client, listing.Options{
+		Namespace:     request.Namespace,
+		LabelSelector: request.LabelSelector,
+		Provider:      request.Provider,
+		Region:        request.Region,
+		Phase:         request.Phase,
+		SortBy:        request.SortBy,
+		Limit:         request.Limit,
+		Continue:      request.Continue,
+	})
+	if err != nil {
+		return nil, listError("clusters", err)
+	}
+
+	res := &pbv2.ListClustersResponse{Continue: next}
+	for i := range managers {
+		res.Clusters = append(res.Clusters, convert.ClusterToProto(&managers[i]))
+	}
+	return res, nil
+}
+
+// AerospikeServerV2 serves the typed databases of the v2 api
+type AerospikeServerV2 struct {
+	pbv2.UnimplementedAerostationAerospikeAPIServer
+	Client client.Client
+}
+
+func NewAerospikeServerV2(client client.Client) *AerospikeServerV2 {
+	return &AerospikeServerV2{Client: client}
+}
+
+func (a *AerospikeServerV2) GetDatabase(ctx context.Context, request *pbv2.GetDatabaseRequest) (*pbv2.Database, error) {
+	if request.Name == "" || request.Namespace == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Unable to get database: name and namespace are required")
+	}
+
+	db := &v1.AeroDatabase{}
+	if err := a.Client.Get(ctx, client.ObjectKey{Name: request.Name, Namespace: request.Namespace}, db); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, status.Errorf(codes.NotFound, "database not found")
+		}
+		return nil, status.Errorf(codes.Internal, "Unable to get database %s | Reason : %s", request.Name, err.Error())
+	}
+
+	return convert.DatabaseToProto(db), nil
+}
+
+func (a *AerospikeServerV2) ListDatabases(ctx context.Context, request *pbv2.ListDatabasesRequest) (*pbv2.ListDatabasesResponse, error) {
+	dbs, next, err := listing.Databases(ctx, a.Client, listing.Options{
+		Namespace:     request.Namespace,
+		LabelSelector: request.LabelSelector,
+		Provider:      request.Provider,
+		Region:        request.Region,
+		Phase:         request.Phase,
+		Cluster:       request.Cluster,
+		SortBy:        request.SortBy,
+		Limit:         request.Limit,
+		Continue:      request.Continue,
+	})
+	if err != nil {
+		return nil, listError("databases", err)
+	}
+
+	res := &pbv2.ListDatabasesResponse{Continue: next}
+	for i := range dbs {
+		res.Databases = append(res.Databases, convert.DatabaseToProto(&dbs[i]))
+	}
+	return res, nil
+}
+
+// listError maps the errors of a list to the codes the clients act on, OutOfRange means list again from the first page
+func listError(resource string, err error) error {
+	switch {
+	case errors.Is(err, listing.ErrInvalid):
+		return status.Errorf(codes.InvalidArgument, "Unable to list %s | Reason : %s", resource, err.Error())
+	case errors.Is(err, listing.ErrExpired):
+		return status.Errorf(codes.OutOfRange, "Unable to list %s: %s, list again without a continue token", resource, err.Error())
+	}
+	return status.Errorf(codes.Internal, "Unable to list %s | Reason : %s", resource, err.Error())
+}
@@ -0,0 +1,83 @@
+package servers
+
+import (
+	"context"
+	"testing"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pbv2 "github.com/aerospike/aerostation/capi-api/messages/v2"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestServersV2(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	manager := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: "default"}}
+	manager.Spec.ClusterOptions = v1.ClusterOptions{Provider: "docker", DockerOptions: &v1.DockerOptions{}}
+	manager.Status.Phase = string(v1.ManagerPhaseProvisioned)
+
+	db := &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default"}}
+	db.Spec.Cluster = v1.ClusterKey{Name: "test-1", Namespace: "default"}
+	other := &v1.AeroDatabase{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "other"}}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(manager, db, other).Build()
+	kube := NewKubernetesServerV2(c)
+	aero := NewAerospikeServerV2(c)
+
+	cluster, err := kube.GetCluster(ctx, &pbv2.GetClusterRequest{Name: "test-1", Namespace: "default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cluster.Metadata.Name).To(Equal("test-1"))
+	g.Expect(cluster.Spec.ClusterOptions.Docker).NotTo(BeNil())
+	g.Expect(cluster.Status.Phase).To(Equal("Provisioned"))
+
+	_, err = kube.GetCluster(ctx, &pbv2.GetClusterRequest{Name: "missing", Namespace: "default"})
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+
+	_, err = kube.GetCluster(ctx, &pbv2.GetClusterRequest{Name: "test-1"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	clusters, err := kube.ListClusters(ctx, &pbv2.ListClustersRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters.Clusters).To(HaveLen(1))
+
+	database, err := aero.GetDatabase(ctx, &pbv2.GetDatabaseRequest{Name: "db", Namespace: "default"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(database.Spec.Cluster.Name).To(Equal("test-1"))
+
+	databases, err := aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{Namespace: "other"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(databases.Databases).To(HaveLen(1))
+	g.Expect(databases.Databases[0].Metadata.Namespace).To(Equal("other"))
+
+	databases, err = aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(databases.Databases).To(HaveLen(2))
+
+	// the databases are paged, a continue token resumes after the first page
+	databases, err = aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{Limit: 1, SortBy: "-name"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(databases.Databases).To(HaveLen(1))
+	g.Expect(databases.Continue).NotTo(BeEmpty())
+	_, err = aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{Continue: databases.Continue})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+	databases, err = aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{Limit: 1, SortBy: "-name", Continue: databases.Continue})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(databases.Databases).To(HaveLen(1))
+	g.Expect(databases.Continue).To(BeEmpty())
+
+	databases, err = aero.ListDatabases(ctx, &pbv2.ListDatabasesRequest{Provider: "docker", Cluster: "test-1"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(databases.Databases).To(HaveLen(1))
+
+	_, err = kube.ListClusters(ctx, &pbv2.ListClustersRequest{SortBy: "size"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
@@ -0,0 +1,127 @@
+package servers
+
+import (
+	"errors"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var eventTypes = map[watch.EventType]pb.WatchEventType{
+	watch.Added:     pb.WatchEventType_ADDED,
+	watch.Modified:  pb.WatchEventType_MODIFIED,
+	watch.Deleted:   pb.WatchEventType_DELETED,
+	watch.Heartbeat: pb.WatchEventType_HEARTBEAT,
+}
+
+// WatchClusters streams the changes of the clusters until the client goes away
+func (k *KubernetesServer) WatchClusters(request *pb.WatchClustersRequest, stream pb.AerostationKubernetesAPI_WatchClustersServer) error {
+	if k.Clusters == nil {
+		return status.Errorf(codes.Unimplemented, "watching clusters is not enabled")
+	}
+
+	err := k.Clusters.Stream(stream.Context(), streamOptions(request.Namespace, request.ResourceVersion, request.HeartbeatSeconds),
+		func(event watch.Event) error {
+			return stream.Send(clusterEvent(event))
+		})
+	return watchError(err)
+}
+
+// WatchDatabases streams the changes of the databases until the client goes away
+func (a *AerospikeServer) WatchDatabases(request *pb.WatchDatabasesRequest, stream pb.AerostationAerospikeAPI_WatchDatabasesServer) error {
+	if a.Databases == nil {
+		return status.Errorf(codes.Unimplemented, "watching databases is not enabled")
+	}
+
+	err := a.Databases.Stream(stream.Context(), streamOptions(request.Namespace, request.ResourceVersion, request.HeartbeatSeconds),
+		func(event watch.Event) error {
+			return stream.Send(databaseEvent(event))
+		})
+	return watchError(err)
+}
+
+func streamOptions(namespace, resourceVersion string, heartbeatSeconds int64) watch.StreamOptions {
+	return watch.StreamOptions{
+		Namespace:         namespace,
+		ResourceVersion:   resourceVersion,
+		HeartbeatInterval: time.Duration(heartbeatSeconds) * time.Second,
+	}
+}
+
+// watchError maps the errors of a stream to the codes the clients act on, OutOfRange means start over
+func watchError(err error) error {
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, watch.ErrResourceVersionTooOld):
+		return status.Errorf(codes.OutOfRange, "Unable to watch: %s, watch without a resource version", err.Error())
+	case errors.Is(err, watch.ErrInvalidResourceVersion):
+		return status.Errorf(codes.InvalidArgument, "Unable to watch: %s", err.Error())
+	case errors.Is(err, watch.ErrNotSynced):
+		return status.Errorf(codes.Unavailable, "Unable to watch: %s", err.Error())
+	case errors.Is(err, watch.ErrSubscriberTooSlow):
+		return status.Errorf(codes.Aborted, "Unable to watch: %s, resume from the last resource version", err.Error())
+	}
+
+	// errors of Send already carry a status
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Errorf(codes.Internal, "Unable to watch | Reason : %s", err.Error())
+}
+
+func clusterEvent(event watch.Event) *pb.ClusterEvent {
+	e := &pb.ClusterEvent{
+		Type:            eventTypes[event.Type],
+		ResourceVersion: event.ResourceVersion,
+	}
+
+	if manager, ok := event.Object.(*v1.AeroClusterManager); ok {
+		e.Name = manager.Name
+		e.Namespace = manager.Namespace
+		e.Phase = manager.Status.Phase
+		e.Conditions = conditions(manager.Status.Conditions)
+		e.KubeVersion = manager.Status.KubeVersion
+	}
+
+	return e
+}
+
+func databaseEvent(event watch.Event) *pb.DatabaseEvent {
+	e := &pb.DatabaseEvent{
+		Type:            eventTypes[event.Type],
+		ResourceVersion: event.ResourceVersion,
+	}
+
+	if db, ok := event.Object.(*v1.AeroDatabase); ok {
+		e.Name = db.Name
+		e.Namespace = db.Namespace
+		e.Phase = db.Status.Phase
+		e.Conditions = conditions(db.Status.Conditions)
+		e.Cluster = &pb.NamespacedName{Name: db.Spec.Cluster.Name, Namespace: db.Spec.Cluster.Namespace}
+		e.Size = db.Status.Size
+		e.ReadyPods = db.Status.ReadyPods
+	}
+
+	return e
+}
+
+func conditions(conditions []metav1.Condition) []*pb.Condition {
+	out := make([]*pb.Condition, 0, len(conditions))
+	for _, c := range conditions {
+		out = append(out, &pb.Condition{
+			Type:               c.Type,
+			Status:             string(c.Status),
+			Reason:             c.Reason,
+			Message:            c.Message,
+			LastTransitionTime: c.LastTransitionTime.Unix(),
+			ObservedGeneration: c.ObservedGeneration,
+		})
+	}
+	return out
+}
@@ -0,0 +1,79 @@
+package servers
+
+import (
+	"fmt"
+	"testing"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	pb "github.com/aerospike/aerostation/capi-api/messages"
+	"github.com/aerospike/aerostation/capi-api/pkg/watch"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWatchError(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(watchError(nil)).To(Succeed())
+	g.Expect(status.Code(watchError(watch.ErrResourceVersionTooOld))).To(Equal(codes.OutOfRange))
+	g.Expect(status.Code(watchError(watch.ErrInvalidResourceVersion))).To(Equal(codes.InvalidArgument))
+	g.Expect(status.Code(watchError(watch.ErrNotSynced))).To(Equal(codes.Unavailable))
+	g.Expect(status.Code(watchError(watch.ErrSubscriberTooSlow))).To(Equal(codes.Aborted))
+	g.Expect(status.Code(watchError(status.Error(codes.Canceled, "gone")))).To(Equal(codes.Canceled))
+	g.Expect(status.Code(watchError(fmt.Errorf("boom")))).To(Equal(codes.Internal))
+}
+
+func TestClusterEvent(t *testing.T) {
+	g := NewWithT(t)
+
+	manager := &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: "default", ResourceVersion: "42"},
+	}
+	manager.Status.Phase = "Provisioned"
+	manager.Status.KubeVersion = "v1.22.2"
+	manager.Status.Conditions = []metav1.Condition{{
+		Type:               "Ready",
+		Status:             metav1.ConditionTrue,
+		Reason:             "Provisioned",
+		LastTransitionTime: metav1.Unix(1600000000, 0),
+		ObservedGeneration: 3,
+	}}
+
+	event := clusterEvent(watch.Event{Type: watch.Modified, Object: manager, ResourceVersion: "42"})
+	g.Expect(event.Type).To(Equal(pb.WatchEventType_MODIFIED))
+	g.Expect(event.Name).To(Equal("test-1"))
+	g.Expect(event.Namespace).To(Equal("default"))
+	g.Expect(event.ResourceVersion).To(Equal("42"))
+	g.Expect(event.Phase).To(Equal("Provisioned"))
+	g.Expect(event.KubeVersion).To(Equal("v1.22.2"))
+	g.Expect(event.Conditions).To(HaveLen(1))
+	g.Expect(event.Conditions[0].Status).To(Equal("True"))
+	g.Expect(event.Conditions[0].LastTransitionTime).To(Equal(int64(1600000000)))
+	g.Expect(event.Conditions[0].ObservedGeneration).To(Equal(int64(3)))
+
+	heartbeat := clusterEvent(watch.Event{Type: watch.Heartbeat, ResourceVersion: "43"})
+	g.Expect(heartbeat.Type).To(Equal(pb.WatchEventType_HEARTBEAT))
+	g.Expect(heartbeat.ResourceVersion).To(Equal("43"))
+	g.Expect(heartbeat.Name).To(BeEmpty())
+}
+
+func TestDatabaseEvent(t *testing.T) {
+	g := NewWithT(t)
+
+	db := &v1.AeroDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", ResourceVersion: "7"},
+	}
+	db.Spec.Cluster = v1.ClusterKey{Name: "test-1", Namespace: "default"}
+	db.Status.Phase = "Running"
+	db.Status.Size = 3
+	db.Status.ReadyPods = 2
+
+	event := databaseEvent(watch.Event{Type: watch.Deleted, Object: db, ResourceVersion: "7"})
+	g.Expect(event.Type).To(Equal(pb.WatchEventType_DELETED))
+	g.Expect(event.Cluster.Name).To(Equal("test-1"))
+	g.Expect(event.Size).To(Equal(int32(3)))
+	g.Expect(event.ReadyPods).To(Equal(int32(2)))
+	g.Expect(event.Conditions).To(BeEmpty())
+}
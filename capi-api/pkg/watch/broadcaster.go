@@ -0,0 +1,367 @@
+// Package watch fans the events of a controller-runtime informer out to the watch streams of the gRPC services.
+package watch
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"time"
+
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// EventType is the kind of change of a watched object
+type EventType string
+
+const (
+	Added    EventType = "ADDED"
+	Modified EventType = "MODIFIED"
+	Deleted  EventType = "DELETED"
+	// Heartbeat has no object, its resource version can be used to resume the watch
+	Heartbeat EventType = "HEARTBEAT"
+)
+
+const (
+	// DefaultHistorySize is how many events are kept to resume watches from
+	DefaultHistorySize = 1000
+
+	// DefaultHeartbeatInterval is how often a heartbeat is sent when none is requested
+	DefaultHeartbeatInterval = 30 * time.Second
+
+	// subscriberBuffer is how many events a stream can fall behind before it is closed
+	subscriberBuffer = 100
+)
+
+var (
+	// ErrNotSynced is returned until the informer cache has synced
+	ErrNotSynced = errors.New("watch cache is not synced yet")
+
+	// ErrResourceVersionTooOld is returned when the events after the resource version are no longer kept,
+	// the client has to start a new watch without a resource version
+	ErrResourceVersionTooOld = errors.New("resource version is too old")
+
+	// ErrInvalidResourceVersion is returned for resource versions that are not from this api server
+	ErrInvalidResourceVersion = errors.New("invalid resource version")
+
+	// ErrSubscriberTooSlow closes the streams that do not keep up with the events
+	ErrSubscriberTooSlow = errors.New("watch stream fell behind")
+)
+
+// Event is a change of an object, or a heartbeat
+type Event struct {
+	Type            EventType
+	Object          client.Object
+	ResourceVersion string
+}
+
+// Broadcaster keeps the objects of an informer and the latest events, so new watches start with a consistent
+// snapshot and interrupted ones can be resumed from a resource version.
+type Broadcaster struct {
+	// ListResourceVersion returns the resource version of the last list of the informer, it is the resource version
+	// of the deletes the informer missed while it was disconnected. The stale one of the object is used if nil.
+	ListResourceVersion func() string
+
+	mu          sync.Mutex
+	historySize int
+	history     []Event
+	// events after floor are all in history
+	floor       uint64
+	latest      string
+	synced      bool
+	objects     map[string]client.Object
+	subscribers map[*Subscription]struct{}
+}
+
+// NewBroadcaster returns a Broadcaster that keeps historySize events, its Handler has to be added to the informer
+func NewBroadcaster(historySize int) *Broadcaster {
+	return &Broadcaster{
+		historySize: historySize,
+		objects:     map[string]client.Object{},
+		subscribers: map[*Subscription]struct{}{},
+	}
+}
+
+// Handler returns the event handler to add to the informer
+func (b *Broadcaster) Handler() toolscache.ResourceEventHandler {
+	return toolscache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if o, ok := obj.(client.Object); ok {
+				b.update(o)
+			}
+		},
+		UpdateFunc: func(_, obj interface{}) {
+			if o, ok := obj.(client.Object); ok {
+				b.update(o)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				if o, ok := tombstone.Obj.(client.Object); ok {
+					b.delete(b.missedDelete(o))
+				}
+				return
+			}
+			if o, ok := obj.(client.Object); ok {
+				b.delete(o)
+			}
+		},
+	}
+}
+
+// Synced seeds the broadcaster with the objects of the synced informer cache, watches can be started afterwards.
+// The informer may deliver the events of these objects after this, they are dropped.
+func (b *Broadcaster) Synced(objects []client.Object) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, obj := range objects {
+		key := objectKey(obj)
+		if known, ok := b.objects[key]; !ok || isNewer(obj, known) {
+			b.objects[key] = obj
+		}
+	}
+
+	for _, obj := range b.objects {
+		if rv := parseResourceVersion(obj); rv > b.floor {
+			b.floor = rv
+			b.latest = obj.GetResourceVersion()
+		}
+	}
+	b.synced = true
+}
+
+func (b *Broadcaster) update(obj client.Object) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := objectKey(obj)
+	known, ok := b.objects[key]
+	// resyncs and events that are already part of the synced snapshot
+	if ok && !isNewer(obj, known) {
+		return
+	}
+	b.objects[key] = obj
+
+	eventType := Added
+	if ok {
+		eventType = Modified
+	}
+	b.record(Event{Type: eventType, Object: obj, ResourceVersion: obj.GetResourceVersion()})
+}
+
+func (b *Broadcaster) delete(obj client.Object) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	key := objectKey(obj)
+	if _, ok := b.objects[key]; !ok {
+		return
+	}
+	delete(b.objects, key)
+
+	b.record(Event{Type: Deleted, Object: obj, ResourceVersion: obj.GetResourceVersion()})
+}
+
+// missedDelete returns a copy of an object deleted while the informer was disconnected, at the resource version of
+// the list it learnt the deletion from. The object of the tombstone has the resource version it was last seen at, which
+// is older than the events already sent: resuming from it would send them again.
+func (b *Broadcaster) missedDelete(obj client.Object) client.Object {
+	if b.ListResourceVersion == nil {
+		return obj
+	}
+	rv := b.ListResourceVersion()
+	if parsed, err := strconv.ParseUint(rv, 10, 64); err != nil || parsed <= parseResourceVersion(obj) {
+		return obj
+	}
+
+	deleted := obj.DeepCopyObject().(client.Object)
+	deleted.SetResourceVersion(rv)
+	return deleted
+}
+
+// record keeps the event and sends it to the subscribers, b.mu has to be held
+func (b *Broadcaster) record(event Event) {
+	if !b.synced {
+		return
+	}
+
+	b.history = append(b.history, event)
+	if len(b.history) > b.historySize {
+		b.floor = parseResourceVersion(b.history[0].Object)
+		b.history = b.history[1:]
+	}
+	// the deletes with a stale resource version do not move the heartbeats back
+	if rv, err := strconv.ParseUint(event.ResourceVersion, 10, 64); err != nil || rv > b.latestResourceVersion() {
+		b.latest = event.ResourceVersion
+	}
+
+	for s := range b.subscribers {
+		select {
+		case s.events <- event:
+		default:
+			s.err = ErrSubscriberTooSlow
+			b.unsubscribe(s)
+		}
+	}
+}
+
+func (b *Broadcaster) latestResourceVersion() uint64 {
+	rv, _ := strconv.ParseUint(b.latest, 10, 64)
+	return rv
+}
+
+// Subscription receives the events of a Broadcaster after the ones returned by Subscribe
+type Subscription struct {
+	events chan Event
+	err    error
+}
+
+// Events is closed when the subscription ends, Err tells why
+func (s *Subscription) Events() <-chan Event {
+	return s.events
+}
+
+// Err is the reason the broadcaster ended the subscription, nil if it was unsubscribed
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+// Subscribe starts receiving events. Without a resource version the returned events are an ADDED event per object,
+// with one they are the events since that resource version. The last event is a heartbeat with the resource version
+// the events are current to.
+func (b *Broadcaster) Subscribe(resourceVersion string) (*Subscription, []Event, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.synced {
+		return nil, nil, ErrNotSynced
+	}
+
+	var events []Event
+	if resourceVersion == "" {
+		for _, obj := range b.objects {
+			events = append(events, Event{Type: Added, Object: obj, ResourceVersion: obj.GetResourceVersion()})
+		}
+	} else {
+		rv, err := strconv.ParseUint(resourceVersion, 10, 64)
+		if err != nil {
+			return nil, nil, ErrInvalidResourceVersion
+		}
+		if rv < b.floor {
+			return nil, nil, ErrResourceVersionTooOld
+		}
+		for _, event := range b.history {
+			if parseResourceVersion(event.Object) > rv {
+				events = append(events, event)
+			}
+		}
+	}
+	events = append(events, Event{Type: Heartbeat, ResourceVersion: b.latest})
+
+	s := &Subscription{events: make(chan Event, subscriberBuffer)}
+	b.subscribers[s] = struct{}{}
+
+	return s, events, nil
+}
+
+// Unsubscribe stops sending events to the subscription and closes its channel
+func (b *Broadcaster) Unsubscribe(s *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.unsubscribe(s)
+}
+
+func (b *Broadcaster) unsubscribe(s *Subscription) {
+	if _, ok := b.subscribers[s]; ok {
+		delete(b.subscribers, s)
+		close(s.events)
+	}
+}
+
+// StreamOptions select the events of a Stream
+type StreamOptions struct {
+	// Namespace of the objects, all namespaces if empty
+	Namespace string
+	// ResourceVersion to resume from, see Subscribe
+	ResourceVersion string
+	// HeartbeatInterval is DefaultHeartbeatInterval if 0
+	HeartbeatInterval time.Duration
+}
+
+// Stream sends the events until the context is done or send fails. Heartbeats carry the resource version of the
+// last event the stream has seen, including the ones of other namespaces.
+func (b *Broadcaster) Stream(ctx context.Context, opts StreamOptions, send func(Event) error) error {
+	s, events, err := b.Subscribe(opts.ResourceVersion)
+	if err != nil {
+		return err
+	}
+	defer b.Unsubscribe(s)
+
+	var last string
+	for _, event := range events {
+		if event.Type == Heartbeat {
+			last = event.ResourceVersion
+		}
+		if !opts.matches(event) {
+			continue
+		}
+		if err := send(event); err != nil {
+			return err
+		}
+	}
+
+	interval := opts.HeartbeatInterval
+	if interval <= 0 {
+		interval = DefaultHeartbeatInterval
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case event, ok := <-s.Events():
+			if !ok {
+				return s.Err()
+			}
+			last = event.ResourceVersion
+			if !opts.matches(event) {
+				continue
+			}
+			if err := send(event); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			if err := send(Event{Type: Heartbeat, ResourceVersion: last}); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (o StreamOptions) matches(event Event) bool {
+	return event.Object == nil || o.Namespace == "" || event.Object.GetNamespace() == o.Namespace
+}
+
+func objectKey(obj client.Object) string {
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// isNewer compares the resource versions of two versions of an object
+func isNewer(obj, known client.Object) bool {
+	if obj.GetResourceVersion() == known.GetResourceVersion() {
+		return false
+	}
+	rv := parseResourceVersion(obj)
+	return rv == 0 || rv > parseResourceVersion(known)
+}
+
+// parseResourceVersion is 0 for resource versions that are not from etcd, those objects are never dropped
+func parseResourceVersion(obj client.Object) uint64 {
+	rv, _ := strconv.ParseUint(obj.GetResourceVersion(), 10, 64)
+	return rv
+}
@@ -0,0 +1,191 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	toolscache "k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func cluster(namespace, name, resourceVersion string) *v1.AeroClusterManager {
+	return &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, ResourceVersion: resourceVersion},
+	}
+}
+
+type eventSummary struct {
+	Type            EventType
+	Name            string
+	ResourceVersion string
+}
+
+func summarize(events []Event) []eventSummary {
+	summaries := []eventSummary{}
+	for _, event := range events {
+		summary := eventSummary{Type: event.Type, ResourceVersion: event.ResourceVersion}
+		if event.Object != nil {
+			summary.Name = event.Object.GetName()
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries
+}
+
+func TestBroadcasterSubscribe(t *testing.T) {
+	g := NewWithT(t)
+
+	b := NewBroadcaster(3)
+	handler := b.Handler()
+
+	_, _, err := b.Subscribe("")
+	g.Expect(err).To(Equal(ErrNotSynced))
+
+	// the informer delivers its initial list before and after the cache is synced
+	handler.OnAdd(cluster("default", "a", "10"))
+	b.Synced([]client.Object{cluster("default", "a", "10"), cluster("default", "b", "12")})
+	handler.OnAdd(cluster("default", "b", "12"))
+
+	_, events, err := b.Subscribe("")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(ConsistOf(
+		eventSummary{Added, "a", "10"},
+		eventSummary{Added, "b", "12"},
+		eventSummary{Heartbeat, "", "12"},
+	))
+	g.Expect(events[2].Type).To(Equal(Heartbeat), "the heartbeat is last")
+
+	// resyncs are not changes
+	handler.OnUpdate(cluster("default", "a", "10"), cluster("default", "a", "10"))
+	handler.OnUpdate(cluster("default", "a", "10"), cluster("default", "a", "13"))
+	handler.OnAdd(cluster("default", "c", "14"))
+	handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "default/b", Obj: cluster("default", "b", "15")})
+
+	_, events, err = b.Subscribe("12")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(Equal([]eventSummary{
+		{Modified, "a", "13"},
+		{Added, "c", "14"},
+		{Deleted, "b", "15"},
+		{Heartbeat, "", "15"},
+	}))
+
+	// the history has 3 events, 13 is dropped by the next one
+	handler.OnUpdate(cluster("default", "c", "14"), cluster("default", "c", "16"))
+	_, _, err = b.Subscribe("12")
+	g.Expect(err).To(Equal(ErrResourceVersionTooOld))
+
+	_, events, err = b.Subscribe("13")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(HaveLen(4))
+
+	_, _, err = b.Subscribe("not-a-number")
+	g.Expect(err).To(Equal(ErrInvalidResourceVersion))
+}
+
+func TestBroadcasterMissedDelete(t *testing.T) {
+	g := NewWithT(t)
+
+	listResourceVersion := "20"
+	b := NewBroadcaster(DefaultHistorySize)
+	b.ListResourceVersion = func() string { return listResourceVersion }
+	handler := b.Handler()
+	b.Synced([]client.Object{cluster("default", "a", "10"), cluster("default", "b", "12")})
+	handler.OnUpdate(cluster("default", "b", "12"), cluster("default", "b", "14"))
+
+	// a was deleted while the informer was disconnected, the list it relisted with is at 20
+	tombstone := cluster("default", "a", "10")
+	handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "default/a", Obj: tombstone})
+	g.Expect(tombstone.ResourceVersion).To(Equal("10"), "the object of the informer is not modified")
+
+	_, events, err := b.Subscribe("14")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(Equal([]eventSummary{
+		{Deleted, "a", "20"},
+		{Heartbeat, "", "20"},
+	}))
+
+	// resuming from the delete does not send it again
+	_, events, err = b.Subscribe("20")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(Equal([]eventSummary{{Heartbeat, "", "20"}}))
+
+	// the stale resource version is kept when the list one is not newer, the heartbeats do not go back to it
+	s, _, err := b.Subscribe("20")
+	g.Expect(err).NotTo(HaveOccurred())
+	listResourceVersion = ""
+	handler.OnDelete(toolscache.DeletedFinalStateUnknown{Key: "default/b", Obj: cluster("default", "b", "14")})
+	g.Expect(s.Events()).To(Receive(WithTransform(summarizeOne, Equal(eventSummary{Deleted, "b", "14"}))))
+	_, events, err = b.Subscribe("20")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(summarize(events)).To(Equal([]eventSummary{{Heartbeat, "", "20"}}))
+}
+
+func TestBroadcasterSlowSubscriber(t *testing.T) {
+	g := NewWithT(t)
+
+	b := NewBroadcaster(DefaultHistorySize)
+	b.Synced(nil)
+
+	s, _, err := b.Subscribe("")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	for i := 0; i <= subscriberBuffer; i++ {
+		b.Handler().OnAdd(cluster("default", "c", ""))
+		b.Handler().OnDelete(cluster("default", "c", ""))
+	}
+
+	received := 0
+	for range s.Events() {
+		received++
+	}
+	g.Expect(received).To(Equal(subscriberBuffer))
+	g.Expect(s.Err()).To(Equal(ErrSubscriberTooSlow))
+}
+
+func TestBroadcasterStream(t *testing.T) {
+	g := NewWithT(t)
+
+	b := NewBroadcaster(DefaultHistorySize)
+	b.Synced([]client.Object{cluster("team-a", "a", "1"), cluster("team-b", "b", "2")})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events := make(chan Event, 10)
+	done := make(chan error)
+	go func() {
+		done <- b.Stream(ctx, StreamOptions{Namespace: "team-a", HeartbeatInterval: 50 * time.Millisecond}, func(event Event) error {
+			events <- event
+			return nil
+		})
+	}()
+
+	g.Eventually(events).Should(Receive(WithTransform(summarizeOne, Equal(eventSummary{Added, "a", "1"}))))
+	g.Eventually(events).Should(Receive(WithTransform(summarizeOne, Equal(eventSummary{Heartbeat, "", "2"}))))
+
+	// events of other namespaces are not sent, but move the resource version of the heartbeats
+	b.Handler().OnUpdate(cluster("team-b", "b", "2"), cluster("team-b", "b", "3"))
+	g.Eventually(events).Should(Receive(WithTransform(summarizeOne, Equal(eventSummary{Heartbeat, "", "3"}))))
+
+	b.Handler().OnUpdate(cluster("team-a", "a", "1"), cluster("team-a", "a", "4"))
+	g.Eventually(events).Should(Receive(WithTransform(summarizeOne, Equal(eventSummary{Modified, "a", "4"}))))
+
+	cancel()
+	g.Eventually(done).Should(Receive(BeNil()))
+
+	// the stream ends with the error of send
+	sendErr := errors.New("client went away")
+	err := b.Stream(context.Background(), StreamOptions{}, func(Event) error { return sendErr })
+	g.Expect(err).To(Equal(sendErr))
+}
+
+func summarizeOne(event Event) eventSummary {
+	return summarize([]Event{event})[0]
+}
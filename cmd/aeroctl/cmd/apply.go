@@ -0,0 +1,336 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/yaml"
+)
+
+// Supported values of --dry-run
+const (
+	dryRunNone   = "none"
+	dryRunClient = "client"
+	dryRunServer = "server"
+)
+
+type applyManifestOptions struct {
+	filenames []string
+	namespace string
+	dryRun    string
+}
+
+// manifest is an object that can be applied, it is defaulted and validated like the webhooks do
+type manifest interface {
+	client.Object
+	webhook.Defaulter
+	webhook.Validator
+}
+
+var applyOptions = &applyManifestOptions{}
+
+func init() {
+	applyCmd.Flags().StringVar(&kubeOptions.kubeconfig, "kubeconfig", "~/.kube/config", "path to kubeconfig file to upload")
+	applyCmd.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "", "kube context to use")
+	applyCmd.Flags().StringVarP(&applyOptions.namespace, "namespace", "n", metav1.NamespaceDefault, "namespace of the objects that do not set one")
+
+	applyCmd.Flags().StringSliceVarP(&applyOptions.filenames, "filename", "f", nil, "manifest files to apply, - reads from stdin")
+	applyCmd.Flags().StringVar(&applyOptions.dryRun, "dry-run", dryRunNone, "one of none|client|server, client and server print a diff instead of applying")
+	applyCmd.Flags().Lookup("dry-run").NoOptDefVal = dryRunClient
+	_ = applyCmd.MarkFlagRequired("filename")
+}
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f FILENAME",
+	Short: "create or update the clusters and databases of a manifest",
+	Example: Examples(`
+		# Create or update the clusters and databases of my-db.yaml.
+		aeroctl apply -f my-db.yaml
+
+		# Show what would change, with the objects defaulted and validated by the webhooks.
+		aeroctl apply -f my-db.yaml --dry-run=server
+		`),
+	Args: cobra.NoArgs,
+	RunE: func(c *cobra.Command, args []string) error {
+		return runApply(os.Stdout)
+	},
+}
+
+func runApply(out io.Writer) error {
+	switch applyOptions.dryRun {
+	case dryRunNone, dryRunClient, dryRunServer:
+	default:
+		return fmt.Errorf("invalid --dry-run %q, must be one of %s|%s|%s", applyOptions.dryRun, dryRunNone, dryRunClient, dryRunServer)
+	}
+
+	// every manifest is decoded before the first one is applied
+	var manifests []manifest
+	for _, filename := range applyOptions.filenames {
+		data, err := readManifestFile(filename)
+		if err != nil {
+			return err
+		}
+		decoded, err := decodeManifests(data, applyOptions.namespace)
+		if err != nil {
+			return errors.Wrapf(err, "decoding %s", filename)
+		}
+		manifests = append(manifests, decoded...)
+	}
+
+	kubeClient, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	for _, m := range manifests {
+		if err := applyManifest(ctx, kubeClient, m, applyOptions.dryRun, out); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func readManifestFile(filename string) ([]byte, error) {
+	if filename == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(filename)
+}
+
+// decodeManifests converts the documents of a multidoc YAML to AeroClusterManagers and AeroDatabases,
+// the objects without a namespace are put in namespace
+func decodeManifests(data []byte, namespace string) ([]manifest, error) {
+	var manifests []manifest
+
+	// stops the decoding when a document is rejected
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	chanObj, chanErr := utils.DecodeYAML(ctx, data)
+	for {
+		select {
+		case obj := <-chanObj:
+			if obj == nil {
+				return manifests, nil
+			}
+			m, err := toManifest(obj)
+			if err != nil {
+				return nil, err
+			}
+			if m.GetNamespace() == "" {
+				m.SetNamespace(namespace)
+			}
+			manifests = append(manifests, m)
+		case err := <-chanErr:
+			if err == nil {
+				return manifests, nil
+			}
+			return nil, err
+		}
+	}
+}
+
+func toManifest(obj *unstructured.Unstructured) (manifest, error) {
+	var m manifest
+
+	gvk := obj.GroupVersionKind()
+	switch gvk {
+	case v1.GroupVersion.WithKind("AeroClusterManager"):
+		m = &v1.AeroClusterManager{}
+	case v1.GroupVersion.WithKind("AeroDatabase"):
+		m = &v1.AeroDatabase{}
+	default:
+		return nil, fmt.Errorf("%s %q can not be applied, only AeroClusterManager and AeroDatabase are supported",
+			gvk.Kind, obj.GetName())
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, m); err != nil {
+		return nil, errors.Wrapf(err, "decoding %s %q", gvk.Kind, obj.GetName())
+	}
+
+	return m, nil
+}
+
+// applyManifest creates the object or patches its spec, labels and annotations. With a dry run
+// nothing is persisted and the diff against the live object is printed instead.
+func applyManifest(ctx context.Context, c client.Client, desired manifest, dryRun string, out io.Writer) error {
+	gvk, err := apiutil.GVKForObject(desired, Scheme)
+	if err != nil {
+		return err
+	}
+	resource := strings.ToLower(gvk.Kind) + "." + gvk.Group + "/" + desired.GetName()
+
+	var (
+		createOpts []client.CreateOption
+		patchOpts  []client.PatchOption
+	)
+	if dryRun == dryRunServer {
+		createOpts = append(createOpts, client.DryRunAll)
+		patchOpts = append(patchOpts, client.DryRunAll)
+	}
+
+	obj, err := Scheme.New(gvk)
+	if err != nil {
+		return err
+	}
+	live := obj.(manifest)
+
+	// the webhook does the same, this fails before anything is sent
+	desired.Default()
+
+	err = c.Get(ctx, client.ObjectKeyFromObject(desired), live)
+	if apierrors.IsNotFound(err) {
+		if err := desired.ValidateCreate(); err != nil {
+			return err
+		}
+		if dryRun != dryRunClient {
+			if err := c.Create(ctx, desired, createOpts...); err != nil {
+				return err
+			}
+		}
+		return printApplied(out, resource, "created", dryRun, nil, desired)
+	}
+	if err != nil {
+		return err
+	}
+
+	updated := mergeManifest(live, desired)
+	if err := updated.ValidateUpdate(live); err != nil {
+		return err
+	}
+	if equality.Semantic.DeepEqual(live, updated) {
+		return printApplied(out, resource, "unchanged", dryRun, nil, nil)
+	}
+
+	if dryRun != dryRunClient {
+		if err := c.Patch(ctx, updated, client.MergeFrom(live), patchOpts...); err != nil {
+			return err
+		}
+	}
+
+	return printApplied(out, resource, "configured", dryRun, live, updated)
+}
+
+// mergeManifest returns the live object with the spec of desired and its labels and annotations added
+func mergeManifest(live, desired manifest) manifest {
+	var updated manifest
+
+	switch l := live.(type) {
+	case *v1.AeroClusterManager:
+		m := l.DeepCopy()
+		m.Spec = desired.(*v1.AeroClusterManager).Spec
+		updated = m
+	case *v1.AeroDatabase:
+		db := l.DeepCopy()
+		db.Spec = desired.(*v1.AeroDatabase).Spec
+		updated = db
+	}
+
+	updated.SetLabels(mergeStrings(live.GetLabels(), desired.GetLabels()))
+	updated.SetAnnotations(mergeStrings(live.GetAnnotations(), desired.GetAnnotations()))
+
+	return updated
+}
+
+func mergeStrings(live, desired map[string]string) map[string]string {
+	if len(desired) == 0 {
+		return live
+	}
+
+	merged := make(map[string]string, len(live)+len(desired))
+	for k, v := range live {
+		merged[k] = v
+	}
+	for k, v := range desired {
+		merged[k] = v
+	}
+
+	return merged
+}
+
+func printApplied(out io.Writer, resource, result, dryRun string, live, applied client.Object) error {
+	if dryRun == dryRunNone {
+		fmt.Fprintf(out, "%s %s\n", resource, result)
+		return nil
+	}
+
+	if applied != nil {
+		diff, err := diffObjects(resource, live, applied)
+		if err != nil {
+			return err
+		}
+		fmt.Fprint(out, diff)
+	}
+	fmt.Fprintf(out, "%s %s (%s dry run)\n", resource, result, dryRun)
+
+	return nil
+}
+
+// diffObjects returns the unified diff of the YAML of live and applied, live is nil for new objects
+func diffObjects(resource string, live, applied client.Object) (string, error) {
+	from, err := diffYAML(live)
+	if err != nil {
+		return "", err
+	}
+	to, err := diffYAML(applied)
+	if err != nil {
+		return "", err
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from),
+		B:        difflib.SplitLines(to),
+		FromFile: "live/" + resource,
+		ToFile:   "applied/" + resource,
+		Context:  3,
+	})
+}
+
+// diffYAML is the YAML of the fields that apply can change, the status and server managed metadata are left out
+func diffYAML(obj client.Object) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	gvk, err := apiutil.GVKForObject(obj, Scheme)
+	if err != nil {
+		return "", err
+	}
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+
+	u := &unstructured.Unstructured{Object: content}
+	u.SetGroupVersionKind(gvk)
+	unstructured.RemoveNestedField(u.Object, "status")
+	for _, f := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+
+	data, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const applyManifests = `
+apiVersion: aerostation.aerospike.com/v1
+kind: AeroClusterManager
+metadata:
+  name: test-1
+spec:
+  clusterOptions:
+    provider: docker
+    kubeversion: v1.21.2
+    replicas: 1
+---
+apiVersion: aerostation.aerospike.com/v1
+kind: AeroDatabase
+metadata:
+  name: test-db
+  namespace: team-a
+spec:
+  clusterKey:
+    name: test-1
+    namespace: default
+  options:
+    replicas: 3
+`
+
+func TestDecodeManifests(t *testing.T) {
+	g := NewWithT(t)
+
+	manifests, err := decodeManifests([]byte(applyManifests), "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(manifests).To(HaveLen(2))
+
+	manager, ok := manifests[0].(*v1.AeroClusterManager)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(manager.Namespace).To(Equal("default"))
+	g.Expect(manager.Spec.ClusterOptions.KubeVersion).To(Equal("v1.21.2"))
+
+	db, ok := manifests[1].(*v1.AeroDatabase)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(db.Namespace).To(Equal("team-a"))
+	g.Expect(db.Spec.Options.Replicas).To(Equal(int32(3)))
+
+	_, err = decodeManifests([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n"), "default")
+	g.Expect(err).To(MatchError(`ConfigMap "settings" can not be applied, only AeroClusterManager and AeroDatabase are supported`))
+
+	// the documents after a rejected one are not waited for
+	_, err = decodeManifests([]byte("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: settings\n---\n"+applyManifests), "default")
+	g.Expect(err).To(MatchError(ContainSubstring(`ConfigMap "settings" can not be applied`)))
+}
+
+func TestApplyManifest(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	manifests, err := decodeManifests([]byte(applyManifests), "default")
+	g.Expect(err).NotTo(HaveOccurred())
+	manager := manifests[0].(*v1.AeroClusterManager)
+
+	c := fake.NewClientBuilder().WithScheme(Scheme).Build()
+
+	// a client dry run prints the whole object as added and creates nothing
+	out := &bytes.Buffer{}
+	g.Expect(applyManifest(ctx, c, manager.DeepCopy(), dryRunClient, out)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("+++ applied/aeroclustermanager.aerostation.aerospike.com/test-1"))
+	g.Expect(out.String()).To(ContainSubstring("+    kubeversion: v1.21.2"))
+	g.Expect(out.String()).To(HaveSuffix("aeroclustermanager.aerostation.aerospike.com/test-1 created (client dry run)\n"))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(manager), &v1.AeroClusterManager{})).NotTo(Succeed())
+
+	out.Reset()
+	g.Expect(applyManifest(ctx, c, manager.DeepCopy(), dryRunNone, out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("aeroclustermanager.aerostation.aerospike.com/test-1 created\n"))
+
+	live := &v1.AeroClusterManager{}
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(manager), live)).To(Succeed())
+	g.Expect(live.Spec.ClusterID).To(Equal(v1.NamespacedName{Name: "test-1", Namespace: "default"}), "defaulted before create")
+
+	out.Reset()
+	g.Expect(applyManifest(ctx, c, manager.DeepCopy(), dryRunNone, out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("aeroclustermanager.aerostation.aerospike.com/test-1 unchanged\n"))
+
+	// the diff only has the changed lines of the spec
+	changed := manager.DeepCopy()
+	changed.Spec.ClusterOptions.Replicas = 2
+	out.Reset()
+	g.Expect(applyManifest(ctx, c, changed, dryRunClient, out)).To(Succeed())
+	g.Expect(out.String()).To(ContainSubstring("-    replicas: 1\n+    replicas: 2\n"))
+	g.Expect(out.String()).NotTo(ContainSubstring("resourceVersion"))
+	g.Expect(out.String()).To(HaveSuffix("aeroclustermanager.aerostation.aerospike.com/test-1 configured (client dry run)\n"))
+
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(manager), live)).To(Succeed())
+	g.Expect(live.Spec.ClusterOptions.Replicas).To(Equal(int32(1)))
+
+	out.Reset()
+	g.Expect(applyManifest(ctx, c, changed.DeepCopy(), dryRunNone, out)).To(Succeed())
+	g.Expect(out.String()).To(Equal("aeroclustermanager.aerostation.aerospike.com/test-1 configured\n"))
+	g.Expect(c.Get(ctx, client.ObjectKeyFromObject(manager), live)).To(Succeed())
+	g.Expect(live.Spec.ClusterOptions.Replicas).To(Equal(int32(2)))
+
+	// updates are validated against the live object
+	downgrade := changed.DeepCopy()
+	downgrade.Spec.ClusterOptions.KubeVersion = "v1.20.0"
+	g.Expect(applyManifest(ctx, c, downgrade, dryRunNone, out)).To(MatchError(ContainSubstring("downgrading from v1.21.2 to v1.20.0 is not supported")))
+}
@@ -39,14 +39,29 @@ func init() {
 	newCluster.Flags().StringVar(&provider, "provider", "eks", "docker,eks,aks,gke")
 	newCluster.Flags().StringVar(&kubeVersion, "kubeversion", "v1.22.0", "k8s version")
 	newCluster.Flags().IntVar(&createOptions.Replicas, "replicas", 3, "number of workers in the kubernetes cluster")
-	newCluster.Flags().StringVar(&createOptions.InstanceType, "instance-type", "t3.large", "instance to use")
-	newCluster.Flags().StringVar(&createOptions.Region, "region", "us-east-1", "the region")
+	newCluster.Flags().StringVar(&createOptions.InstanceType, "instance-type", "", "instance to use, t3.large (eks), Standard_D2s_v3 (aks) or e2-standard-4 (gke) if empty")
+	newCluster.Flags().StringVar(&createOptions.Region, "region", "", "the region, us-east-1 if empty (eks), required for aks and gke")
 	newCluster.Flags().StringVar(&createOptions.SSHKey, "ssh-key", "aerospike", "the aws ssh-key-file")
+	newCluster.Flags().StringVar(&createOptions.ResourceGroup, "resource-group", "", "the azure resource group (aks)")
+	newCluster.Flags().StringVar(&createOptions.SubscriptionID, "subscription-id", "", "the azure subscription (aks)")
+	newCluster.Flags().StringVar(&createOptions.IdentityName, "identity", "", "name of the AzureClusterIdentity (aks)")
+	newCluster.Flags().StringVar(&createOptions.IdentityNamespace, "identity-namespace", "default", "namespace of the AzureClusterIdentity (aks)")
+	newCluster.Flags().StringVar(&createOptions.SSHPublicKey, "ssh-public-key", "", "base64 encoded ssh public key for the nodes (aks)")
+	newCluster.Flags().StringVar(&createOptions.Project, "project", "", "the gcp project (gke)")
+	newCluster.Flags().StringVar(&createOptions.Network, "network", "", "the gcp vpc network, default if empty (gke)")
 
 	createCmd.AddCommand(newCluster)
 	createCmd.AddCommand(newdb)
 }
 
+// providerDefaults are the region and the instance type of the clusters of a provider when the flags are not set, the
+// region of the aks and gke clusters is required
+var providerDefaults = map[string]struct{ region, instanceType string }{
+	"eks": {"us-east-1", "t3.large"},
+	"aks": {"", "Standard_D2s_v3"},
+	"gke": {"", "e2-standard-4"},
+}
+
 var newCluster = &cobra.Command{
 	Use:   "cluster [cluster-name]",
 	Short: "create a cluster",
@@ -66,7 +81,6 @@ var newdb = &cobra.Command{
 }
 
 func runNewCluster(name string) error {
-	// todo: validate other fields like ssh-key, instance, region provided as input
 	if name == "" {
 		return errors.New("[ERROR] invalid input")
 	}
@@ -78,24 +92,9 @@ func runNewCluster(name string) error {
 	createOptions.Name = name
 	log.Printf("[INFO] aerostation creating workload cluster - %s - %s \n", name, provider)
 
-	clusterOptions := v1.ClusterOptions{
-		Name:        name,
-		Provider:    provider,
-		Replicas:    int32(createOptions.Replicas),
-		KubeVersion: kubeVersion,
-	}
-
-	switch provider {
-	case "docker":
-		clusterOptions.DockerOptions = &v1.DockerOptions{}
-	case "eks":
-		clusterOptions.EKSOptions = &v1.EKSOptions{
-			Region:       createOptions.Region,
-			InstanceType: createOptions.InstanceType,
-			SSHKey:       createOptions.SSHKey,
-		}
-	default:
-		return fmt.Errorf("unhandled provider type")
+	clusterOptions, err := newClusterOptions(name, provider, *createOptions)
+	if err != nil {
+		return err
 	}
 
 	cluster := &v1.AeroClusterManager{
@@ -115,6 +114,12 @@ func runNewCluster(name string) error {
 		},
 	}
 
+	// the webhook does the same, this fails before anything is sent
+	cluster.Default()
+	if err := cluster.ValidateCreate(); err != nil {
+		return err
+	}
+
 	if err = kubeClient.Create(context.TODO(), cluster); err != nil {
 		return err
 	}
@@ -124,6 +129,61 @@ func runNewCluster(name string) error {
 	return nil
 }
 
+// newClusterOptions returns the options of a cluster of a provider from the flags, the region and the instance type
+// default to the ones of the provider
+func newClusterOptions(name, provider string, opts clusterOptions) (v1.ClusterOptions, error) {
+	clusterOptions := v1.ClusterOptions{
+		Name:        name,
+		Provider:    provider,
+		Replicas:    int32(opts.Replicas),
+		KubeVersion: kubeVersion,
+	}
+
+	defaults := providerDefaults[provider]
+	if opts.Region == "" {
+		opts.Region = defaults.region
+	}
+	if opts.InstanceType == "" {
+		opts.InstanceType = defaults.instanceType
+	}
+
+	switch provider {
+	case "docker":
+		clusterOptions.DockerOptions = &v1.DockerOptions{}
+	case "eks":
+		clusterOptions.EKSOptions = &v1.EKSOptions{
+			Region:       opts.Region,
+			InstanceType: opts.InstanceType,
+			SSHKey:       opts.SSHKey,
+		}
+	case "aks":
+		// region and instance-type are the azure location and vm size
+		clusterOptions.AKSOptions = &v1.AKSOptions{
+			Location:       opts.Region,
+			VMSize:         opts.InstanceType,
+			ResourceGroup:  opts.ResourceGroup,
+			SubscriptionID: opts.SubscriptionID,
+			IdentityRef: v1.NamespacedName{
+				Name:      opts.IdentityName,
+				Namespace: opts.IdentityNamespace,
+			},
+			SSHPublicKey: opts.SSHPublicKey,
+		}
+	case "gke":
+		// instance-type is the gcp machine type
+		clusterOptions.GKEOptions = &v1.GKEOptions{
+			Project:     opts.Project,
+			Region:      opts.Region,
+			MachineType: opts.InstanceType,
+			Network:     opts.Network,
+		}
+	default:
+		return clusterOptions, fmt.Errorf("unhandled provider type")
+	}
+
+	return clusterOptions, nil
+}
+
 func runNewDatabase(name, cluster string) error {
 	c, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
 	if err != nil {
@@ -151,6 +211,11 @@ func runNewDatabase(name, cluster string) error {
 		},
 	}
 
+	db.Default()
+	if err := db.ValidateCreate(); err != nil {
+		return err
+	}
+
 	return c.Create(context.Background(), db)
 }
 
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+func TestNewClusterOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	eks, err := newClusterOptions("edge", "eks", clusterOptions{Replicas: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(eks.EKSOptions).To(Equal(&v1.EKSOptions{Region: "us-east-1", InstanceType: "t3.large"}))
+
+	// the aws defaults are not azure locations or vm sizes
+	aks, err := newClusterOptions("edge", "aks", clusterOptions{Replicas: 3})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(aks.AKSOptions.Location).To(BeEmpty())
+	g.Expect(aks.AKSOptions.VMSize).To(Equal("Standard_D2s_v3"))
+
+	gke, err := newClusterOptions("edge", "gke", clusterOptions{Region: "us-central1", InstanceType: "n2-standard-8"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(gke.GKEOptions.Region).To(Equal("us-central1"))
+	g.Expect(gke.GKEOptions.MachineType).To(Equal("n2-standard-8"))
+
+	_, err = newClusterOptions("edge", "vsphere", clusterOptions{})
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,304 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/printers"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+)
+
+// output formats printed as a table, everything else goes to the kubectl printers
+const (
+	outputTable = "table"
+	outputWide  = "wide"
+)
+
+type getOptions struct {
+	printFlags    *genericclioptions.PrintFlags
+	namespace     string
+	allNamespaces bool
+	cluster       string
+}
+
+func newGetOptions() *getOptions {
+	return &getOptions{
+		printFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(Scheme),
+	}
+}
+
+var (
+	getClustersOptions  = newGetOptions()
+	getDatabasesOptions = newGetOptions()
+)
+
+func init() {
+	for _, c := range []struct {
+		cmd     *cobra.Command
+		options *getOptions
+	}{
+		{getClusters, getClustersOptions},
+		{getDatabases, getDatabasesOptions},
+	} {
+		c.cmd.Flags().StringVar(&kubeOptions.kubeconfig, "kubeconfig", "~/.kube/config", "path to kubeconfig file to upload")
+		c.cmd.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "", "kube context to use")
+		c.cmd.Flags().StringVarP(&c.options.namespace, "namespace", "n", metav1.NamespaceDefault, "kube namespace to use")
+		c.cmd.Flags().BoolVarP(&c.options.allNamespaces, "all-namespaces", "A", false, "list the resources across all namespaces")
+		c.options.printFlags.AddFlags(c.cmd)
+		c.cmd.Flags().Lookup("output").Usage = "Output format. One of: table|wide|json|yaml|jsonpath=...|jsonpath-file=..."
+	}
+
+	getDatabases.Flags().StringVar(&getDatabasesOptions.cluster, "cluster", "", "only list the databases of this kubernetes cluster")
+
+	getCmd.AddCommand(getClusters)
+	getCmd.AddCommand(getDatabases)
+}
+
+var getClusters = &cobra.Command{
+	Use:     "clusters [cluster-name]",
+	Aliases: []string{"cluster"},
+	Short:   "list kubernetes clusters or get one by name",
+	Example: Examples(`
+		# List the clusters of all namespaces.
+		aeroctl get clusters -A
+
+		# Get the cluster named test-1 as yaml.
+		aeroctl get cluster test-1 -o yaml
+		`),
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return runGetClusters(os.Stdout, args)
+	},
+}
+
+var getDatabases = &cobra.Command{
+	Use:     "databases [database-name]",
+	Aliases: []string{"database", "db"},
+	Short:   "list aerospike databases or get one by name",
+	Example: Examples(`
+		# List the databases of the cluster test-1 with their image.
+		aeroctl get databases --cluster test-1 -o wide
+
+		# Get the phase of the database named test-db.
+		aeroctl get database test-db -o jsonpath='{.status.phase}'
+		`),
+	Args: cobra.MaximumNArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return runGetDatabases(os.Stdout, args)
+	},
+}
+
+func runGetClusters(out io.Writer, args []string) error {
+	kubeClient, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	o := getClustersOptions
+	ctx := context.Background()
+
+	if len(args) == 1 {
+		manager := &v1.AeroClusterManager{}
+		if err := kubeClient.Get(ctx, client.ObjectKey{Name: args[0], Namespace: o.namespace}, manager); err != nil {
+			return err
+		}
+		return o.print(out, manager, clustersTable([]v1.AeroClusterManager{*manager}))
+	}
+
+	managers := &v1.AeroClusterManagerList{}
+	if err := kubeClient.List(ctx, managers, o.listOptions()...); err != nil {
+		return err
+	}
+
+	return o.printList(out, managers, clustersTable(managers.Items), len(managers.Items))
+}
+
+func runGetDatabases(out io.Writer, args []string) error {
+	kubeClient, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	o := getDatabasesOptions
+	ctx := context.Background()
+
+	if len(args) == 1 {
+		db := &v1.AeroDatabase{}
+		if err := kubeClient.Get(ctx, client.ObjectKey{Name: args[0], Namespace: o.namespace}, db); err != nil {
+			return err
+		}
+		return o.print(out, db, databasesTable([]v1.AeroDatabase{*db}))
+	}
+
+	databases := &v1.AeroDatabaseList{}
+	if err := kubeClient.List(ctx, databases, o.listOptions()...); err != nil {
+		return err
+	}
+
+	if o.cluster != "" {
+		items := []v1.AeroDatabase{}
+		for _, db := range databases.Items {
+			if db.Spec.Cluster.Name == o.cluster {
+				items = append(items, db)
+			}
+		}
+		databases.Items = items
+	}
+
+	return o.printList(out, databases, databasesTable(databases.Items), len(databases.Items))
+}
+
+func (o *getOptions) listOptions() []client.ListOption {
+	if o.allNamespaces {
+		return nil
+	}
+
+	return []client.ListOption{client.InNamespace(o.namespace)}
+}
+
+func (o *getOptions) printList(out io.Writer, list runtime.Object, table *metav1.Table, count int) error {
+	if count == 0 && o.isTable() {
+		if o.allNamespaces {
+			fmt.Fprintln(os.Stderr, "No resources found")
+		} else {
+			fmt.Fprintf(os.Stderr, "No resources found in %s namespace.\n", o.namespace)
+		}
+		return nil
+	}
+
+	// the items of a list come back without their kind
+	err := meta.EachListItem(list, func(item runtime.Object) error {
+		gvk, err := apiutil.GVKForObject(item, Scheme)
+		if err != nil {
+			return err
+		}
+		item.GetObjectKind().SetGroupVersionKind(gvk)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return o.print(out, list, table)
+}
+
+func (o *getOptions) isTable() bool {
+	format := *o.printFlags.OutputFormat
+	return format == "" || format == outputTable || format == outputWide
+}
+
+// print writes the table for the table formats and the object for the formats of the kubectl printers
+func (o *getOptions) print(out io.Writer, obj runtime.Object, table *metav1.Table) error {
+	if o.isTable() {
+		printer := printers.NewTablePrinter(printers.PrintOptions{
+			Wide:          *o.printFlags.OutputFormat == outputWide,
+			WithNamespace: o.allNamespaces,
+		})
+		return printer.PrintObj(table, out)
+	}
+
+	printer, err := o.printFlags.ToPrinter()
+	if err != nil {
+		return err
+	}
+
+	return printer.PrintObj(obj, out)
+}
+
+// clustersTable has a row per cluster, the columns with priority 1 are only printed by -o wide
+func clustersTable(items []v1.AeroClusterManager) *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Provider", Type: "string"},
+			{Name: "Version", Type: "string"},
+			{Name: "Replicas", Type: "integer"},
+			{Name: "Phase", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "Running-Version", Type: "string", Priority: 1},
+			{Name: "Cluster-ID", Type: "string", Priority: 1},
+			{Name: "Operator", Type: "boolean", Priority: 1},
+			{Name: "Operator-Version", Type: "string", Priority: 1},
+		},
+	}
+
+	for i := range items {
+		manager := &items[i]
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				manager.Name,
+				manager.Spec.ClusterOptions.Provider,
+				manager.Spec.ClusterOptions.KubeVersion,
+				manager.Spec.ClusterOptions.Replicas,
+				manager.Status.Phase,
+				age(manager.CreationTimestamp),
+				manager.Status.KubeVersion,
+				manager.Spec.ClusterID.Namespace + "/" + manager.Spec.ClusterID.Name,
+				manager.Status.AerospikeOperator.Running,
+				manager.Status.AerospikeOperator.Version,
+			},
+			Object: runtime.RawExtension{Object: manager},
+		})
+	}
+
+	return table
+}
+
+// databasesTable has a row per database, the columns with priority 1 are only printed by -o wide
+func databasesTable(items []v1.AeroDatabase) *metav1.Table {
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Cluster", Type: "string"},
+			{Name: "Type", Type: "string"},
+			{Name: "Replicas", Type: "integer"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Phase", Type: "string"},
+			{Name: "Age", Type: "string"},
+			{Name: "Target-Namespace", Type: "string", Priority: 1},
+			{Name: "Image", Type: "string", Priority: 1},
+			{Name: "Operator-Phase", Type: "string", Priority: 1},
+		},
+	}
+
+	for i := range items {
+		db := &items[i]
+		table.Rows = append(table.Rows, metav1.TableRow{
+			Cells: []interface{}{
+				db.Name,
+				db.Spec.Cluster.Name,
+				db.Spec.DatabaseType,
+				db.Spec.Options.Replicas,
+				fmt.Sprintf("%d/%d", db.Status.ReadyPods, db.Status.Size),
+				db.Status.Phase,
+				age(db.CreationTimestamp),
+				db.Spec.TargetNamespace,
+				db.Status.Image,
+				db.Status.OperatorPhase,
+			},
+			Object: runtime.RawExtension{Object: db},
+		})
+	}
+
+	return table
+}
+
+func age(created metav1.Time) string {
+	if created.IsZero() {
+		return "<unknown>"
+	}
+
+	return duration.HumanDuration(time.Since(created.Time))
+}
@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func getCluster() v1.AeroClusterManager {
+	return v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1", Namespace: "team-a"},
+		Spec: v1.AeroClusterManagerSpec{
+			ClusterID: v1.NamespacedName{Name: "test-1", Namespace: "team-a"},
+			ClusterOptions: v1.ClusterOptions{
+				Provider:    v1.ProviderEKS,
+				KubeVersion: "v1.21.2",
+				Replicas:    3,
+			},
+		},
+		Status: v1.AeroClusterManagerStatus{
+			Phase:             string(v1.ManagerPhaseProvisioned),
+			KubeVersion:       "v1.21.2",
+			AerospikeOperator: v1.ApplicationStatus{Version: "2.0.0"},
+		},
+	}
+}
+
+func TestClustersTable(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, tc := range []struct {
+		output        string
+		allNamespaces bool
+		header        []string
+		row           []string
+	}{
+		{
+			output: "",
+			header: []string{"NAME", "PROVIDER", "VERSION", "REPLICAS", "PHASE", "AGE"},
+			row:    []string{"test-1", "eks", "v1.21.2", "3", "Provisioned", "<unknown>"},
+		},
+		{
+			output:        outputWide,
+			allNamespaces: true,
+			header:        []string{"NAMESPACE", "NAME", "PROVIDER", "VERSION", "REPLICAS", "PHASE", "AGE", "RUNNING-VERSION", "CLUSTER-ID", "OPERATOR", "OPERATOR-VERSION"},
+			row:           []string{"team-a", "test-1", "eks", "v1.21.2", "3", "Provisioned", "<unknown>", "v1.21.2", "team-a/test-1", "false", "2.0.0"},
+		},
+	} {
+		o := newGetOptions()
+		*o.printFlags.OutputFormat = tc.output
+		o.allNamespaces = tc.allNamespaces
+
+		list := &v1.AeroClusterManagerList{Items: []v1.AeroClusterManager{getCluster()}}
+		out := &bytes.Buffer{}
+		g.Expect(o.printList(out, list, clustersTable(list.Items), len(list.Items))).To(Succeed())
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		g.Expect(lines).To(HaveLen(2))
+		g.Expect(strings.Fields(lines[0])).To(Equal(tc.header))
+		g.Expect(strings.Fields(lines[1])).To(Equal(tc.row))
+	}
+}
+
+func TestDatabasesTable(t *testing.T) {
+	g := NewWithT(t)
+
+	o := newGetOptions()
+	*o.printFlags.OutputFormat = outputWide
+
+	db := statusDatabase()
+	out := &bytes.Buffer{}
+	g.Expect(o.print(out, db, databasesTable([]v1.AeroDatabase{*db}))).To(Succeed())
+
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	g.Expect(lines).To(HaveLen(2))
+	g.Expect(strings.Fields(lines[0])).To(Equal([]string{
+		"NAME", "CLUSTER", "TYPE", "REPLICAS", "READY", "PHASE", "AGE", "TARGET-NAMESPACE", "IMAGE", "OPERATOR-PHASE",
+	}))
+	g.Expect(strings.Fields(lines[1])).To(Equal([]string{
+		"test-db", "test-1", "memory", "2", "1/2", "Deployed", "<unknown>", "aerospike",
+		"aerospike/aerospike-server-enterprise:5.6.0.7",
+	}))
+}
+
+func TestPrintListJSON(t *testing.T) {
+	g := NewWithT(t)
+
+	o := newGetOptions()
+	*o.printFlags.OutputFormat = "json"
+
+	list := &v1.AeroClusterManagerList{Items: []v1.AeroClusterManager{getCluster()}}
+	out := &bytes.Buffer{}
+	g.Expect(o.printList(out, list, clustersTable(list.Items), len(list.Items))).To(Succeed())
+
+	// the printer needs the kind of the list and its items
+	g.Expect(out.String()).To(ContainSubstring(`"kind": "AeroClusterManagerList"`))
+	g.Expect(out.String()).To(ContainSubstring(`"kind": "AeroClusterManager"`))
+	g.Expect(out.String()).To(ContainSubstring(`"name": "test-1"`))
+}
@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	osuser "os/user"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/secrets"
+	"github.com/aerospike/aerostation/pkg/utils"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type getKubeconfigOptions struct {
+	namespace string
+	user      string
+	ttl       time.Duration
+	merge     bool
+}
+
+var kubeconfigOptions = &getKubeconfigOptions{}
+
+func init() {
+	getKubeconfig.Flags().StringVar(&kubeOptions.kubeconfig, "kubeconfig", "~/.kube/config", "path to kubeconfig file to upload")
+	getKubeconfig.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "", "kube context to use")
+	getKubeconfig.Flags().StringVarP(&kubeconfigOptions.namespace, "namespace", "n", metav1.NamespaceDefault, "kube namespace to use")
+
+	getKubeconfig.Flags().StringVar(&kubeconfigOptions.user, "user", "", "user the certificate is issued to, the local user if empty")
+	getKubeconfig.Flags().DurationVar(&kubeconfigOptions.ttl, "ttl", secrets.DefaultUserKubeconfigTTL,
+		fmt.Sprintf("how long the kubeconfig is valid, at most %s", secrets.MaxUserKubeconfigTTL))
+	getKubeconfig.Flags().BoolVar(&kubeconfigOptions.merge, "merge", false, "merge into the default kubeconfig and switch to its context instead of printing it")
+
+	kubeconfigCmd.AddCommand(getKubeconfig)
+}
+
+var getKubeconfig = &cobra.Command{
+	Use:   "get [cluster-name]",
+	Short: "get a short-lived kubeconfig of a workload cluster",
+	Long: `Issues a kubeconfig with a client certificate for the user, signed by the CA of the workload cluster.
+The user is in the aerostation:users group, which has the view ClusterRole of the workload cluster.
+The admin kubeconfig of the cluster is never handed out.`,
+	Example: Examples(`
+		# Print a kubeconfig of the cluster test-1 that is valid for an hour.
+		aeroctl kubeconfig get test-1 --ttl 1h > test-1.kubeconfig
+
+		# Add the cluster test-1 to the default kubeconfig and switch to it.
+		aeroctl kubeconfig get test-1 --merge
+		`),
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return runGetKubeconfig(os.Stdout, args[0])
+	},
+}
+
+func runGetKubeconfig(out io.Writer, name string) error {
+	// fail before anything is signed
+	if err := secrets.ValidateUserKubeconfigTTL(kubeconfigOptions.ttl); err != nil {
+		return err
+	}
+
+	user := kubeconfigOptions.user
+	if user == "" {
+		current, err := osuser.Current()
+		if err != nil {
+			return fmt.Errorf("unable to find the local user, use --user: %v", err)
+		}
+		user = current.Username
+	}
+
+	kubeClient, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	manager := &v1.AeroClusterManager{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Name: name, Namespace: kubeconfigOptions.namespace}, manager); err != nil {
+		return err
+	}
+	manager.Default()
+
+	data, expiresAt, err := utils.IssueUserKubeconfig(ctx, kubeClient, manager.Spec.ClusterID.ToObjectKey(), user, utils.KubeconfigScope{}, kubeconfigOptions.ttl)
+	if err != nil {
+		return err
+	}
+
+	if !kubeconfigOptions.merge {
+		fmt.Fprintf(os.Stderr, "kubeconfig of %s expires at %s\n", user, expiresAt.Local().Format(time.RFC1123))
+		_, err := out.Write(data)
+		return err
+	}
+
+	issued, err := clientcmd.Load(data)
+	if err != nil {
+		return err
+	}
+
+	pathOptions := clientcmd.NewDefaultPathOptions()
+	filename := pathOptions.GetDefaultFilename()
+	existing, err := pathOptions.GetStartingConfig()
+	if err != nil {
+		return err
+	}
+
+	if err := clientcmd.WriteToFile(*mergeKubeconfig(existing, issued), filename); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "Switched to context %q in %s, it expires at %s\n",
+		issued.CurrentContext, filename, expiresAt.Local().Format(time.RFC1123))
+	return nil
+}
+
+// mergeKubeconfig adds the cluster, user and context of issued to existing and makes its context the current one,
+// entries with the same name are replaced
+func mergeKubeconfig(existing, issued *api.Config) *api.Config {
+	merged := existing.DeepCopy()
+	if merged.Clusters == nil {
+		merged.Clusters = map[string]*api.Cluster{}
+	}
+	if merged.AuthInfos == nil {
+		merged.AuthInfos = map[string]*api.AuthInfo{}
+	}
+	if merged.Contexts == nil {
+		merged.Contexts = map[string]*api.Context{}
+	}
+
+	for name, cluster := range issued.Clusters {
+		merged.Clusters[name] = cluster
+	}
+	for name, authInfo := range issued.AuthInfos {
+		merged.AuthInfos[name] = authInfo
+	}
+	for name, kubeContext := range issued.Contexts {
+		merged.Contexts[name] = kubeContext
+	}
+	merged.CurrentContext = issued.CurrentContext
+
+	return merged
+}
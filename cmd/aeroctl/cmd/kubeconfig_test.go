@@ -0,0 +1,47 @@
+package cmd
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	"k8s.io/client-go/tools/clientcmd/api"
+)
+
+func TestMergeKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+
+	existing := &api.Config{
+		Clusters: map[string]*api.Cluster{
+			"kind":   {Server: "https://127.0.0.1:6443"},
+			"test-1": {Server: "https://10.0.0.1:6443"},
+		},
+		AuthInfos: map[string]*api.AuthInfo{
+			"kind":         {Token: "kind-token"},
+			"test-1-alice": {ClientCertificateData: []byte("expired")},
+		},
+		Contexts: map[string]*api.Context{
+			"kind": {Cluster: "kind", AuthInfo: "kind"},
+		},
+		CurrentContext: "kind",
+	}
+	issued := &api.Config{
+		Clusters:       map[string]*api.Cluster{"test-1": {Server: "https://10.0.0.2:6443"}},
+		AuthInfos:      map[string]*api.AuthInfo{"test-1-alice": {ClientCertificateData: []byte("renewed")}},
+		Contexts:       map[string]*api.Context{"alice@test-1": {Cluster: "test-1", AuthInfo: "test-1-alice"}},
+		CurrentContext: "alice@test-1",
+	}
+
+	merged := mergeKubeconfig(existing, issued)
+	g.Expect(merged.CurrentContext).To(Equal("alice@test-1"))
+	g.Expect(merged.Contexts).To(HaveKey("kind"))
+	g.Expect(merged.AuthInfos["kind"].Token).To(Equal("kind-token"))
+	g.Expect(merged.Clusters["test-1"].Server).To(Equal("https://10.0.0.2:6443"))
+	g.Expect(merged.AuthInfos["test-1-alice"].ClientCertificateData).To(Equal([]byte("renewed")))
+
+	// the loaded kubeconfig is not modified
+	g.Expect(existing.CurrentContext).To(Equal("kind"))
+
+	g.Expect(mergeKubeconfig(api.NewConfig(), issued).Contexts).To(HaveLen(1))
+	g.Expect(mergeKubeconfig(&api.Config{}, issued).Contexts).To(HaveLen(1))
+}
@@ -40,9 +40,13 @@ var (
 
 func init() {
 	rootCmd.AddCommand(statusCmd)
+	rootCmd.AddCommand(getCmd)
 	rootCmd.AddCommand(createCmd)
+	rootCmd.AddCommand(applyCmd)
 	rootCmd.AddCommand(updateCmd)
+	rootCmd.AddCommand(upgradeCmd)
 	rootCmd.AddCommand(deleteCmd)
+	rootCmd.AddCommand(kubeconfigCmd)
 }
 
 var rootCmd = &cobra.Command{
@@ -110,11 +114,21 @@ var statusCmd = &cobra.Command{
 	Short: "get the status of type [cluster|db]",
 }
 
+var getCmd = &cobra.Command{
+	Use:   "get",
+	Short: "list resources or get one by name [clusters|databases]",
+}
+
 var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "update the status of type [cluster|db]",
 }
 
+var upgradeCmd = &cobra.Command{
+	Use:   "upgrade",
+	Short: "upgrade the kubernetes version of type [cluster]",
+}
+
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "create different database resources [cluster|db]",
@@ -125,6 +139,11 @@ var deleteCmd = &cobra.Command{
 	Short: "delete resources [cluster|db]",
 }
 
+var kubeconfigCmd = &cobra.Command{
+	Use:   "kubeconfig",
+	Short: "get credentials of the workload clusters [get]",
+}
+
 // Examples normalizes a command's examples to follow the conventions.
 func Examples(s string) string {
 	if len(s) == 0 {
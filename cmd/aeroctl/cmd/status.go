@@ -2,12 +2,26 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"sort"
+	"text/tabwriter"
+	"time"
 
+	akov1beta1 "github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	v1 "github.com/aerospike/aerostation/api/v1"
+	aeroremote "github.com/aerospike/aerostation/pkg/remote"
 	"github.com/aerospike/aerostation/pkg/utils"
 	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func init() {
@@ -16,6 +30,12 @@ func init() {
 	clusterstatusCmd.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "~/.kube/config", "kube context to use")
 	clusterstatusCmd.Flags().StringVar(&kubeOptions.kubeconfig, "namespace", "~/.kube/config", "kube namespace to use")
 
+	dbstatusCmd.Flags().StringVar(&kubeOptions.kubeconfig, "kubeconfig", "~/.kube/config", "path to kubeconfig file to upload")
+	dbstatusCmd.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "", "kube context to use")
+	dbstatusCmd.Flags().StringVar(&kubeOptions.namespace, "namespace", "default", "kube namespace to use")
+	dbstatusCmd.Flags().BoolVarP(&dbStatusOptions.watch, "watch", "w", false, "stream changes until the database is Running or failed")
+	dbstatusCmd.Flags().DurationVar(&dbStatusOptions.timeout, "timeout", 30*time.Minute, "how long to watch the database")
+
 	statusCmd.AddCommand(clusterstatusCmd)
 	statusCmd.AddCommand(dbstatusCmd)
 	statusCmd.AddCommand(clusterstatusCmd)
@@ -34,17 +54,236 @@ var clusterstatusCmd = &cobra.Command{
 	},
 }
 
+type databaseStatusOptions struct {
+	watch   bool
+	timeout time.Duration
+}
+
+var dbStatusOptions = &databaseStatusOptions{}
+
 var dbstatusCmd = &cobra.Command{
 	Use:   "database [database-name]",
-	Short: "get the status of type cluster",
-	Args:  cobra.ExactArgs(1),
+	Short: "get the status of type database",
+	Example: Examples(`
+		# Get the status of the database named test-db.
+		aeroctl status database test-db
+
+		# Follow the database until it is Running.
+		aeroctl status database test-db --watch
+		`),
+	Args: cobra.ExactArgs(1),
 	RunE: func(c *cobra.Command, args []string) error {
 		return runStatusDB(args[0])
 	},
 }
 
 func runStatusDB(name string) error {
-	return nil
+	kubeClient, err := utils.GetKubeWatchClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	key := client.ObjectKey{Name: name, Namespace: kubeOptions.namespace}
+
+	if !dbStatusOptions.watch {
+		db := &v1.AeroDatabase{}
+		if err := kubeClient.Get(ctx, key, db); err != nil {
+			return err
+		}
+
+		printDatabase(ctx, os.Stdout, kubeClient, db)
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, dbStatusOptions.timeout)
+	defer cancel()
+
+	return watchDatabase(ctx, kubeClient, key)
+}
+
+// watchDatabase prints the database on every change until it is Running or failed
+func watchDatabase(ctx context.Context, kubeClient client.WithWatch, key client.ObjectKey) error {
+	for {
+		w, err := kubeClient.Watch(ctx, &v1.AeroDatabaseList{},
+			client.InNamespace(key.Namespace),
+			client.MatchingFields{"metadata.name": key.Name})
+		if err != nil {
+			return err
+		}
+
+		done, err := streamDatabase(ctx, kubeClient, w)
+		w.Stop()
+		if done || err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return fmt.Errorf("database %s did not settle: %w", key.Name, ctx.Err())
+		}
+		// the api server closes watches after a while, start a new one
+	}
+}
+
+// streamDatabase consumes the events of a watch, done is true once the database settled
+func streamDatabase(ctx context.Context, kubeClient client.Client, w watch.Interface) (bool, error) {
+	for event := range w.ResultChan() {
+		switch event.Type {
+		case watch.Deleted:
+			return true, errors.New("database was deleted")
+		case watch.Error:
+			return true, apierrors.FromObject(event.Object)
+		}
+
+		db, ok := event.Object.(*v1.AeroDatabase)
+		if !ok {
+			continue
+		}
+
+		fmt.Printf("--- %s\n", time.Now().Format(time.RFC3339))
+		printDatabase(ctx, os.Stdout, kubeClient, db)
+
+		if settled, err := databaseSettled(db); settled {
+			return true, err
+		}
+	}
+
+	return false, nil
+}
+
+// databaseSettled returns true once the database is Running, with an error if its deployment failed
+func databaseSettled(db *v1.AeroDatabase) (bool, error) {
+	if db.Status.Phase == string(v1.DBPhaseRunning) {
+		return true, nil
+	}
+
+	ready := meta.FindStatusCondition(db.Status.Conditions, v1.DatabaseReadyCondition)
+	if ready != nil && ready.Reason == v1.DeployFailedReason {
+		return true, fmt.Errorf("database %s failed: %s", db.Name, ready.Message)
+	}
+
+	return false, nil
+}
+
+// printDatabase prints the database with the phase of its cluster and its pods on the remote cluster
+func printDatabase(ctx context.Context, out io.Writer, kubeClient client.Client, db *v1.AeroDatabase) {
+	manager := &v1.AeroClusterManager{}
+	if err := kubeClient.Get(ctx, db.Spec.Cluster.ToObjectKey(), manager); err != nil {
+		manager = nil
+	}
+
+	clusterKey := db.Spec.Cluster.ToObjectKey()
+	if manager != nil && manager.Spec.ClusterID.Name != "" {
+		clusterKey = manager.Spec.ClusterID.ToObjectKey()
+	}
+
+	pods, err := remoteDatabasePods(ctx, kubeClient, clusterKey, db)
+	writeDatabaseStatus(out, db, manager, pods, err)
+}
+
+// remoteDatabasePods lists the aerospike pods of the database on the cluster it is deployed to
+func remoteDatabasePods(ctx context.Context, kubeClient client.Client, clusterKey client.ObjectKey, db *v1.AeroDatabase) ([]corev1.Pod, error) {
+	cfg, err := aeroremote.RESTConfig(ctx, kubeClient, clusterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	remoteClient, err := client.New(cfg, client.Options{Scheme: Scheme})
+	if err != nil {
+		return nil, err
+	}
+
+	pods := &corev1.PodList{}
+	err = remoteClient.List(ctx, pods,
+		client.InNamespace(db.Spec.TargetNamespace),
+		client.MatchingLabels{akov1beta1.AerospikeCustomResourceLabel: db.Spec.Name})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(pods.Items, func(i, j int) bool { return pods.Items[i].Name < pods.Items[j].Name })
+	return pods.Items, nil
+}
+
+// writeDatabaseStatus formats the database, manager is nil if the cluster is gone and
+// podsErr is set if the remote cluster is not reachable
+func writeDatabaseStatus(out io.Writer, db *v1.AeroDatabase, manager *v1.AeroClusterManager, pods []corev1.Pod, podsErr error) {
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+
+	clusterPhase := "NotFound"
+	if manager != nil {
+		clusterPhase = manager.Status.Phase
+	}
+
+	ready := "Unknown"
+	if condition := meta.FindStatusCondition(db.Status.Conditions, v1.DatabaseReadyCondition); condition != nil {
+		ready = fmt.Sprintf("%s (%s)", condition.Status, condition.Reason)
+		if condition.Message != "" {
+			ready = fmt.Sprintf("%s (%s: %s)", condition.Status, condition.Reason, condition.Message)
+		}
+	}
+
+	fmt.Fprintf(w, "Name:\t%s/%s\n", db.Namespace, db.Name)
+	fmt.Fprintf(w, "Cluster:\t%s/%s\t%s\n", db.Spec.Cluster.Namespace, db.Spec.Cluster.Name, clusterPhase)
+	fmt.Fprintf(w, "Target Namespace:\t%s\n", db.Spec.TargetNamespace)
+	fmt.Fprintf(w, "Database Type:\t%s\n", db.Spec.DatabaseType)
+	fmt.Fprintf(w, "Replicas:\t%d\n", db.Spec.Options.Replicas)
+	fmt.Fprintf(w, "Deploy Client:\t%t\n", db.Spec.DeployClient)
+	fmt.Fprintf(w, "Phase:\t%s\n", db.Status.Phase)
+	fmt.Fprintf(w, "Ready:\t%s\n", ready)
+	fmt.Fprintf(w, "Operator Phase:\t%s\n", db.Status.OperatorPhase)
+	fmt.Fprintf(w, "Image:\t%s\n", db.Status.Image)
+	fmt.Fprintf(w, "Pods Ready:\t%d/%d\n", db.Status.ReadyPods, db.Status.Size)
+	if db.Status.LastError != "" {
+		fmt.Fprintf(w, "Last Error:\t%s\n", db.Status.LastError)
+	}
+	w.Flush()
+
+	fmt.Fprintln(out)
+	if podsErr != nil {
+		// fall back to what the controller mirrored the last time it reached the cluster
+		fmt.Fprintf(out, "remote cluster not reachable, showing the last reported pods: %s\n", podsErr.Error())
+		writeReportedPods(out, db)
+		return
+	}
+
+	w = tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "POD\tREADY\tSTATUS\tIMAGE\tNODE")
+	for i := range pods {
+		pod := &pods[i]
+		image := ""
+		if len(pod.Spec.Containers) > 0 {
+			image = pod.Spec.Containers[0].Image
+		}
+		fmt.Fprintf(w, "%s\t%t\t%s\t%s\t%s\n", pod.Name, podReady(pod), pod.Status.Phase, image, pod.Spec.NodeName)
+	}
+	w.Flush()
+}
+
+func writeReportedPods(out io.Writer, db *v1.AeroDatabase) {
+	names := make([]string, 0, len(db.Status.Pods))
+	for name := range db.Status.Pods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	w := tabwriter.NewWriter(out, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "POD\tIP\tIMAGE")
+	for _, name := range names {
+		pod := db.Status.Pods[name]
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, pod.PodIP, pod.Image)
+	}
+	w.Flush()
+}
+
+func podReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
 }
 
 func runStatusCluster(name string) error {
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func statusDatabase() *v1.AeroDatabase {
+	db := &v1.AeroDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-db", Namespace: "default"},
+		Spec: v1.AeroDatabaseSpec{
+			Cluster:         v1.ClusterKey{Name: "test-1", Namespace: "default"},
+			Name:            "test-db",
+			TargetNamespace: "aerospike",
+			DatabaseType:    v1.DatabaseTypeMemory,
+			Options:         v1.DatabaseOptions{Replicas: 2},
+		},
+		Status: v1.AeroDatabaseStatus{
+			Phase:     string(v1.DBPhaseDeployed),
+			Size:      2,
+			ReadyPods: 1,
+			Image:     "aerospike/aerospike-server-enterprise:5.6.0.7",
+			Pods: map[string]v1alpha1.AerospikePodStatus{
+				"test-db-0-1": {PodIP: "10.0.0.2", Image: "aerospike/aerospike-server-enterprise:5.6.0.7"},
+				"test-db-0-0": {PodIP: "10.0.0.1", Image: "aerospike/aerospike-server-enterprise:5.6.0.7"},
+			},
+		},
+	}
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason, "1 of 2 pods ready", 1)
+
+	return db
+}
+
+func TestWriteDatabaseStatus(t *testing.T) {
+	g := NewWithT(t)
+
+	manager := &v1.AeroClusterManager{Status: v1.AeroClusterManagerStatus{Phase: string(v1.ManagerPhaseProvisioned)}}
+	pods := []corev1.Pod{{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-db-0-0"},
+		Spec: corev1.PodSpec{
+			NodeName:   "node-1",
+			Containers: []corev1.Container{{Image: "aerospike/aerospike-server-enterprise:5.6.0.7"}},
+		},
+		Status: corev1.PodStatus{
+			Phase:      corev1.PodRunning,
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+		},
+	}}
+
+	out := &bytes.Buffer{}
+	writeDatabaseStatus(out, statusDatabase(), manager, pods, nil)
+
+	g.Expect(out.String()).To(ContainSubstring("Cluster:           default/test-1  Provisioned"))
+	g.Expect(out.String()).To(ContainSubstring("Ready:             False (PodsNotReady: 1 of 2 pods ready)"))
+	g.Expect(out.String()).To(MatchRegexp(`test-db-0-0\s+true\s+Running\s+aerospike/aerospike-server-enterprise:5.6.0.7\s+node-1`))
+}
+
+func TestWriteDatabaseStatusUnreachable(t *testing.T) {
+	g := NewWithT(t)
+
+	out := &bytes.Buffer{}
+	writeDatabaseStatus(out, statusDatabase(), nil, nil, errors.New("connection refused"))
+
+	g.Expect(out.String()).To(ContainSubstring("default/test-1  NotFound"))
+	g.Expect(out.String()).To(ContainSubstring("remote cluster not reachable, showing the last reported pods: connection refused"))
+	g.Expect(out.String()).To(MatchRegexp(`(?s)test-db-0-0\s+10.0.0.1.*test-db-0-1\s+10.0.0.2`))
+}
+
+func TestDatabaseSettled(t *testing.T) {
+	g := NewWithT(t)
+
+	db := statusDatabase()
+	settled, err := databaseSettled(db)
+	g.Expect(settled).To(BeFalse())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db.Status.Phase = string(v1.DBPhaseRunning)
+	settled, err = databaseSettled(db)
+	g.Expect(settled).To(BeTrue())
+	g.Expect(err).NotTo(HaveOccurred())
+
+	db = statusDatabase()
+	db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, "unknown database type", 1)
+	settled, err = databaseSettled(db)
+	g.Expect(settled).To(BeTrue())
+	g.Expect(err).To(MatchError("database test-db failed: unknown database type"))
+}
@@ -20,6 +20,17 @@ type clusterOptions struct {
 	Region       string
 	SSHKey       string
 
+	// azure only
+	ResourceGroup     string
+	SubscriptionID    string
+	IdentityName      string
+	IdentityNamespace string
+	SSHPublicKey      string
+
+	// gcp only
+	Project string
+	Network string
+
 	// ClusterId string - unique id assigned to workload cluster (todo?)
 	// Owner string 	//workload cluster owner (todo?)
 	// AvailabilityZones    []string // todo ?
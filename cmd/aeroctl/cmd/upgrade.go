@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"sigs.k8s.io/cluster-api/util/patch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type upgradeClusterOptions struct {
+	kubeVersion string
+	wait        bool
+	timeout     time.Duration
+}
+
+// upgradePollInterval is how often the cluster is checked while waiting for an upgrade
+const upgradePollInterval = 10 * time.Second
+
+var upgradeOptions = &upgradeClusterOptions{}
+
+func init() {
+	upgradeCluster.Flags().StringVar(&kubeOptions.kubeconfig, "kubeconfig", "~/.kube/config", "path to kubeconfig file to upload")
+	upgradeCluster.Flags().StringVar(&kubeOptions.kubeconfigContext, "context", "", "kube context to use")
+	upgradeCluster.Flags().StringVar(&kubeOptions.namespace, "namespace", "default", "kube namespace to use")
+
+	upgradeCluster.Flags().StringVar(&upgradeOptions.kubeVersion, "kubeversion", "", "k8s version to upgrade to")
+	upgradeCluster.Flags().BoolVar(&upgradeOptions.wait, "wait", false, "wait until the control plane and the workers are upgraded")
+	upgradeCluster.Flags().DurationVar(&upgradeOptions.timeout, "timeout", time.Hour, "how long to wait for the upgrade")
+	_ = upgradeCluster.MarkFlagRequired("kubeversion")
+
+	upgradeCmd.AddCommand(upgradeCluster)
+}
+
+var upgradeCluster = &cobra.Command{
+	Use:   "cluster [cluster-name]",
+	Short: "upgrade the kubernetes version of a cluster",
+	Example: Examples(`
+		# Upgrade the cluster named test-1 to kubernetes v1.22.0 and follow the progress.
+		aeroctl upgrade cluster test-1 --kubeversion v1.22.0 --wait
+		`),
+	Args: cobra.ExactArgs(1),
+	RunE: func(c *cobra.Command, args []string) error {
+		return runUpgradeCluster(args[0])
+	},
+}
+
+func runUpgradeCluster(name string) error {
+	kubeClient, err := utils.GetKubeClient(kubeOptions.kubeconfig, Scheme)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	key := client.ObjectKey{Name: name, Namespace: kubeOptions.namespace}
+
+	manager := &v1.AeroClusterManager{}
+	if err := kubeClient.Get(ctx, key, manager); err != nil {
+		return err
+	}
+
+	patchHelper, err := patch.NewHelper(manager, kubeClient)
+	if err != nil {
+		return err
+	}
+
+	old := manager.DeepCopy()
+	manager.Default()
+	manager.Spec.ClusterOptions.KubeVersion = upgradeOptions.kubeVersion
+
+	// fail fast on skew errors, the webhook may not be installed
+	if err := manager.ValidateUpdate(old); err != nil {
+		return err
+	}
+
+	if err := patchHelper.Patch(ctx, manager); err != nil {
+		return err
+	}
+
+	fmt.Printf("upgrading cluster %s from %s to %s\n", name, old.Spec.ClusterOptions.KubeVersion, upgradeOptions.kubeVersion)
+
+	if !upgradeOptions.wait {
+		return nil
+	}
+
+	return waitForUpgrade(ctx, kubeClient, key, upgradeOptions.kubeVersion)
+}
+
+// waitForUpgrade prints the progress of the upgrade until the cluster runs the target version
+func waitForUpgrade(ctx context.Context, kubeClient client.Client, key client.ObjectKey, target string) error {
+	last := ""
+
+	return wait.PollImmediate(upgradePollInterval, upgradeOptions.timeout, func() (bool, error) {
+		manager := &v1.AeroClusterManager{}
+		if err := kubeClient.Get(ctx, key, manager); err != nil {
+			return false, err
+		}
+
+		condition := meta.FindStatusCondition(manager.Status.Conditions, v1.KubeVersionUpgradedCondition)
+		if condition == nil {
+			return false, nil
+		}
+
+		progress := fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+		if progress != last {
+			fmt.Printf("%s\t%s\n", manager.Status.Phase, progress)
+			last = progress
+		}
+
+		if condition.Reason == v1.UpgradeBlockedReason {
+			return false, fmt.Errorf("upgrade of %s is blocked: %s", key.Name, condition.Message)
+		}
+
+		return manager.Status.KubeVersion == target && condition.Status == metav1.ConditionTrue, nil
+	})
+}
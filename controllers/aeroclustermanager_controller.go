@@ -19,13 +19,14 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/argoproj/gitops-engine/pkg/utils/kube"
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
@@ -34,8 +35,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	"github.com/aerospike/aerostation/pkg/bundle"
 	"github.com/aerospike/aerostation/pkg/utils"
 	"github.com/aerospike/aerostation/pkg/utils/annotations"
 	"github.com/aerospike/aerostation/pkg/utils/capi"
@@ -44,6 +49,7 @@ import (
 	v1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util"
+	"sigs.k8s.io/cluster-api/util/conditions"
 
 	fleet "github.com/rancher/fleet/pkg/apis/fleet.cattle.io/v1alpha1"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -54,6 +60,9 @@ const (
 	GithubSecret             = "github-ssh-auth"
 )
 
+// clusterRequeueAfter is how often a cluster is checked while it is being provisioned or deleted
+const clusterRequeueAfter = 30 * time.Second
+
 // AeroClusterManagerReconciler reconciles a AeroClusterManager object
 type AeroClusterManagerReconciler struct {
 	client.Client
@@ -67,9 +76,14 @@ type AeroClusterManagerReconciler struct {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AeroClusterManagerReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.AeroClusterManager{}).
-		Complete(r)
+		Owns(&v1.ClusterAddon{})
+	if r.DatabaseReconciler != nil {
+		// the prometheus exporter status follows the monitoring of the databases
+		b = b.Watches(&source.Kind{Type: &v1.AeroDatabase{}}, handler.EnqueueRequestsFromMapFunc(r.DatabaseReconciler.DatabaseToCluster))
+	}
+	return b.Complete(r)
 }
 
 //+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=aeroclustermanagers,verbs=get;list;watch;create;update;patch;delete
@@ -77,6 +91,7 @@ func (r *AeroClusterManagerReconciler) SetupWithManager(mgr ctrl.Manager) error
 //+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=aeroclustermanagers/finalizers,verbs=update
 //+kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create;patch
 //+kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;patch
+//+kubebuilder:rbac:groups=core,resources=configmaps,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=fleet.cattle.io;machinedeployments.cluster.x-k8s.io;infrastructure.cluster.x-k8s.io;bootstrap.cluster.x-k8s.io;controlplane.cluster.x-k8s.io,resources=*,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=cluster.x-k8s.io,resources=*;machinedeployments;clusters;clusters/status,verbs=get;list;watch;create;update;patch;delete
@@ -111,9 +126,18 @@ func (r *AeroClusterManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 	} else {
 		if utils.ContainsString(manager.GetFinalizers(), AerostationFinalizerName) {
 			// our finalizer is present, lets delete the cluster
-			if err := r.deleteExternalResources(ctx, manager); err != nil {
+			r.reconcilePhase(manager)
+			res, err := r.deleteExternalResources(ctx, manager)
+			if err != nil {
 				return ctrl.Result{}, err
 			}
+			if !res.IsZero() {
+				// surface why the cluster is not gone yet
+				if err := r.Status().Update(ctx, manager); err != nil {
+					return ctrl.Result{}, err
+				}
+				return res, nil
+			}
 
 			controllerutil.RemoveFinalizer(manager, AerostationFinalizerName)
 			if err := r.Update(ctx, manager); err != nil {
@@ -144,7 +168,7 @@ func (r *AeroClusterManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 	// ensure phase is always patched
 	defer func() {
 		// Always reconcile the Status.Phase field.
-		r.reconcilePhase(manager, cluster)
+		r.reconcilePhase(manager)
 
 		// Always attempt to Patch the Cluster object and status after each reconciliation.
 		// Patch ObservedGeneration only if the reconciliation completed successfully
@@ -159,31 +183,44 @@ func (r *AeroClusterManagerReconciler) Reconcile(ctx context.Context, req ctrl.R
 	return r.reconcile(ctx, manager, cluster)
 }
 
-func (r *AeroClusterManagerReconciler) deleteExternalResources(ctx context.Context, manager *v1.AeroClusterManager) error {
+// deleteExternalResources deletes the databases of the cluster and, once they are gone, the capi cluster.
+// A non zero result means deletion is blocked on the databases and the DeletionBlocked condition says why.
+func (r *AeroClusterManagerReconciler) deleteExternalResources(ctx context.Context, manager *v1.AeroClusterManager) (ctrl.Result, error) {
 	// If this cluster is not managed by us. don't do anything with it.
 	if !manager.Spec.Managed {
-		return nil
+		return ctrl.Result{}, nil
 	}
 
 	clusters, err := r.getAllAerospikeClustersForKubeCluster(ctx, manager)
 	if err != nil {
-		return nil
+		return ctrl.Result{}, err
 	}
 
 	for _, cluster := range clusters {
-		r.Delete(ctx, &cluster)
+		if err := r.Delete(ctx, &cluster); err != nil && !apierrors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+	}
+
+	if len(clusters) > 0 {
+		// the databases have to remove their remote resources while the cluster is still reachable
+		manager.Status.SetCondition(v1.DeletionBlockedCondition, metav1.ConditionTrue, v1.DatabasesExistReason,
+			fmt.Sprintf("waiting for %d database(s) to be deleted", len(clusters)), manager.Generation)
+		return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
 	}
 
+	manager.Status.SetCondition(v1.DeletionBlockedCondition, metav1.ConditionFalse, v1.NotBlockedReason, "", manager.Generation)
+
 	cluster := &v1beta1.Cluster{}
 
 	if err := r.Client.Get(ctx, manager.Spec.ClusterID.ToObjectKey(), cluster); err != nil {
 		if apierrors.IsNotFound(err) {
-			return nil
+			return ctrl.Result{}, nil
 		}
-		return err
+		return ctrl.Result{}, err
 	}
 
-	return r.Client.Delete(ctx, cluster)
+	return ctrl.Result{}, r.Client.Delete(ctx, cluster)
 }
 
 func (r *AeroClusterManagerReconciler) getAllAerospikeClustersForKubeCluster(ctx context.Context, manager *v1.AeroClusterManager) ([]v1.AeroDatabase, error) {
@@ -208,8 +245,11 @@ func (r *AeroClusterManagerReconciler) getAllAerospikeClustersForKubeCluster(ctx
 func (r *AeroClusterManagerReconciler) reconcile(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (ctrl.Result, error) {
 	phases := []func(context.Context, *v1.AeroClusterManager, *v1beta1.Cluster) (ctrl.Result, error){
 		r.reconcileCluster,
+		r.reconcileCNI,
+		r.reconcileOperator,
 		r.reconcileGitRepo,
 		r.reconcileFleet,
+		r.reconcileMonitoring,
 	}
 
 	// TODO: shoudl also remove databases linked to this kubernetes cluster
@@ -232,14 +272,25 @@ func (r *AeroClusterManagerReconciler) reconcile(ctx context.Context, manager *v
 		return res, kerrors.NewAggregate(errs)
 	}
 
-	fmt.Println("ending loops, should not requeue")
-	return ctrl.Result{Requeue: false}, nil
+	fmt.Println("ending loops")
+	return res, nil
 }
 
 // reconcileCluster handles reconciliation of the capi cluster
 func (r *AeroClusterManagerReconciler) reconcileCluster(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (ctrl.Result, error) {
 	if err := r.Client.Get(ctx, manager.Spec.ClusterID.ToObjectKey(), cluster); err != nil {
 		if apierrors.IsNotFound(err) {
+			// the aks and gke objects are applied unstructured, a missing provider is reported instead of failing
+			// every apply
+			if err := capi.CheckProvider(r.Config, manager.Spec.ClusterOptions.Provider); err != nil {
+				if errors.Is(err, capi.ErrProviderNotInstalled) {
+					manager.Status.SetCondition(v1.InfrastructureReadyCondition, metav1.ConditionFalse, v1.ProviderNotInstalledReason,
+						err.Error(), manager.Generation)
+					return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
+				}
+				return ctrl.Result{}, err
+			}
+
 			// Object not found, this means we have not created it yet, lets do that now!
 			var err error
 			switch manager.Spec.ClusterOptions.Provider {
@@ -247,37 +298,143 @@ func (r *AeroClusterManagerReconciler) reconcileCluster(ctx context.Context, man
 				err = capi.ApplyDocker(r.Client, &manager.Spec, r.Config)
 			case "eks":
 				if manager.Spec.ClusterOptions.EKSOptions == nil {
-					fmt.Println("Manager Spec has errors")
+					err = errors.New("eksOptions are required for provider eks")
+					break
 				}
 				err = capi.ApplyEks(r.Client, &manager.Spec.ClusterOptions, r.Config)
+			case "aks":
+				err = capi.ApplyAks(r.Client, &manager.Spec.ClusterOptions, r.Config)
+			case "gke":
+				err = capi.ApplyGke(r.Client, &manager.Spec.ClusterOptions, r.Config)
+			default:
+				err = fmt.Errorf("unsupported provider %q", manager.Spec.ClusterOptions.Provider)
 			}
 
 			if err != nil {
 				fmt.Println(err)
+				manager.Status.SetCondition(v1.InfrastructureReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, err.Error(), manager.Generation)
 				// Error Writing the capi object. Requeuing
 				return ctrl.Result{}, err
 			}
 
-			return ctrl.Result{}, nil
+			manager.Status.KubeVersion = manager.Spec.ClusterOptions.KubeVersion
+			manager.Status.SetCondition(v1.InfrastructureReadyCondition, metav1.ConditionFalse, v1.ProvisioningReason,
+				"cluster api objects created", manager.Generation)
+			manager.Status.SetCondition(v1.ControlPlaneReadyCondition, metav1.ConditionFalse, v1.WaitingForClusterReason, "", manager.Generation)
+			return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
 		}
 		// Error reading the object - requeue the request.
 		return ctrl.Result{}, err
 	}
 
+	setClusterConditions(manager, cluster)
+
+	return r.reconcileKubeVersion(ctx, manager)
+}
+
+// reconcileKubeVersion applies the capi objects of the existing cluster. A new kubernetes version is rolled
+// out to the control plane first, the workers keep running the old version until the control plane is upgraded.
+func (r *AeroClusterManagerReconciler) reconcileKubeVersion(ctx context.Context, manager *v1.AeroClusterManager) (ctrl.Result, error) {
+	status := &manager.Status
+	target := manager.Spec.ClusterOptions.KubeVersion
+
+	// clusters created before upgrades were tracked run the version they were created with
+	if status.KubeVersion == "" {
+		status.KubeVersion = target
+	}
+	current := status.KubeVersion
+
+	if current == target {
+		status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionTrue, v1.UpgradedReason, target, manager.Generation)
+		return ctrl.Result{}, r.applyCluster(manager, target, target)
+	}
+
+	if err := checkUpgrade(manager.Spec.ClusterOptions.Provider, current, target); err != nil {
+		// keep the cluster on the version it runs until the spec is fixed
+		status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradeBlockedReason, err.Error(), manager.Generation)
+		return ctrl.Result{}, r.applyCluster(manager, current, current)
+	}
+
+	if !status.IsUpgrading() {
+		// recreate the condition so its lastTransitionTime marks the start of the upgrade
+		meta.RemoveStatusCondition(&status.Conditions, v1.KubeVersionUpgradedCondition)
+		status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradingControlPlaneReason,
+			fmt.Sprintf("upgrading control plane from %s to %s", current, target), manager.Generation)
+	}
+	condition := meta.FindStatusCondition(status.Conditions, v1.KubeVersionUpgradedCondition)
+
+	if condition.Reason == v1.UpgradingControlPlaneReason {
+		if err := r.applyCluster(manager, target, current); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		upgraded, message, err := capi.ControlPlaneUpgraded(ctx, r.Client, &manager.Spec, condition.LastTransitionTime)
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to get control plane of %s/%s", manager.Namespace, manager.Name)
+		}
+		if !upgraded {
+			status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradingControlPlaneReason, message, manager.Generation)
+			return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
+		}
+
+		status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradingWorkersReason,
+			fmt.Sprintf("upgrading workers from %s to %s", current, target), manager.Generation)
+	}
+
+	if err := r.applyCluster(manager, target, target); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	upgraded, message, err := capi.WorkersUpgraded(ctx, r.Client, &manager.Spec)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to get workers of %s/%s", manager.Namespace, manager.Name)
+	}
+	if !upgraded {
+		status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradingWorkersReason, message, manager.Generation)
+		return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
+	}
+
+	status.KubeVersion = target
+	status.SetCondition(v1.KubeVersionUpgradedCondition, metav1.ConditionTrue, v1.UpgradedReason, target, manager.Generation)
+	return ctrl.Result{}, nil
+}
+
+// checkUpgrade repeats the checks of the webhook, it may be disabled
+func checkUpgrade(provider, current, target string) error {
+	if !utils.ContainsString(v1.UpgradableProviders, provider) {
+		return fmt.Errorf("kubernetes upgrades are not supported for provider %q", provider)
+	}
+
+	return v1.ValidateKubeVersionUpgrade(current, target)
+}
+
+// applyCluster applies the capi objects of the cluster, the control plane and the workers may run different versions
+func (r *AeroClusterManagerReconciler) applyCluster(manager *v1.AeroClusterManager, controlPlaneVersion, workerVersion string) error {
+	controlPlane := manager.Spec.DeepCopy()
+	controlPlane.ClusterOptions.KubeVersion = controlPlaneVersion
+	workers := manager.Spec.DeepCopy()
+	workers.ClusterOptions.KubeVersion = workerVersion
+
 	var err error
 	switch manager.Spec.ClusterOptions.Provider {
-	case "docker":
-		err = capi.ApplyDocker(r.Client, &manager.Spec, r.Config)
-	case "eks":
-		err = capi.ApplyEks(r.Client, &manager.Spec.ClusterOptions, r.Config)
+	case v1.ProviderDocker:
+		if err = capi.ApplyDockerControlPlane(r.Client, controlPlane, r.Config); err == nil {
+			err = capi.ApplyDockerWorkers(r.Client, workers, r.Config)
+		}
+	case v1.ProviderEKS:
+		if err = capi.ApplyEksControlPlane(r.Client, &controlPlane.ClusterOptions, r.Config); err == nil {
+			err = capi.ApplyEksWorkers(r.Client, &workers.ClusterOptions, r.Config)
+		}
+	case v1.ProviderAKS:
+		err = capi.ApplyAks(r.Client, &controlPlane.ClusterOptions, r.Config)
+	case v1.ProviderGKE:
+		err = capi.ApplyGke(r.Client, &controlPlane.ClusterOptions, r.Config)
 	}
 	if err != nil {
 		fmt.Println(err)
-		// Error Writing the cathub.com/rancher/fleet/pkg/apis v0.0.0pi object. Requeuing
-		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return err
 }
 
 func (r *AeroClusterManagerReconciler) reconcileFleet(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (_ ctrl.Result, reterr error) {
@@ -376,14 +533,39 @@ func (r *AeroClusterManagerReconciler) reconcileGitRepo(ctx context.Context, man
 	return ctrl.Result{}, r.Client.Update(ctx, gitRepoObj)
 }
 
-// reconcileOperator handles the reconcilation of operator related tasks
-func (r *AeroClusterManagerReconciler) reconcileOperator(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (_ ctrl.Result, reterr error) {
-	clusterkey := util.ObjectKey(cluster)
+// reconcileCNI installs pod networking on clusters whose infrastructure does not provide it
+func (r *AeroClusterManagerReconciler) reconcileCNI(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (ctrl.Result, error) {
+	if !manager.Status.IsConditionTrue(v1.ControlPlaneReadyCondition) {
+		manager.Status.SetCondition(v1.CNIInstalledCondition, metav1.ConditionFalse, v1.WaitingForControlPlaneReason, "", manager.Generation)
+		return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
+	}
+
+	if manager.Spec.ClusterOptions.Provider != "docker" {
+		manager.Status.SetCondition(v1.CNIInstalledCondition, metav1.ConditionTrue, v1.ProvidedByInfrastructureReason, "", manager.Generation)
+		return ctrl.Result{}, nil
+	}
+
+	addon, err := r.ensureAddon(ctx, manager, bundle.AddonCalico)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to create the calico add-on of %s/%s", manager.Namespace, manager.Name)
+	}
+	setAddonCondition(manager, v1.CNIInstalledCondition, addon)
+	return ctrl.Result{}, nil
+}
+
+// reconcileOperator creates the add-ons of the operator, cert-manager is installed first
+func (r *AeroClusterManagerReconciler) reconcileOperator(ctx context.Context, manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) (ctrl.Result, error) {
+	if !manager.Status.IsConditionTrue(v1.CNIInstalledCondition) {
+		manager.Status.SetCondition(v1.CertManagerInstalledCondition, metav1.ConditionFalse, v1.WaitingForClusterReason, "", manager.Generation)
+		manager.Status.SetCondition(v1.OperatorInstalledCondition, metav1.ConditionFalse, v1.WaitingForClusterReason, "", manager.Generation)
+		return ctrl.Result{RequeueAfter: clusterRequeueAfter}, nil
+	}
 
-	cli, reterr := r.Tracker.GetClient(ctx, clusterkey)
+	clusterkey := util.ObjectKey(cluster)
 
-	if reterr != nil {
-		return ctrl.Result{}, reterr
+	cli, err := r.Tracker.GetClient(ctx, clusterkey)
+	if err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to get remote client %s/%s", manager.Namespace, manager.Name)
 	}
 
 	namespace := &corev1.Namespace{
@@ -392,81 +574,155 @@ func (r *AeroClusterManagerReconciler) reconcileOperator(ctx context.Context, ma
 		},
 	}
 
-	err := cli.Get(ctx, client.ObjectKey{Name: "aerospike"}, namespace)
+	err = cli.Get(ctx, client.ObjectKey{Name: "aerospike"}, namespace)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			err = cli.Create(ctx, namespace)
-
-			if err != nil {
-				_ = r.Log.WithValues("aeroclustermanager", "unable to create namespace")
-				// we cant really do more at this point.
-				return ctrl.Result{}, errors.Wrapf(err, "failed to get remote client %s/%s", manager.Namespace, manager.Name)
-			}
-		} else {
+		if !apierrors.IsNotFound(err) {
 			// Unknown Error
 			return ctrl.Result{}, err
 		}
+		if err := cli.Create(ctx, namespace); err != nil {
+			// we cant really do more at this point.
+			return ctrl.Result{}, errors.Wrapf(err, "failed to create namespace aerospike on %s/%s", manager.Namespace, manager.Name)
+		}
 	}
 
-	akoKey := client.ObjectKey{
-		Name: "aerospikeclusters.aerospike.com",
-	}
-	exists := &unstructured.Unstructured{}
-	err = cli.Get(ctx, akoKey, exists)
-
-	if err != nil || exists == nil {
-
-		err = utils.ApplyCertManager(ctx, r.Client, clusterkey)
-
+	for _, a := range []struct {
+		name          string
+		conditionType string
+	}{
+		{bundle.AddonCertManager, v1.CertManagerInstalledCondition},
+		{bundle.AddonAKO, v1.OperatorInstalledCondition},
+	} {
+		addon, err := r.ensureAddon(ctx, manager, a.name)
 		if err != nil {
-			fmt.Println("Failed to apply CertManager")
+			return ctrl.Result{}, errors.Wrapf(err, "failed to create the %s add-on of %s/%s", a.name, manager.Namespace, manager.Name)
 		}
+		setAddonCondition(manager, a.conditionType, addon)
+		if a.name == bundle.AddonAKO {
+			// the previous version keeps running while an upgrade is blocked or in progress
+			manager.Status.AerospikeOperator = v1.ApplicationStatus{
+				Running: addon.Status.IsConditionTrue(v1.AddonHealthyCondition),
+				Version: addon.Status.Version,
+			}
+		}
+	}
 
-		err = utils.ApplyOperatorator(ctx, r.Client, clusterkey)
+	return ctrl.Result{}, nil
+}
 
-		if err != nil {
+// reconcileMonitoring reports the prometheus exporter as running while the exporters of any database of the cluster
+// are ready and scraped
+func (r *AeroClusterManagerReconciler) reconcileMonitoring(ctx context.Context, manager *v1.AeroClusterManager, _ *v1beta1.Cluster) (ctrl.Result, error) {
+	databases, err := r.getAllAerospikeClustersForKubeCluster(ctx, manager)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
-			fmt.Println("Failed to apply operator")
-			return ctrl.Result{}, err
+	running := false
+	for _, db := range databases {
+		if db.Status.IsConditionTrue(v1.MonitoringReadyCondition) {
+			running = true
 		}
+	}
+	manager.Status.PrometheusExporter.Running = running
 
-		// TODO: check everything is deployed correctly?
-		manager.Status.AerospikeOperator.Running = true
+	return ctrl.Result{}, nil
+}
 
+// ensureAddon creates a ClusterAddon the cluster requires, its version is left to the user once it exists. The
+// add-ons the cluster requires are created again if they are deleted. The version of the operator follows
+// ClusterOptions.OperatorVersion while it is set.
+func (r *AeroClusterManagerReconciler) ensureAddon(ctx context.Context, manager *v1.AeroClusterManager, name string) (*v1.ClusterAddon, error) {
+	version := ""
+	if name == bundle.AddonAKO {
+		version = manager.Spec.ClusterOptions.OperatorVersion
 	}
 
-	fmt.Println("Operator loop finished")
-
-	_ = r.Log.WithValues("aeroclustermanager", "remote cluster now has the aerospike kubernetes operator installed")
+	addon := &v1.ClusterAddon{}
+	key := client.ObjectKey{Namespace: manager.Namespace, Name: manager.Name + "-" + name}
+	err := r.Client.Get(ctx, key, addon)
+	if err == nil && version != "" && addon.Spec.Version != version {
+		// the ClusterAddon checks the upgrade and applies it
+		addon.Spec.Version = version
+		return addon, r.Client.Update(ctx, addon)
+	}
+	if err == nil || !apierrors.IsNotFound(err) {
+		return addon, err
+	}
 
-	return ctrl.Result{}, reterr
+	addon = &v1.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels:    map[string]string{common.ClusterLabelName: manager.Name},
+		},
+		Spec: v1.ClusterAddonSpec{Cluster: manager.Name, Addon: name, Version: version},
+	}
+	if name == bundle.AddonCertManager && manager.Spec.ClusterOptions.Provider == v1.ProviderDocker {
+		// the pods of cert-manager need the pod networking of calico
+		addon.Spec.DependsOn = []string{bundle.AddonCalico}
+	}
+	if err := controllerutil.SetControllerReference(manager, addon, r.Scheme); err != nil {
+		return nil, err
+	}
+	return addon, r.Client.Create(ctx, addon)
 }
 
-func (r *AeroClusterManagerReconciler) reconcilePhase(manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) {
-	if manager.Status.Phase == "" {
-		manager.Status.SetTypedPhase(v1.ManagerPhasePending)
+// setAddonCondition reflects the state of an add-on in a condition of the manager
+func setAddonCondition(manager *v1.AeroClusterManager, conditionType string, addon *v1.ClusterAddon) {
+	applied := meta.FindStatusCondition(addon.Status.Conditions, v1.AddonAppliedCondition)
+	switch {
+	case addon.IsReady():
+		manager.Status.SetCondition(conditionType, metav1.ConditionTrue, v1.InstalledReason,
+			addon.Spec.Addon+" "+addon.Status.Version, manager.Generation)
+	case applied != nil && (applied.Reason == v1.InstallFailedReason || applied.Reason == v1.InvalidSpecReason ||
+		applied.Reason == v1.IncompatibleCRDReason):
+		manager.Status.SetCondition(conditionType, metav1.ConditionFalse, v1.InstallFailedReason, applied.Message, manager.Generation)
+	case addon.Status.Phase == string(v1.AddonPhaseUpgrading):
+		manager.Status.SetCondition(conditionType, metav1.ConditionFalse, v1.UpgradingReason, applied.Message, manager.Generation)
+	default:
+		manager.Status.SetCondition(conditionType, metav1.ConditionFalse, v1.ProvisioningReason,
+			fmt.Sprintf("%s is %s", addon.Name, addon.Status.Phase), manager.Generation)
 	}
+}
 
-	// Does nto handle cluster error states just yet
-	if cluster == nil || v1beta1.ClusterPhase(cluster.Status.Phase) != v1beta1.ClusterPhaseProvisioned {
-		manager.Status.SetTypedPhase(v1.ManagerPhaseClusterCreating)
+// setClusterConditions reflects the state of the capi cluster in the conditions of the manager
+func setClusterConditions(manager *v1.AeroClusterManager, cluster *v1beta1.Cluster) {
+	if cluster.Status.InfrastructureReady {
+		manager.Status.SetCondition(v1.InfrastructureReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason, "", manager.Generation)
+	} else {
+		manager.Status.SetCondition(v1.InfrastructureReadyCondition, metav1.ConditionFalse, v1.ProvisioningReason,
+			fmt.Sprintf("cluster is %s", cluster.Status.Phase), manager.Generation)
 	}
 
-	if v1beta1.ClusterPhase(cluster.Status.Phase) == v1beta1.ClusterPhaseProvisioned {
-		if manager.Spec.ClusterOptions.Provider == "docker" {
-			fmt.Println("checking on CNI...")
-			err := capi.ApplyCNI(context.Background(), r.Client, r.Tracker, types.NamespacedName{Name: cluster.Name, Namespace: cluster.Namespace})
-			if err != nil {
-				fmt.Printf("error in applying CNI %s\n", err.Error())
-			}
-		}
-
-		manager.Status.SetTypedPhase(v1.ManagerPhaseOperatorInstalling)
+	// initialized rather than ready, nodes only become ready once the CNI is installed
+	if conditions.IsTrue(cluster, v1beta1.ControlPlaneInitializedCondition) {
+		manager.Status.SetCondition(v1.ControlPlaneReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason, "", manager.Generation)
+	} else {
+		manager.Status.SetCondition(v1.ControlPlaneReadyCondition, metav1.ConditionFalse, v1.WaitingForControlPlaneReason,
+			conditions.GetMessage(cluster, v1beta1.ControlPlaneInitializedCondition), manager.Generation)
 	}
+}
 
-	if manager.Status.AerospikeOperator.Running {
-		manager.Status.SetTypedPhase(v1.ManagerPhaseProvisioned)
+// reconcilePhase derives the phase from the conditions
+func (r *AeroClusterManagerReconciler) reconcilePhase(manager *v1.AeroClusterManager) {
+	status := &manager.Status
+
+	switch {
+	case !manager.DeletionTimestamp.IsZero():
+		status.SetTypedPhase(v1.ManagerPhaseDeleting)
+	case status.IsUpgrading() || status.IsOperatorUpgrading():
+		status.SetTypedPhase(v1.ManagerPhaseUpgrading)
+	case status.IsConditionTrue(v1.OperatorInstalledCondition):
+		status.SetTypedPhase(v1.ManagerPhaseProvisioned)
+	case status.IsConditionTrue(v1.InfrastructureReadyCondition) && status.IsConditionTrue(v1.ControlPlaneReadyCondition):
+		status.SetTypedPhase(v1.ManagerPhaseOperatorInstalling)
+	case meta.FindStatusCondition(status.Conditions, v1.InfrastructureReadyCondition) != nil:
+		status.SetTypedPhase(v1.ManagerPhaseClusterCreating)
+	default:
+		status.SetTypedPhase(v1.ManagerPhasePending)
 	}
+
 }
 
 // ClusterToCluster Maps capi clusters to aerospike clusters
@@ -0,0 +1,108 @@
+package controllers
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/bundle"
+)
+
+func TestEnsureAddonOperatorVersion(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	manager := &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default", UID: "uid-edge"},
+		Spec:       v1.AeroClusterManagerSpec{ClusterOptions: v1.ClusterOptions{Provider: v1.ProviderEKS, OperatorVersion: "2.0.0"}},
+	}
+	r := &AeroClusterManagerReconciler{Client: fake.NewClientBuilder().WithScheme(scheme).Build(), Scheme: scheme}
+
+	ako, err := r.ensureAddon(ctx, manager, bundle.AddonAKO)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ako.Spec.Version).To(Equal("2.0.0"))
+	certManager, err := r.ensureAddon(ctx, manager, bundle.AddonCertManager)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(certManager.Spec.Version).To(BeEmpty())
+
+	// a new operator version is handed to the add-on, which checks and applies the upgrade
+	manager.Spec.ClusterOptions.OperatorVersion = "2.1.0"
+	ako, err = r.ensureAddon(ctx, manager, bundle.AddonAKO)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ako.Spec.Version).To(Equal("2.1.0"))
+
+	// without a version the add-on is left to the user
+	ako.Spec.Version = "2.2.0"
+	g.Expect(r.Update(ctx, ako)).To(Succeed())
+	manager.Spec.ClusterOptions.OperatorVersion = ""
+	ako, err = r.ensureAddon(ctx, manager, bundle.AddonAKO)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ako.Spec.Version).To(Equal("2.2.0"))
+
+	ako.Status.Version = "2.1.0"
+	ako.Status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.IncompatibleCRDReason, "2.1.0 to 2.2.0", 0)
+	ako.Status.SetCondition(v1.AddonHealthyCondition, metav1.ConditionTrue, v1.RunningReason, "", 0)
+	setAddonCondition(manager, v1.OperatorInstalledCondition, ako)
+	g.Expect(manager.Status.IsConditionTrue(v1.OperatorInstalledCondition)).To(BeFalse())
+	g.Expect(meta.FindStatusCondition(manager.Status.Conditions, v1.OperatorInstalledCondition).Reason).To(Equal(v1.InstallFailedReason))
+}
+
+func TestClusterManagerPhase(t *testing.T) {
+	type condition struct {
+		conditionType string
+		status        metav1.ConditionStatus
+		reason        string
+	}
+	infrastructure := condition{v1.InfrastructureReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason}
+	controlPlane := condition{v1.ControlPlaneReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason}
+	operator := condition{v1.OperatorInstalledCondition, metav1.ConditionTrue, v1.InstalledReason}
+
+	tests := []struct {
+		name       string
+		conditions []condition
+		deleting   bool
+		phase      v1.ClusterPhase
+	}{
+		{name: "new", phase: v1.ManagerPhasePending},
+		{name: "infrastructure provisioning", phase: v1.ManagerPhaseClusterCreating,
+			conditions: []condition{{v1.InfrastructureReadyCondition, metav1.ConditionFalse, v1.ProvisioningReason}}},
+		{name: "control plane provisioning", phase: v1.ManagerPhaseClusterCreating,
+			conditions: []condition{infrastructure, {v1.ControlPlaneReadyCondition, metav1.ConditionFalse, v1.WaitingForControlPlaneReason}}},
+		{name: "operator installing", phase: v1.ManagerPhaseOperatorInstalling,
+			conditions: []condition{infrastructure, controlPlane, {v1.OperatorInstalledCondition, metav1.ConditionFalse, v1.WaitingForDependenciesReason}}},
+		{name: "provisioned", phase: v1.ManagerPhaseProvisioned, conditions: []condition{infrastructure, controlPlane, operator}},
+		{name: "upgrading kubernetes", phase: v1.ManagerPhaseUpgrading,
+			conditions: []condition{infrastructure, controlPlane, operator, {v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradingWorkersReason}}},
+		{name: "kubernetes upgrade blocked", phase: v1.ManagerPhaseProvisioned,
+			conditions: []condition{infrastructure, controlPlane, operator, {v1.KubeVersionUpgradedCondition, metav1.ConditionFalse, v1.UpgradeBlockedReason}}},
+		{name: "upgrading the operator", phase: v1.ManagerPhaseUpgrading,
+			conditions: []condition{infrastructure, controlPlane, {v1.OperatorInstalledCondition, metav1.ConditionFalse, v1.UpgradingReason}}},
+		{name: "operator install failed", phase: v1.ManagerPhaseOperatorInstalling,
+			conditions: []condition{infrastructure, controlPlane, {v1.OperatorInstalledCondition, metav1.ConditionFalse, v1.InstallFailedReason}}},
+		{name: "deleting", deleting: true, phase: v1.ManagerPhaseDeleting, conditions: []condition{infrastructure, controlPlane, operator}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			manager := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default"}}
+			if tt.deleting {
+				now := metav1.Now()
+				manager.DeletionTimestamp = &now
+			}
+			for _, c := range tt.conditions {
+				manager.Status.SetCondition(c.conditionType, c.status, c.reason, "", 1)
+			}
+			(&AeroClusterManagerReconciler{}).reconcilePhase(manager)
+			g.Expect(manager.Status.Phase).To(Equal(string(tt.phase)))
+		})
+	}
+}
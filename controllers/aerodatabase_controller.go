@@ -18,19 +18,30 @@ package controllers
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	"github.com/aerospike/aerostation/pkg/bundle"
+	"github.com/aerospike/aerostation/pkg/manifests"
 	"github.com/aerospike/aerostation/pkg/utils"
 
 	"github.com/aerospike/aerostation/pkg/utils/ako"
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/cluster-api/util/patch"
@@ -38,6 +49,7 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/api/v1alpha1"
 )
 
 // AeroDatabaseReconciler reconciles a AeroDatabase object
@@ -46,8 +58,39 @@ type AeroDatabaseReconciler struct {
 	Log     logr.Logger
 	Scheme  *runtime.Scheme
 	Tracker *remote.ClusterCacheTracker
+	// Addons are the manifests of the feature key and the rest client, the embedded ones if nil
+	Addons *bundle.Bundle
+	// NewApplier returns the applier of a workload cluster, utils.NewApplier if nil
+	NewApplier func(ctx context.Context, c client.Client, cluster client.ObjectKey) (*utils.Applier, error)
+	// Appliers caches the appliers of the workload clusters, NewApplier is called on every reconcile if nil
+	Appliers *utils.Appliers
+	// RemoteClient returns the client of a workload cluster, the one of Tracker if nil
+	RemoteClient func(ctx context.Context, cluster client.ObjectKey) (client.Client, error)
+
+	controller controller.Controller
 }
 
+// databaseTargetIndex indexes the AeroDatabases by the AerospikeCluster they deploy, see databaseTarget
+const databaseTargetIndex = "spec.target"
+
+// databaseTarget is the key of the AerospikeCluster of a database: the workload cluster, the target namespace and the
+// name of the database
+func databaseTarget(cluster client.ObjectKey, namespace, name string) string {
+	return cluster.String() + "/" + namespace + "/" + name
+}
+
+// indexDatabaseTarget is the extractor of databaseTargetIndex
+func indexDatabaseTarget(o client.Object) []string {
+	db, ok := o.(*v1.AeroDatabase)
+	if !ok {
+		return nil
+	}
+	return []string{databaseTarget(db.Spec.Cluster.ToObjectKey(), db.Spec.TargetNamespace, db.Spec.Name)}
+}
+
+// databaseStatusRequeueAfter is how often the remote status is polled until the database is running
+const databaseStatusRequeueAfter = 30 * time.Second
+
 //+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=aerodatabases,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=aerodatabases/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=aerodatabases/finalizers,verbs=update
@@ -85,6 +128,10 @@ func (r *AeroDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		if utils.ContainsString(db.GetFinalizers(), AerostationFinalizerName) {
 			// our finalizer is present, lets delete the cluster
 			if err := r.deleteExternalResources(ctx, db, cluster); err != nil {
+				db.Status.SetCondition(v1.DeletionBlockedCondition, metav1.ConditionTrue, v1.RemoteDeleteFailedReason, err.Error(), db.Generation)
+				if err := r.Status().Update(ctx, db); err != nil {
+					return ctrl.Result{}, err
+				}
 				return ctrl.Result{}, err
 			}
 
@@ -111,6 +158,8 @@ func (r *AeroDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	}
 
 	defer func() {
+		r.reconcilePhase(db)
+
 		patchOpts := []patch.Option{}
 		if reterr == nil {
 			patchOpts = append(patchOpts, patch.WithStatusObservedGeneration{})
@@ -143,21 +192,340 @@ func (r *AeroDatabaseReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
-	err = utils.ApplyDatabase(ctx, r.Client, db, ako.GetMemoryDB(db.Spec))
+	customResource, err := ako.GetDatabase(db.Spec)
+	if err != nil {
+		// the spec has to change before this database can be deployed, don't requeue
+		db.Status.LastError = err.Error()
+		db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, err.Error(), db.Generation)
+		return ctrl.Result{}, nil
+	}
+
+	if ako.RequiresStorage(db.Spec.DatabaseType) {
+		err := r.applyStorageClass(ctx, db, cluster)
+		if errors.Is(err, manifests.ErrNoStorage) {
+			// the database has to be placed on another cluster, don't requeue
+			db.Status.LastError = err.Error()
+			db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, err.Error(), db.Generation)
+			return ctrl.Result{}, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "failed to apply storage class for database %s/%s", req.Namespace, req.Name)
+		}
+	}
+
+	err = utils.ApplyDatabase(ctx, r.Client, r.Addons, db, customResource)
 
 	if err != nil {
+		db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, err.Error(), db.Generation)
 		return ctrl.Result{}, errors.Wrapf(err, "failed to patch database %s/%s", req.Namespace, req.Name)
 	}
-	db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseDeployed))
+
+	if err := r.watchAerospikeClusters(ctx, db); err != nil {
+		// without the watch we fall back to polling the remote status
+		r.Log.Info("unable to watch remote aerospike clusters", "cluster", db.Spec.Cluster.ToObjectKey(), "error", err.Error())
+	}
+
+	if err := r.reconcileStatus(ctx, db); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to get status of database %s/%s", req.Namespace, req.Name)
+	}
+
+	if err := r.reconcileMonitoring(ctx, db); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "failed to monitor database %s/%s", req.Namespace, req.Name)
+	}
+
+	if !db.Status.IsConditionTrue(v1.DatabaseReadyCondition) {
+		return ctrl.Result{RequeueAfter: databaseStatusRequeueAfter}, reterr
+	}
+	if db.Spec.Options.Monitoring.IsEnabled() && !db.Status.IsConditionTrue(v1.MonitoringReadyCondition) {
+		// the exporters follow the pods, and the prometheus operator may be installed later
+		return ctrl.Result{RequeueAfter: databaseStatusRequeueAfter}, reterr
+	}
 
 	return ctrl.Result{}, reterr
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *AeroDatabaseReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	// the events of the remote AerospikeClusters are mapped to their database through the index
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &v1.AeroDatabase{}, databaseTargetIndex, indexDatabaseTarget); err != nil {
+		return err
+	}
+
+	c, err := ctrl.NewControllerManagedBy(mgr).
 		For(&v1.AeroDatabase{}).
-		Complete(r)
+		Build(r)
+	if err != nil {
+		return err
+	}
+
+	r.controller = c
+	return nil
+}
+
+// watchAerospikeClusters watches the AerospikeClusters of the remote cluster the database is deployed to,
+// so that status changes made by the operator are mirrored without waiting for a requeue.
+func (r *AeroDatabaseReconciler) watchAerospikeClusters(ctx context.Context, db *v1.AeroDatabase) error {
+	clusterKey := db.Spec.Cluster.ToObjectKey()
+
+	return r.Tracker.Watch(ctx, remote.WatchInput{
+		Name:         "aerodatabase-watchAerospikeClusters",
+		Cluster:      clusterKey,
+		Watcher:      r.controller,
+		Kind:         &v1beta1.AerospikeCluster{},
+		EventHandler: handler.EnqueueRequestsFromMapFunc(r.aerospikeClusterToDatabases(clusterKey)),
+	})
+}
+
+// aerospikeClusterToDatabases maps an AerospikeCluster of the remote cluster to the AeroDatabases that deployed it
+func (r *AeroDatabaseReconciler) aerospikeClusterToDatabases(clusterKey client.ObjectKey) handler.MapFunc {
+	return func(o client.Object) []ctrl.Request {
+		result := []ctrl.Request{}
+
+		var databases v1.AeroDatabaseList
+		err := r.Client.List(context.Background(), &databases,
+			client.MatchingFields{databaseTargetIndex: databaseTarget(clusterKey, o.GetNamespace(), o.GetName())})
+		if err != nil {
+			return result
+		}
+
+		for _, db := range databases.Items {
+			name := client.ObjectKey{Namespace: db.Namespace, Name: db.Name}
+			result = append(result, ctrl.Request{NamespacedName: name})
+		}
+
+		return result
+	}
+}
+
+// reconcileStatus mirrors the status of the remote AerospikeCluster into the database status,
+// the database is Running once the operator caught up with the spec and every pod is ready.
+func (r *AeroDatabaseReconciler) reconcileStatus(ctx context.Context, db *v1.AeroDatabase) error {
+	remoteClient, err := r.remoteClient(ctx, db.Spec.Cluster.ToObjectKey())
+	if err != nil {
+		return err
+	}
+
+	aeroCluster := &v1beta1.AerospikeCluster{}
+	key := client.ObjectKey{Name: db.Spec.Name, Namespace: db.Spec.TargetNamespace}
+	if err := remoteClient.Get(ctx, key, aeroCluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			// not created yet, nothing to mirror
+			db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.ProvisioningReason,
+				"AerospikeCluster not found on the remote cluster", db.Generation)
+			return nil
+		}
+		return err
+	}
+
+	pods := &corev1.PodList{}
+	err = remoteClient.List(ctx, pods,
+		client.InNamespace(key.Namespace),
+		client.MatchingLabels{v1beta1.AerospikeCustomResourceLabel: key.Name})
+	if err != nil {
+		return err
+	}
+
+	var readyPods int32
+	for i := range pods.Items {
+		if isPodReady(&pods.Items[i]) {
+			readyPods++
+		}
+	}
+
+	// the operator reports a newer revision of the v1alpha1 pod status, convert by json field names
+	podStatus := map[string]v1alpha1.AerospikePodStatus{}
+	data, err := json.Marshal(aeroCluster.Status.Pods)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(data, &podStatus); err != nil {
+		return err
+	}
+
+	db.Status.Size = aeroCluster.Status.Size
+	db.Status.Image = aeroCluster.Status.Image
+	db.Status.ReadyPods = readyPods
+	db.Status.Pods = podStatus
+	db.Status.OperatorPhase = operatorPhase(aeroCluster)
+
+	switch {
+	case db.Status.OperatorPhase != v1.AerospikeClusterCompleted:
+		db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.OperatorInProgressReason,
+			fmt.Sprintf("operator reports %d of %d pods", len(aeroCluster.Status.Pods), aeroCluster.Spec.Size), db.Generation)
+	case readyPods != aeroCluster.Spec.Size:
+		db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason,
+			fmt.Sprintf("%d of %d pods ready", readyPods, aeroCluster.Spec.Size), db.Generation)
+	default:
+		db.Status.SetCondition(v1.DatabaseReadyCondition, metav1.ConditionTrue, v1.RunningReason, "", db.Generation)
+	}
+
+	return nil
+}
+
+// reconcileMonitoring applies the exporter Service and monitor of the database, or prunes them once monitoring is
+// disabled, and reports where the exporters are scraped
+func (r *AeroDatabaseReconciler) reconcileMonitoring(ctx context.Context, db *v1.AeroDatabase) error {
+	enabled := db.Spec.Options.Monitoring.IsEnabled()
+	if !enabled && db.Status.Monitoring == nil && meta.FindStatusCondition(db.Status.Conditions, v1.MonitoringReadyCondition) == nil {
+		return nil
+	}
+
+	applier, err := r.newApplier(ctx, db.Spec.Cluster.ToObjectKey())
+	if err != nil {
+		return err
+	}
+
+	monitor, err := applyMonitoring(ctx, applier, db)
+	if err != nil {
+		if enabled {
+			db.Status.SetCondition(v1.MonitoringReadyCondition, metav1.ConditionFalse, v1.DeployFailedReason, err.Error(), db.Generation)
+		}
+		return err
+	}
+	if !enabled {
+		db.Status.Monitoring = nil
+		meta.RemoveStatusCondition(&db.Status.Conditions, v1.MonitoringReadyCondition)
+		return nil
+	}
+
+	remoteClient, err := r.remoteClient(ctx, db.Spec.Cluster.ToObjectKey())
+	if err != nil {
+		return err
+	}
+	pods := &corev1.PodList{}
+	err = remoteClient.List(ctx, pods,
+		client.InNamespace(db.Spec.TargetNamespace),
+		client.MatchingLabels{v1beta1.AerospikeCustomResourceLabel: db.Spec.Name})
+	if err != nil {
+		return err
+	}
+
+	monitoring := db.Spec.Options.Monitoring.DeepCopy()
+	monitoring.Default()
+	endpoints := exporterEndpoints(pods.Items, monitoring.Port)
+	db.Status.Monitoring = &v1.MonitoringStatus{Monitor: monitor, Endpoints: endpoints}
+
+	switch {
+	case monitor == "":
+		db.Status.SetCondition(v1.MonitoringReadyCondition, metav1.ConditionFalse, v1.PrometheusOperatorMissingReason,
+			ako.MonitorCRD(monitoring.MonitorKind)+" not found on the cluster, the exporters are not scraped", db.Generation)
+	case len(pods.Items) == 0 || len(endpoints) != len(pods.Items):
+		db.Status.SetCondition(v1.MonitoringReadyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason,
+			fmt.Sprintf("%d of %d exporters ready", len(endpoints), len(pods.Items)), db.Generation)
+	default:
+		db.Status.SetCondition(v1.MonitoringReadyCondition, metav1.ConditionTrue, v1.RunningReason, "", db.Generation)
+	}
+
+	return nil
+}
+
+// applyMonitoring applies the monitoring manifests of a database under their own inventory, the monitor only if the
+// prometheus operator is installed. It returns the monitor, empty if there is none.
+func applyMonitoring(ctx context.Context, applier *utils.Applier, db *v1.AeroDatabase) (string, error) {
+	inventory := monitoringInventory(db)
+	if !db.Spec.Options.Monitoring.IsEnabled() {
+		_, err := applier.Remove(ctx, inventory)
+		return "", err
+	}
+
+	monitoring := db.Spec.Options.Monitoring.DeepCopy()
+	monitoring.Default()
+	crd := utils.ObjectRef{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Name: ako.MonitorCRD(monitoring.MonitorKind)}
+	_, err := applier.Resources.Get(ctx, crd, "v1")
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", errors.Wrapf(err, "failed to get %s", crd)
+	}
+	installed := err == nil
+
+	data, err := ako.GetMonitoring(db.Spec, installed)
+	if err != nil {
+		return "", err
+	}
+	results, err := applier.Apply(ctx, data, utils.ApplyOptions{Inventory: inventory, Namespace: db.Spec.TargetNamespace})
+	if err != nil {
+		return "", errors.New(failures(results, err))
+	}
+
+	if !installed {
+		return "", nil
+	}
+	return monitoring.MonitorKind + " " + db.Spec.TargetNamespace + "/" + ako.ExporterName(db.Spec.Name), nil
+}
+
+// monitoringInventory is the inventory of the monitoring objects of a database
+func monitoringInventory(db *v1.AeroDatabase) string {
+	return "monitoring-" + db.Spec.Name + "." + db.Spec.TargetNamespace
+}
+
+// exporterEndpoints are the metrics endpoints of the pods whose exporter is ready
+func exporterEndpoints(pods []corev1.Pod, port int32) []string {
+	var endpoints []string
+	for _, pod := range pods {
+		if pod.Status.PodIP == "" {
+			continue
+		}
+		for _, container := range pod.Status.ContainerStatuses {
+			if container.Name == ako.ExporterContainerName && container.Ready {
+				endpoints = append(endpoints, fmt.Sprintf("http://%s:%d/metrics", pod.Status.PodIP, port))
+			}
+		}
+	}
+	sort.Strings(endpoints)
+	return endpoints
+}
+
+func (r *AeroDatabaseReconciler) newApplier(ctx context.Context, cluster client.ObjectKey) (*utils.Applier, error) {
+	if r.Appliers != nil {
+		return r.Appliers.Get(ctx, r.Client, cluster)
+	}
+	if r.NewApplier != nil {
+		return r.NewApplier(ctx, r.Client, cluster)
+	}
+	return utils.NewApplier(ctx, r.Client, cluster)
+}
+
+func (r *AeroDatabaseReconciler) remoteClient(ctx context.Context, cluster client.ObjectKey) (client.Client, error) {
+	if r.RemoteClient != nil {
+		return r.RemoteClient(ctx, cluster)
+	}
+	return r.Tracker.GetClient(ctx, cluster)
+}
+
+// reconcilePhase derives the phase from the conditions
+func (r *AeroDatabaseReconciler) reconcilePhase(db *v1.AeroDatabase) {
+	ready := meta.FindStatusCondition(db.Status.Conditions, v1.DatabaseReadyCondition)
+
+	switch {
+	case ready != nil && ready.Status == metav1.ConditionTrue:
+		db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseRunning))
+	case ready != nil && ready.Reason != v1.DeployFailedReason:
+		db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhaseDeployed))
+	default:
+		db.Status.SetTypedPhase(v1.DBPhase(v1.DBPhasePending))
+	}
+}
+
+// operatorPhase derives the phase of an AerospikeCluster from the status the operator has written back
+func operatorPhase(aeroCluster *v1beta1.AerospikeCluster) string {
+	status := aeroCluster.Status
+	if status.Size != aeroCluster.Spec.Size || status.Image != aeroCluster.Spec.Image {
+		return v1.AerospikeClusterInProgress
+	}
+	if len(status.Pods) != int(aeroCluster.Spec.Size) {
+		return v1.AerospikeClusterInProgress
+	}
+
+	return v1.AerospikeClusterCompleted
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == corev1.PodReady {
+			return c.Status == corev1.ConditionTrue
+		}
+	}
+
+	return false
 }
 
 func (r *AeroDatabaseReconciler) DatabaseToCluster(o client.Object) []ctrl.Request {
@@ -188,8 +556,19 @@ func (r *AeroDatabaseReconciler) getDatabaseForCluster(ctx context.Context, db *
 	return c, err
 }
 
+// applyStorageClass creates the StorageClass persistent databases claim their volumes from, the one of the provider
+// of the cluster. Docker clusters have none, manifests.ErrNoStorage is returned for them.
+func (r *AeroDatabaseReconciler) applyStorageClass(ctx context.Context, db *v1.AeroDatabase, cluster *v1.AeroClusterManager) error {
+	storage, err := manifests.GetStorage(cluster.Spec.ClusterOptions.Provider)
+	if err != nil {
+		return err
+	}
+
+	return utils.Apply(ctx, storage, r.Client, db.Spec.Cluster.ToObjectKey())
+}
+
 func (r *AeroDatabaseReconciler) deleteExternalResources(ctx context.Context, db *v1.AeroDatabase, kube *v1.AeroClusterManager) error {
-	remoteClient, err := r.Tracker.GetClient(ctx, db.Spec.Cluster.ToObjectKey())
+	remoteClient, err := r.remoteClient(ctx, db.Spec.Cluster.ToObjectKey())
 	if err != nil {
 		// if kubernetes cluster does not exist, bad state.
 		return nil
@@ -197,12 +576,20 @@ func (r *AeroDatabaseReconciler) deleteExternalResources(ctx context.Context, db
 
 	// TODO: Clean up dangling Feature Secret
 
+	if db.Status.Monitoring != nil || meta.FindStatusCondition(db.Status.Conditions, v1.MonitoringReadyCondition) != nil {
+		applier, err := r.newApplier(ctx, db.Spec.Cluster.ToObjectKey())
+		if err != nil {
+			return err
+		}
+		if _, err := applier.Remove(ctx, monitoringInventory(db)); err != nil {
+			return err
+		}
+	}
+
 	obj := &v1beta1.AerospikeCluster{}
 	obj.Name = db.Spec.Name
-	obj.Namespace = db.Spec.Namespace
-	remoteClient.Delete(ctx, obj)
-
-	if err != nil {
+	obj.Namespace = db.Spec.TargetNamespace
+	if err := remoteClient.Delete(ctx, obj); err != nil && !apierrors.IsNotFound(err) {
 		return err
 	}
 
@@ -0,0 +1,274 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+)
+
+func monitoredDatabase() *v1.AeroDatabase {
+	db := &v1.AeroDatabase{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "default"},
+		Spec: v1.AeroDatabaseSpec{
+			Cluster:         v1.ClusterKey{Name: "edge", Namespace: "default"},
+			Name:            "demo",
+			Namespace:       "default",
+			TargetNamespace: "aerospike",
+		},
+	}
+	db.Spec.Options.Monitoring = &v1.MonitoringOptions{Enabled: true}
+	return db
+}
+
+func TestApplyMonitoring(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	w := &workload{objects: map[utils.ObjectRef]*unstructured.Unstructured{}}
+	applier := &utils.Applier{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster:   client.ObjectKey{Namespace: "default", Name: "edge"},
+		Resources: w,
+	}
+	db := monitoredDatabase()
+
+	// without the prometheus operator only the exporter service is applied
+	monitor, err := applyMonitoring(ctx, applier, db)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(monitor).To(BeEmpty())
+	g.Expect(w.has("Service", "demo-exporter")).To(BeTrue())
+	g.Expect(w.has("ServiceMonitor", "demo-exporter")).To(BeFalse())
+
+	crd := &unstructured.Unstructured{}
+	crd.SetAPIVersion("apiextensions.k8s.io/v1")
+	crd.SetKind("CustomResourceDefinition")
+	crd.SetName("servicemonitors.monitoring.coreos.com")
+	_, err = w.Apply(ctx, crd, metav1.PatchOptions{FieldManager: "prometheus-operator"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	monitor, err = applyMonitoring(ctx, applier, db)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(monitor).To(Equal("ServiceMonitor aerospike/demo-exporter"))
+	g.Expect(w.has("ServiceMonitor", "demo-exporter")).To(BeTrue())
+
+	// disabling the monitoring prunes the service and the monitor, the operator is kept
+	db.Spec.Options.Monitoring.Enabled = false
+	monitor, err = applyMonitoring(ctx, applier, db)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(monitor).To(BeEmpty())
+	g.Expect(w.has("Service", "demo-exporter")).To(BeFalse())
+	g.Expect(w.has("ServiceMonitor", "demo-exporter")).To(BeFalse())
+	g.Expect(w.has("CustomResourceDefinition", "servicemonitors.monitoring.coreos.com")).To(BeTrue())
+}
+
+func TestExporterEndpoints(t *testing.T) {
+	g := NewWithT(t)
+	pod := func(ip string, ready bool) corev1.Pod {
+		return corev1.Pod{Status: corev1.PodStatus{
+			PodIP: ip,
+			ContainerStatuses: []corev1.ContainerStatus{
+				{Name: "aerospike-server", Ready: true},
+				{Name: "exporter", Ready: ready},
+			},
+		}}
+	}
+
+	endpoints := exporterEndpoints([]corev1.Pod{pod("10.0.0.2", true), pod("10.0.0.1", true), pod("10.0.0.3", false), pod("", true)}, 9145)
+	g.Expect(endpoints).To(Equal([]string{"http://10.0.0.1:9145/metrics", "http://10.0.0.2:9145/metrics"}))
+}
+
+// remoteDatabase is the AerospikeCluster of monitoredDatabase, with the pods the operator reports and their pods
+func remoteDatabase(reported, ready int) []client.Object {
+	cluster := &v1beta1.AerospikeCluster{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "aerospike"}}
+	cluster.Spec.Size = 2
+	cluster.Spec.Image = "aerospike/aerospike-server-enterprise:5.6.0.7"
+	cluster.Status.Pods = map[string]v1beta1.AerospikePodStatus{}
+	objects := []client.Object{cluster}
+	for i := 0; i < reported; i++ {
+		name := fmt.Sprintf("demo-0-%d", i)
+		cluster.Status.Pods[name] = v1beta1.AerospikePodStatus{PodIP: fmt.Sprintf("10.0.0.%d", i), PodPort: 3000}
+		pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "aerospike",
+			Labels: map[string]string{v1beta1.AerospikeCustomResourceLabel: "demo"}}}
+		status := corev1.ConditionFalse
+		if i < ready {
+			status = corev1.ConditionTrue
+		}
+		pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: status}}
+		objects = append(objects, pod)
+	}
+	if reported == int(cluster.Spec.Size) {
+		cluster.Status.Size = cluster.Spec.Size
+		cluster.Status.Image = cluster.Spec.Image
+	}
+	return objects
+}
+
+func TestReconcileStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		remote   []client.Object
+		ready    metav1.ConditionStatus
+		reason   string
+		size     int32
+		pods     int
+		podReady int32
+	}{
+		{name: "not created", ready: metav1.ConditionFalse, reason: v1.ProvisioningReason},
+		{name: "operator in progress", remote: remoteDatabase(1, 1), ready: metav1.ConditionFalse, reason: v1.OperatorInProgressReason,
+			pods: 1, podReady: 1},
+		{name: "pods not ready", remote: remoteDatabase(2, 1), ready: metav1.ConditionFalse, reason: v1.PodsNotReadyReason,
+			size: 2, pods: 2, podReady: 1},
+		{name: "running", remote: remoteDatabase(2, 2), ready: metav1.ConditionTrue, reason: v1.RunningReason,
+			size: 2, pods: 2, podReady: 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			g.Expect(v1beta1.AddToScheme(scheme)).To(Succeed())
+			remote := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.remote...).Build()
+			r := &AeroDatabaseReconciler{RemoteClient: func(_ context.Context, cluster client.ObjectKey) (client.Client, error) {
+				g.Expect(cluster).To(Equal(client.ObjectKey{Namespace: "default", Name: "edge"}))
+				return remote, nil
+			}}
+
+			db := monitoredDatabase()
+			g.Expect(r.reconcileStatus(context.Background(), db)).To(Succeed())
+			ready := meta.FindStatusCondition(db.Status.Conditions, v1.DatabaseReadyCondition)
+			g.Expect(ready).NotTo(BeNil())
+			g.Expect(ready.Status).To(Equal(tt.ready))
+			g.Expect(ready.Reason).To(Equal(tt.reason))
+			g.Expect(db.Status.Size).To(Equal(tt.size))
+			g.Expect(db.Status.Pods).To(HaveLen(tt.pods))
+			g.Expect(db.Status.ReadyPods).To(Equal(tt.podReady))
+			if tt.pods > 0 {
+				g.Expect(db.Status.Pods["demo-0-0"].PodIP).To(Equal("10.0.0.0"))
+			}
+		})
+	}
+}
+
+// indexedClient answers the lists matching the database target index like the cache does, the fake client ignores
+// field selectors
+type indexedClient struct {
+	client.Client
+}
+
+func (c indexedClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	options := &client.ListOptions{}
+	options.ApplyOptions(opts)
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+	value, ok := options.FieldSelector.RequiresExactMatch(databaseTargetIndex)
+	if databases, isDatabases := list.(*v1.AeroDatabaseList); ok && isDatabases {
+		var items []v1.AeroDatabase
+		for _, db := range databases.Items {
+			if indexDatabaseTarget(&db)[0] == value {
+				items = append(items, db)
+			}
+		}
+		databases.Items = items
+	}
+	return nil
+}
+
+func TestAerospikeClusterToDatabases(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	db := monitoredDatabase()
+	otherCluster := monitoredDatabase()
+	otherCluster.Name = "demo-core"
+	otherCluster.Spec.Cluster.Name = "core"
+	otherNamespace := monitoredDatabase()
+	otherNamespace.Name = "demo-staging"
+	otherNamespace.Spec.TargetNamespace = "staging"
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(db, otherCluster, otherNamespace).Build()
+	r := &AeroDatabaseReconciler{Client: indexedClient{c}}
+
+	cluster := &v1beta1.AerospikeCluster{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "aerospike"}}
+	g.Expect(r.aerospikeClusterToDatabases(client.ObjectKey{Namespace: "default", Name: "edge"})(cluster)).To(Equal([]ctrl.Request{
+		{NamespacedName: client.ObjectKey{Namespace: "default", Name: "demo"}},
+	}))
+	g.Expect(r.aerospikeClusterToDatabases(client.ObjectKey{Namespace: "default", Name: "core"})(cluster)).To(Equal([]ctrl.Request{
+		{NamespacedName: client.ObjectKey{Namespace: "default", Name: "demo-core"}},
+	}))
+
+	cluster.Name = "unknown"
+	g.Expect(r.aerospikeClusterToDatabases(client.ObjectKey{Namespace: "default", Name: "edge"})(cluster)).To(BeEmpty())
+}
+
+func TestDatabasePhase(t *testing.T) {
+	type condition struct {
+		status metav1.ConditionStatus
+		reason string
+	}
+	tests := []struct {
+		name  string
+		ready *condition
+		phase v1.ClusterPhase
+	}{
+		{name: "new", phase: v1.DBPhasePending},
+		{name: "deploy failed", ready: &condition{metav1.ConditionFalse, v1.DeployFailedReason}, phase: v1.DBPhasePending},
+		{name: "provisioning", ready: &condition{metav1.ConditionFalse, v1.ProvisioningReason}, phase: v1.DBPhaseDeployed},
+		{name: "operator in progress", ready: &condition{metav1.ConditionFalse, v1.OperatorInProgressReason}, phase: v1.DBPhaseDeployed},
+		{name: "pods not ready", ready: &condition{metav1.ConditionFalse, v1.PodsNotReadyReason}, phase: v1.DBPhaseDeployed},
+		{name: "running", ready: &condition{metav1.ConditionTrue, v1.RunningReason}, phase: v1.DBPhaseRunning},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			db := monitoredDatabase()
+			if tt.ready != nil {
+				db.Status.SetCondition(v1.DatabaseReadyCondition, tt.ready.status, tt.ready.reason, "", 1)
+			}
+			(&AeroDatabaseReconciler{}).reconcilePhase(db)
+			g.Expect(db.Status.Phase).To(Equal(string(tt.phase)))
+		})
+	}
+}
+
+func TestDatabasePhaseTransitions(t *testing.T) {
+	g := NewWithT(t)
+	r := &AeroDatabaseReconciler{}
+	db := monitoredDatabase()
+
+	// a database goes back from running while its pods restart, and stays pending until a failed deploy is fixed
+	for _, step := range []struct {
+		status metav1.ConditionStatus
+		reason string
+		phase  v1.ClusterPhase
+	}{
+		{metav1.ConditionFalse, v1.DeployFailedReason, v1.DBPhasePending},
+		{metav1.ConditionFalse, v1.ProvisioningReason, v1.DBPhaseDeployed},
+		{metav1.ConditionTrue, v1.RunningReason, v1.DBPhaseRunning},
+		{metav1.ConditionFalse, v1.PodsNotReadyReason, v1.DBPhaseDeployed},
+		{metav1.ConditionTrue, v1.RunningReason, v1.DBPhaseRunning},
+		{metav1.ConditionFalse, v1.DeployFailedReason, v1.DBPhasePending},
+	} {
+		db.Status.SetCondition(v1.DatabaseReadyCondition, step.status, step.reason, "", 1)
+		r.reconcilePhase(db)
+		g.Expect(db.Status.Phase).To(Equal(string(step.phase)), step.reason)
+	}
+	g.Expect(db.Status.Conditions).To(HaveLen(1))
+}
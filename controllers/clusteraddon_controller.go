@@ -0,0 +1,467 @@
+/*
+Copyright 2021.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aerospike/aerospike-kubernetes-operator/api/v1beta1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	"sigs.k8s.io/cluster-api/util/patch"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	"github.com/aerospike/aerostation/pkg/bundle"
+	"github.com/aerospike/aerostation/pkg/utils"
+)
+
+// addonRequeueAfter is how often the health of an add-on is checked until it is ready
+const addonRequeueAfter = 30 * time.Second
+
+// addonKinds are the custom resource kinds aerostation writes, per add-on: every version of the add-on has to serve them
+var addonKinds = map[string][]schema.GroupVersionKind{
+	bundle.AddonAKO: {v1beta1.GroupVersion.WithKind("AerospikeCluster")},
+}
+
+// ClusterAddonReconciler reconciles a ClusterAddon object
+type ClusterAddonReconciler struct {
+	client.Client
+	Log    logr.Logger
+	Scheme *runtime.Scheme
+	// Addons are the manifests of the add-ons, the embedded ones if nil
+	Addons *bundle.Bundle
+	// NewApplier returns the applier of a workload cluster, utils.NewApplier if nil
+	NewApplier func(ctx context.Context, c client.Client, cluster client.ObjectKey) (*utils.Applier, error)
+	// Appliers caches the appliers of the workload clusters, NewApplier is called on every reconcile if nil
+	Appliers *utils.Appliers
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ClusterAddonReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&v1.ClusterAddon{}).
+		// the dependents of an add-on wait for it, its dependencies for their dependents to be removed
+		Watches(&source.Kind{Type: &v1.ClusterAddon{}}, handler.EnqueueRequestsFromMapFunc(r.addonToAddons)).
+		Watches(&source.Kind{Type: &v1.AeroClusterManager{}}, handler.EnqueueRequestsFromMapFunc(r.managerToAddons)).
+		Complete(r)
+}
+
+//+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=clusteraddons,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=clusteraddons/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=aerostation.aerospike.com,resources=clusteraddons/finalizers,verbs=update
+
+// Reconcile installs the version of the spec once the cluster and the dependencies of the add-on are ready, and
+// removes the objects of the add-on once it has no dependents left.
+func (r *ClusterAddonReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
+	_ = r.Log.WithValues("clusteraddon", req.NamespacedName)
+
+	addon := &v1.ClusterAddon{}
+	if err := r.Client.Get(ctx, req.NamespacedName, addon); err != nil {
+		if apierrors.IsNotFound(err) {
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+
+	if !addon.DeletionTimestamp.IsZero() {
+		if utils.ContainsString(addon.GetFinalizers(), AerostationFinalizerName) {
+			r.reconcilePhase(addon)
+			res, err := r.remove(ctx, addon)
+			if err != nil || !res.IsZero() {
+				// surface why the add-on is not gone yet
+				if err := r.Status().Update(ctx, addon); err != nil {
+					return ctrl.Result{}, err
+				}
+				return res, err
+			}
+
+			controllerutil.RemoveFinalizer(addon, AerostationFinalizerName)
+			if err := r.Update(ctx, addon); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if !utils.ContainsString(addon.GetFinalizers(), AerostationFinalizerName) {
+		controllerutil.AddFinalizer(addon, AerostationFinalizerName)
+		if err := r.Update(ctx, addon); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	patchHelper, err := patch.NewHelper(addon, r.Client)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	defer func() {
+		r.reconcilePhase(addon)
+		if err := patchHelper.Patch(ctx, addon); err != nil {
+			reterr = kerrors.NewAggregate([]error{reterr, err})
+		}
+	}()
+
+	return r.reconcile(ctx, addon)
+}
+
+func (r *ClusterAddonReconciler) reconcile(ctx context.Context, addon *v1.ClusterAddon) (ctrl.Result, error) {
+	status := &addon.Status
+	invalid := func(message string) (ctrl.Result, error) {
+		// the spec has to change, don't requeue
+		status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.InvalidSpecReason, message, addon.Generation)
+		return ctrl.Result{}, nil
+	}
+
+	manager := &v1.AeroClusterManager{}
+	if err := r.Client.Get(ctx, client.ObjectKey{Namespace: addon.Namespace, Name: addon.Spec.Cluster}, manager); err != nil {
+		if apierrors.IsNotFound(err) {
+			status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.WaitingForClusterReason,
+				fmt.Sprintf("cluster %s not found", addon.Spec.Cluster), addon.Generation)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	// the add-ons go away with their cluster, which is reconciled on their changes as their controller. An add-on
+	// controlled by something else keeps its controller and is only owned by the cluster.
+	if err := setClusterOwner(manager, addon, r.Scheme); err != nil {
+		return ctrl.Result{}, err
+	}
+	if addon.Labels[common.ClusterLabelName] != manager.Name {
+		if addon.Labels == nil {
+			addon.Labels = map[string]string{}
+		}
+		addon.Labels[common.ClusterLabelName] = manager.Name
+	}
+
+	addons, err := r.clusterAddons(ctx, addon)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	for _, other := range addons {
+		// the inventory is per add-on and per namespace, the first of the duplicates installs it
+		if other.Name < addon.Name && other.Spec.Addon == addon.Spec.Addon && other.Spec.TargetNamespace == addon.Spec.TargetNamespace {
+			return invalid(fmt.Sprintf("%s is installed by %s", addon.Spec.Addon, other.Name))
+		}
+	}
+
+	versions, err := r.Addons.Versions(ctx, addon.Spec.Addon)
+	if err != nil && !errors.Is(err, bundle.ErrUnknownAddon) {
+		return ctrl.Result{}, err
+	}
+	status.AvailableVersions = versions
+
+	manifest, err := r.Addons.Get(ctx, addon.Spec.Addon, addon.Spec.Version)
+	if errors.Is(err, bundle.ErrUnknownAddon) || errors.Is(err, bundle.ErrUnknownVersion) {
+		return invalid(err.Error())
+	}
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if len(addon.Spec.Values) > 0 && !manifest.Template {
+		return invalid(fmt.Sprintf("%s takes no values", addon.Spec.Addon))
+	}
+	var values interface{}
+	if manifest.Template {
+		values = addon.Spec.Values
+	}
+	rendered, err := manifest.Render(values)
+	if err != nil {
+		return invalid(err.Error())
+	}
+
+	waiting, err := r.dependencies(addon, addons)
+	if err != nil {
+		return invalid(err.Error())
+	}
+	if len(waiting) > 0 {
+		status.SetCondition(v1.AddonDependenciesReadyCondition, metav1.ConditionFalse, v1.WaitingForDependenciesReason,
+			strings.Join(waiting, ", "), addon.Generation)
+	} else {
+		status.SetCondition(v1.AddonDependenciesReadyCondition, metav1.ConditionTrue, v1.InstalledReason, "", addon.Generation)
+	}
+
+	if !manager.Status.IsConditionTrue(v1.ControlPlaneReadyCondition) {
+		status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.WaitingForControlPlaneReason, "", addon.Generation)
+		return ctrl.Result{}, nil
+	}
+
+	// an installed version is kept in sync, a new one waits for the dependencies: they are installed and upgraded first
+	if status.Version != manifest.Version && len(waiting) > 0 {
+		reason := v1.WaitingForDependenciesReason
+		if status.Version != "" {
+			reason = v1.UpgradingReason
+		}
+		status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, reason,
+			"waiting for "+strings.Join(waiting, ", "), addon.Generation)
+		return ctrl.Result{}, nil
+	}
+
+	applier, err := r.newApplier(ctx, manager.Spec.ClusterID.ToObjectKey())
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if status.Version != "" && status.Version != manifest.Version {
+		// the installed version keeps running until the custom resources of the cluster can be served by the new one
+		err := applier.CheckCRDs(ctx, rendered, addonKinds[addon.Spec.Addon]...)
+		if errors.Is(err, utils.ErrIncompatibleCRD) {
+			status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.IncompatibleCRDReason,
+				fmt.Sprintf("%s to %s: %s", status.Version, manifest.Version, err), addon.Generation)
+			return ctrl.Result{RequeueAfter: addonRequeueAfter}, nil
+		}
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	results, err := applier.Apply(ctx, rendered, utils.ApplyOptions{
+		Inventory: utils.AddonInventory(addon.Spec.Addon, addon.Spec.TargetNamespace),
+		Namespace: addon.Spec.TargetNamespace,
+	})
+	if err != nil {
+		status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionFalse, v1.InstallFailedReason, failures(results, err), addon.Generation)
+		return ctrl.Result{}, err
+	}
+
+	status.Version, status.Source, status.SHA256 = manifest.Version, manifest.Source, manifest.SHA256
+	status.Objects = 0
+	for _, result := range results {
+		if result.Code == common.ResultCodeSynced {
+			status.Objects++
+		}
+	}
+	status.SetCondition(v1.AddonAppliedCondition, metav1.ConditionTrue, v1.InstalledReason, manifest.Version, addon.Generation)
+
+	healthy, message, err := applier.Health(ctx, results)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !healthy {
+		status.SetCondition(v1.AddonHealthyCondition, metav1.ConditionFalse, v1.PodsNotReadyReason, message, addon.Generation)
+		return ctrl.Result{RequeueAfter: addonRequeueAfter}, nil
+	}
+	status.SetCondition(v1.AddonHealthyCondition, metav1.ConditionTrue, v1.RunningReason, "", addon.Generation)
+	return ctrl.Result{}, nil
+}
+
+// remove deletes the objects of the add-on once no other add-on of the cluster depends on it. Nothing is removed from
+// a cluster that is gone or being deleted.
+func (r *ClusterAddonReconciler) remove(ctx context.Context, addon *v1.ClusterAddon) (ctrl.Result, error) {
+	manager := &v1.AeroClusterManager{}
+	err := r.Client.Get(ctx, client.ObjectKey{Namespace: addon.Namespace, Name: addon.Spec.Cluster}, manager)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+	if apierrors.IsNotFound(err) || !manager.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	addons, err := r.clusterAddons(ctx, addon)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	var dependents []string
+	for _, other := range addons {
+		dependsOn, err := dependsOn(&other)
+		if err == nil && utils.ContainsString(dependsOn, addon.Spec.Addon) {
+			dependents = append(dependents, other.Name)
+		}
+	}
+	if len(dependents) > 0 {
+		addon.Status.SetCondition(v1.DeletionBlockedCondition, metav1.ConditionTrue, v1.DependentsExistReason,
+			fmt.Sprintf("waiting for %s to be deleted", strings.Join(dependents, ", ")), addon.Generation)
+		return ctrl.Result{RequeueAfter: addonRequeueAfter}, nil
+	}
+
+	applier, err := r.newApplier(ctx, manager.Spec.ClusterID.ToObjectKey())
+	if err == nil {
+		var results []utils.ApplyResult
+		results, err = applier.Remove(ctx, utils.AddonInventory(addon.Spec.Addon, addon.Spec.TargetNamespace))
+		if err != nil {
+			err = errors.New(failures(results, err))
+		}
+	}
+	if err != nil {
+		addon.Status.SetCondition(v1.DeletionBlockedCondition, metav1.ConditionTrue, v1.RemoteDeleteFailedReason, err.Error(), addon.Generation)
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// dependencies returns why the dependencies of the add-on are not ready
+func (r *ClusterAddonReconciler) dependencies(addon *v1.ClusterAddon, addons []v1.ClusterAddon) ([]string, error) {
+	names, err := dependsOn(addon)
+	if err != nil {
+		return nil, err
+	}
+
+	var waiting []string
+	for _, name := range names {
+		installed, ready := false, false
+		for i := range addons {
+			if addons[i].Spec.Addon == name && addons[i].DeletionTimestamp.IsZero() {
+				installed = true
+				ready = ready || addons[i].IsReady()
+			}
+		}
+		switch {
+		case !installed:
+			waiting = append(waiting, name+" is not installed")
+		case !ready:
+			waiting = append(waiting, name+" is not ready")
+		}
+	}
+	return waiting, nil
+}
+
+// dependsOn returns the dependencies of the bundle and of the spec of an add-on
+func dependsOn(addon *v1.ClusterAddon) ([]string, error) {
+	names, err := bundle.DependsOn(addon.Spec.Addon)
+	if err != nil {
+		return nil, err
+	}
+	for _, name := range addon.Spec.DependsOn {
+		if name == addon.Spec.Addon {
+			return nil, fmt.Errorf("%s depends on itself", name)
+		}
+		if !utils.ContainsString(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// clusterAddons returns the other add-ons of the cluster of an add-on
+func (r *ClusterAddonReconciler) clusterAddons(ctx context.Context, addon *v1.ClusterAddon) ([]v1.ClusterAddon, error) {
+	var list v1.ClusterAddonList
+	if err := r.Client.List(ctx, &list, client.InNamespace(addon.Namespace)); err != nil {
+		return nil, err
+	}
+
+	addons := []v1.ClusterAddon{}
+	for _, other := range list.Items {
+		if other.Spec.Cluster == addon.Spec.Cluster && other.Name != addon.Name {
+			addons = append(addons, other)
+		}
+	}
+	return addons, nil
+}
+
+func (r *ClusterAddonReconciler) newApplier(ctx context.Context, cluster client.ObjectKey) (*utils.Applier, error) {
+	if r.Appliers != nil {
+		return r.Appliers.Get(ctx, r.Client, cluster)
+	}
+	if r.NewApplier != nil {
+		return r.NewApplier(ctx, r.Client, cluster)
+	}
+	return utils.NewApplier(ctx, r.Client, cluster)
+}
+
+// failures returns the error of an apply with the objects that failed
+func failures(results []utils.ApplyResult, err error) string {
+	messages := []string{err.Error()}
+	for _, result := range results {
+		if result.Code == common.ResultCodeSyncFailed {
+			messages = append(messages, fmt.Sprintf("%s: %s", result.Object, result.Message))
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// reconcilePhase derives the phase from the conditions
+func (r *ClusterAddonReconciler) reconcilePhase(addon *v1.ClusterAddon) {
+	status := &addon.Status
+	applied := meta.FindStatusCondition(status.Conditions, v1.AddonAppliedCondition)
+
+	switch {
+	case !addon.DeletionTimestamp.IsZero():
+		status.SetTypedPhase(v1.AddonPhaseDeleting)
+	case addon.IsReady():
+		status.SetTypedPhase(v1.AddonPhaseInstalled)
+	case applied == nil:
+		status.SetTypedPhase(v1.AddonPhasePending)
+	case applied.Reason == v1.InstallFailedReason || applied.Reason == v1.InvalidSpecReason || applied.Reason == v1.IncompatibleCRDReason:
+		status.SetTypedPhase(v1.AddonPhaseFailed)
+	case applied.Reason == v1.UpgradingReason:
+		status.SetTypedPhase(v1.AddonPhaseUpgrading)
+	case applied.Status == metav1.ConditionTrue:
+		status.SetTypedPhase(v1.AddonPhaseInstalling)
+	default:
+		status.SetTypedPhase(v1.AddonPhasePending)
+	}
+}
+
+// addonToAddons maps an add-on to the other add-ons of its cluster
+func (r *ClusterAddonReconciler) addonToAddons(o client.Object) []ctrl.Request {
+	addon, ok := o.(*v1.ClusterAddon)
+	if !ok {
+		panic(fmt.Sprintf("Expected a ClusterAddon but got a %T", o))
+	}
+
+	addons, err := r.clusterAddons(context.Background(), addon)
+	if err != nil {
+		return nil
+	}
+	result := []ctrl.Request{}
+	for _, other := range addons {
+		result = append(result, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&other)})
+	}
+	return result
+}
+
+// managerToAddons maps an AeroClusterManager to the add-ons of its cluster
+func (r *ClusterAddonReconciler) managerToAddons(o client.Object) []ctrl.Request {
+	var list v1.ClusterAddonList
+	if err := r.Client.List(context.Background(), &list, client.InNamespace(o.GetNamespace())); err != nil {
+		return nil
+	}
+
+	result := []ctrl.Request{}
+	for _, addon := range list.Items {
+		if addon.Spec.Cluster == o.GetName() {
+			result = append(result, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&addon)})
+		}
+	}
+	return result
+}
+
+// setClusterOwner makes the cluster the controller of an add-on, or one of its owners if it is controlled by another
+// object, a controller reference that is already set is left alone
+func setClusterOwner(manager *v1.AeroClusterManager, addon *v1.ClusterAddon, scheme *runtime.Scheme) error {
+	if metav1.IsControlledBy(addon, manager) {
+		return nil
+	}
+	if metav1.GetControllerOf(addon) != nil {
+		return controllerutil.SetOwnerReference(manager, addon, scheme)
+	}
+	return controllerutil.SetControllerReference(manager, addon, scheme)
+}
@@ -0,0 +1,318 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/bundle"
+	"github.com/aerospike/aerostation/pkg/utils"
+)
+
+// workload is a workload cluster where the workloads roll out as soon as they are applied, if ready
+type workload struct {
+	objects map[utils.ObjectRef]*unstructured.Unstructured
+	ready   bool
+}
+
+func (w *workload) Get(_ context.Context, ref utils.ObjectRef, _ string) (*unstructured.Unstructured, error) {
+	obj, ok := w.objects[ref]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: ref.Group, Resource: ref.Kind}, ref.Name)
+	}
+	obj = obj.DeepCopy()
+	if w.ready {
+		_ = unstructured.SetNestedField(obj.Object, int64(1), "status", "updatedReplicas")
+		_ = unstructured.SetNestedField(obj.Object, int64(1), "status", "availableReplicas")
+		_ = unstructured.SetNestedField(obj.Object, int64(1), "status", "readyReplicas")
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{map[string]interface{}{"type": "Established", "status": "True"}},
+			"status", "conditions")
+	}
+	return obj, nil
+}
+
+func (w *workload) Apply(_ context.Context, obj *unstructured.Unstructured, options metav1.PatchOptions) (*unstructured.Unstructured, error) {
+	applied := obj.DeepCopy()
+	applied.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: options.FieldManager}})
+	w.objects[utils.ObjectRef{Group: applied.GroupVersionKind().Group, Kind: applied.GetKind(), Namespace: applied.GetNamespace(), Name: applied.GetName()}] = applied
+	return applied.DeepCopy(), nil
+}
+
+func (w *workload) Delete(_ context.Context, ref utils.ObjectRef, _ string, _ metav1.DeleteOptions) error {
+	delete(w.objects, ref)
+	return nil
+}
+
+func (w *workload) List(_ context.Context, ref utils.ObjectRef, _ string, _ metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	for r, obj := range w.objects {
+		if r.Group == ref.Group && r.Kind == ref.Kind && (ref.Namespace == "" || r.Namespace == ref.Namespace) {
+			list.Items = append(list.Items, *obj.DeepCopy())
+		}
+	}
+	return list, nil
+}
+
+func (w *workload) has(kind, name string) bool {
+	for ref := range w.objects {
+		if ref.Kind == kind && ref.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func newAddonReconciler(t *testing.T, objects ...client.Object) (*ClusterAddonReconciler, *workload) {
+	scheme := runtime.NewScheme()
+	g := NewWithT(t)
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+
+	manager := &v1.AeroClusterManager{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default"},
+		Spec:       v1.AeroClusterManagerSpec{ClusterID: v1.NamespacedName{Namespace: "default", Name: "edge"}},
+	}
+	manager.Status.SetCondition(v1.ControlPlaneReadyCondition, metav1.ConditionTrue, v1.ProvisionedReason, "", 0)
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(objects, manager)...).Build()
+
+	w := &workload{objects: map[utils.ObjectRef]*unstructured.Unstructured{}}
+	return &ClusterAddonReconciler{
+		Client: c,
+		Log:    log.Log,
+		Scheme: scheme,
+		Addons: bundle.New(c, "aerostation-system"),
+		NewApplier: func(_ context.Context, c client.Client, cluster client.ObjectKey) (*utils.Applier, error) {
+			return &utils.Applier{Client: c, Cluster: cluster, Resources: w}, nil
+		},
+	}, w
+}
+
+func clusterAddon(name string) *v1.ClusterAddon {
+	return &v1.ClusterAddon{
+		ObjectMeta: metav1.ObjectMeta{Name: "edge-" + name, Namespace: "default"},
+		Spec:       v1.ClusterAddonSpec{Cluster: "edge", Addon: name},
+	}
+}
+
+func reconcileAddon(t *testing.T, r *ClusterAddonReconciler, name string) (ctrl.Result, *v1.ClusterAddon) {
+	g := NewWithT(t)
+	key := client.ObjectKey{Namespace: "default", Name: "edge-" + name}
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	addon := &v1.ClusterAddon{}
+	if err := r.Get(context.Background(), key, addon); apierrors.IsNotFound(err) {
+		return res, nil
+	}
+	return res, addon
+}
+
+func TestClusterAddonDependencies(t *testing.T) {
+	g := NewWithT(t)
+	r, w := newAddonReconciler(t, clusterAddon(bundle.AddonCertManager), clusterAddon(bundle.AddonAKO))
+
+	// ako waits for cert-manager
+	_, ako := reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhasePending)))
+	g.Expect(ako.Status.IsConditionTrue(v1.AddonDependenciesReadyCondition)).To(BeFalse())
+	g.Expect(ako.OwnerReferences).To(HaveLen(1))
+	g.Expect(*ako.OwnerReferences[0].Controller).To(BeTrue())
+	g.Expect(w.objects).To(BeEmpty())
+
+	// cert-manager is applied, its pods are not ready yet
+	res, certManager := reconcileAddon(t, r, bundle.AddonCertManager)
+	g.Expect(res.RequeueAfter).To(Equal(addonRequeueAfter))
+	g.Expect(certManager.Status.Phase).To(Equal(string(v1.AddonPhaseInstalling)))
+	g.Expect(certManager.Status.Version).To(Equal("v1.4.0"))
+	g.Expect(certManager.Status.Source).To(Equal(bundle.SourceEmbedded))
+	g.Expect(certManager.Status.Objects).To(BeNumerically(">", 0))
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhasePending)))
+
+	w.ready = true
+	_, certManager = reconcileAddon(t, r, bundle.AddonCertManager)
+	g.Expect(certManager.Status.Phase).To(Equal(string(v1.AddonPhaseInstalled)))
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhaseInstalled)))
+	g.Expect(ako.Status.Version).To(Equal("2.0.0"))
+	g.Expect(w.has("Deployment", "aerospike-operator-controller-manager")).To(BeTrue())
+}
+
+func TestClusterAddonUpgrade(t *testing.T) {
+	g := NewWithT(t)
+	ako210 := "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: ako\n  namespace: aerospike\n"
+	r, w := newAddonReconciler(t, clusterAddon(bundle.AddonCertManager), clusterAddon(bundle.AddonAKO),
+		&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ako-2.1.0", Namespace: "aerostation-system", Labels: map[string]string{bundle.AddonLabel: bundle.AddonAKO}},
+			Data:       map[string]string{bundle.KeyVersion: "2.1.0", bundle.KeyManifest: ako210},
+		})
+	w.ready = true
+	reconcileAddon(t, r, bundle.AddonCertManager)
+	reconcileAddon(t, r, bundle.AddonAKO)
+
+	// cert-manager is upgraded first, ako waits for it
+	_, certManager := reconcileAddon(t, r, bundle.AddonCertManager)
+	certManager.Generation++
+	g.Expect(r.Update(context.Background(), certManager)).To(Succeed())
+	_, ako := reconcileAddon(t, r, bundle.AddonAKO)
+	ako.Spec.Version = "2.1.0"
+	g.Expect(r.Update(context.Background(), ako)).To(Succeed())
+
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhaseUpgrading)))
+	g.Expect(ako.Status.Version).To(Equal("2.0.0"))
+
+	reconcileAddon(t, r, bundle.AddonCertManager)
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhaseInstalled)))
+	g.Expect(ako.Status.Version).To(Equal("2.1.0"))
+	g.Expect(ako.Status.Source).To(Equal("configmap:aerostation-system/ako-2.1.0"))
+	// the objects of 2.0.0 are pruned, the namespaces are kept
+	g.Expect(w.has("ConfigMap", "ako")).To(BeTrue())
+	g.Expect(w.has("Deployment", "aerospike-operator-controller-manager")).To(BeFalse())
+}
+
+func TestClusterAddonRemove(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	r, w := newAddonReconciler(t, clusterAddon(bundle.AddonCertManager), clusterAddon(bundle.AddonAKO))
+	w.ready = true
+	reconcileAddon(t, r, bundle.AddonCertManager)
+	reconcileAddon(t, r, bundle.AddonAKO)
+
+	// cert-manager is removed after ako
+	g.Expect(r.Delete(ctx, clusterAddon(bundle.AddonCertManager))).To(Succeed())
+	res, certManager := reconcileAddon(t, r, bundle.AddonCertManager)
+	g.Expect(res.RequeueAfter).To(Equal(addonRequeueAfter))
+	g.Expect(certManager.Status.IsConditionTrue(v1.DeletionBlockedCondition)).To(BeTrue())
+	g.Expect(w.has("Deployment", "cert-manager")).To(BeTrue())
+
+	g.Expect(r.Delete(ctx, clusterAddon(bundle.AddonAKO))).To(Succeed())
+	_, ako := reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako).To(BeNil())
+	g.Expect(w.has("Deployment", "aerospike-operator-controller-manager")).To(BeFalse())
+
+	_, certManager = reconcileAddon(t, r, bundle.AddonCertManager)
+	g.Expect(certManager).To(BeNil())
+	g.Expect(w.has("Deployment", "cert-manager")).To(BeFalse())
+	// the inventories are gone
+	var inventories corev1.ConfigMapList
+	g.Expect(r.List(ctx, &inventories, client.MatchingLabels{"aerostation.io/inventory": bundle.AddonAKO})).To(Succeed())
+	g.Expect(inventories.Items).To(BeEmpty())
+}
+
+func TestClusterAddonInvalid(t *testing.T) {
+	g := NewWithT(t)
+	istio := clusterAddon("istio")
+	features := clusterAddon(bundle.AddonFeatures)
+	features.Spec.Values = map[string]string{"key": "value"}
+	restClient := clusterAddon(bundle.AddonRESTClient)
+	r, w := newAddonReconciler(t, istio, features, restClient)
+
+	for _, name := range []string{"istio", bundle.AddonFeatures, bundle.AddonRESTClient} {
+		res, addon := reconcileAddon(t, r, name)
+		g.Expect(res.IsZero()).To(BeTrue(), name)
+		g.Expect(addon.Status.Phase).To(Equal(string(v1.AddonPhaseFailed)), name)
+	}
+	g.Expect(w.objects).To(BeEmpty())
+}
+
+func TestSetClusterOwner(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	g.Expect(v1.AddToScheme(scheme)).To(Succeed())
+	manager := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "edge", Namespace: "default", UID: "uid-edge"}}
+
+	// the controller reference the cluster created the add-on with is kept
+	addon := clusterAddon(bundle.AddonAKO)
+	g.Expect(setClusterOwner(manager, addon, scheme)).To(Succeed())
+	g.Expect(setClusterOwner(manager, addon, scheme)).To(Succeed())
+	g.Expect(addon.OwnerReferences).To(HaveLen(1))
+	g.Expect(metav1.IsControlledBy(addon, manager)).To(BeTrue())
+
+	// the controller of an add-on created by another object is left alone
+	addon = clusterAddon(bundle.AddonAKO)
+	other := &v1.AeroClusterManager{ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "default", UID: "uid-other"}}
+	g.Expect(setClusterOwner(other, addon, scheme)).To(Succeed())
+	g.Expect(setClusterOwner(manager, addon, scheme)).To(Succeed())
+	g.Expect(addon.OwnerReferences).To(HaveLen(2))
+	g.Expect(metav1.IsControlledBy(addon, other)).To(BeTrue())
+}
+
+func TestClusterAddonIncompatibleCRD(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	akoCRD := `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: aerospikeclusters.asdb.aerospike.com
+spec:
+  group: asdb.aerospike.com
+  names:
+    kind: AerospikeCluster
+  versions:
+    - name: v1beta1
+      served: %s
+      storage: true
+`
+	override := func(version, served string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "ako-" + version, Namespace: "aerostation-system", Labels: map[string]string{bundle.AddonLabel: bundle.AddonAKO}},
+			Data:       map[string]string{bundle.KeyVersion: version, bundle.KeyManifest: fmt.Sprintf(akoCRD, served)},
+		}
+	}
+	r, w := newAddonReconciler(t, clusterAddon(bundle.AddonCertManager), clusterAddon(bundle.AddonAKO),
+		override("2.2.0", "true"), override("2.3.0", "false"))
+	w.ready = true
+	reconcileAddon(t, r, bundle.AddonCertManager)
+	_, ako := reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.AvailableVersions).To(Equal([]string{"2.0.0", "2.2.0", "2.3.0"}))
+
+	// the aerospike clusters of an older operator are still stored as v1alpha1
+	crd := utils.ObjectRef{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition", Name: "aerospikeclusters.asdb.aerospike.com"}
+	g.Expect(w.objects).To(HaveKey(crd))
+	w.objects[crd].Object["status"] = map[string]interface{}{"storedVersions": []interface{}{"v1alpha1", "v1beta1"}}
+	cluster := utils.ObjectRef{Group: "asdb.aerospike.com", Kind: "AerospikeCluster", Namespace: "aerospike", Name: "demo"}
+	w.objects[cluster] = &unstructured.Unstructured{}
+
+	ako.Spec.Version = "2.2.0"
+	g.Expect(r.Update(ctx, ako)).To(Succeed())
+	res, ako := reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(res.RequeueAfter).To(Equal(addonRequeueAfter))
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhaseFailed)))
+	g.Expect(ako.Status.Version).To(Equal("2.0.0"))
+	g.Expect(ako.Status.IsConditionTrue(v1.AddonHealthyCondition)).To(BeTrue())
+	g.Expect(w.has("Deployment", "aerospike-operator-controller-manager")).To(BeTrue())
+
+	// migrated, the upgrade goes ahead
+	w.objects[crd].Object["status"] = map[string]interface{}{"storedVersions": []interface{}{"v1beta1"}}
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Phase).To(Equal(string(v1.AddonPhaseInstalled)))
+	g.Expect(ako.Status.Version).To(Equal("2.2.0"))
+	g.Expect(w.has("Deployment", "aerospike-operator-controller-manager")).To(BeFalse())
+
+	// a version that stops serving the aerospike clusters the controller writes is refused, with or without clusters
+	delete(w.objects, cluster)
+	ako.Spec.Version = "2.3.0"
+	g.Expect(r.Update(ctx, ako)).To(Succeed())
+	_, ako = reconcileAddon(t, r, bundle.AddonAKO)
+	g.Expect(ako.Status.Version).To(Equal("2.2.0"))
+	applied := meta.FindStatusCondition(ako.Status.Conditions, v1.AddonAppliedCondition)
+	g.Expect(applied).NotTo(BeNil())
+	g.Expect(applied.Reason).To(Equal(v1.IncompatibleCRDReason))
+	g.Expect(applied.Message).To(ContainSubstring("no longer serves v1beta1 (required)"))
+}
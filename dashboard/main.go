@@ -2,6 +2,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"k8s.io/client-go/rest"
@@ -9,6 +10,7 @@ import (
 	"net/http"
 
 	"github.com/aerospike/aerostation/cmd/aeroctl/cmd"
+	"github.com/aerospike/aerostation/pkg/authn"
 	"github.com/aerospike/aerostation/pkg/dashboard/routes"
 	"github.com/gorilla/mux"
 
@@ -16,9 +18,11 @@ import (
 )
 
 var kubeconfigfile string
+var authConfigFile string
 
 func init() {
 	flag.StringVar(&kubeconfigfile, "kc", "", "Path to a kubeconfig")
+	flag.StringVar(&authConfigFile, "auth_config", "/etc/aerostation/auth/config.yaml", "authenticators of the dashboard, see pkg/authn")
 
 	flag.Parse()
 }
@@ -26,7 +30,13 @@ func init() {
 //go:generate swagger generate spec -o ./swaggerui/swagger.json -m
 func main() {
 
-	routes.SetupGoGuardian()
+	authConfig, err := authn.Load(authConfigFile)
+	if err != nil {
+		panic(err.Error())
+	}
+	if err := routes.SetupGoGuardian(context.Background(), authConfig); err != nil {
+		panic(err.Error())
+	}
 	// creates the in-cluster config
 	config, err := rest.InClusterConfig()
 	if err != nil {
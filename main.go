@@ -37,6 +37,8 @@ import (
 
 	aerostationv1 "github.com/aerospike/aerostation/api/v1"
 	"github.com/aerospike/aerostation/controllers"
+	"github.com/aerospike/aerostation/pkg/bundle"
+	"github.com/aerospike/aerostation/pkg/utils"
 	"github.com/spf13/pflag"
 	capiawsv1beta1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -58,6 +60,8 @@ var (
 	scheme     = runtime.NewScheme()
 	setupLog   = ctrl.Log.WithName("setup")
 	syncPeriod time.Duration
+
+	addonsNamespace string
 )
 
 func init() {
@@ -76,12 +80,18 @@ func init() {
 	utilruntime.Must(expv1beta1.AddToScheme(scheme))
 	utilruntime.Must(aerov1b1.AddToScheme(scheme))
 	utilruntime.Must(fleet.AddToScheme(scheme))
+	// CAPZ (aks) and CAPG (gke) types are not registered: their releases for
+	// cluster-api v1.0 pin other controller-runtime versions, so their objects
+	// are built and applied unstructured and the controller reports a missing
+	// provider with the ProviderNotInstalled reason, see capi.CheckProvider.
 	//+kubebuilder:scaffold:scheme
 }
 
 func InitFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&syncPeriod, "sync-period", 30*time.Minute,
 		"The minimum interval at which watched resources are reconciled (e.g. 15m)")
+	fs.StringVar(&addonsNamespace, "addons-namespace", "aerostation-system",
+		"The namespace of the ConfigMaps overriding the embedded add-on manifests")
 }
 
 func main() {
@@ -125,6 +135,7 @@ func main() {
 	}
 
 	setupReconcilers(ctx, mgr)
+	setupWebhooks(mgr)
 
 	//+kubebuilder:scaffold:builder
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -143,6 +154,22 @@ func main() {
 	}
 }
 
+func setupWebhooks(mgr ctrl.Manager) {
+	// webhooks need certificates, set ENABLE_WEBHOOKS=false when running the manager locally
+	if os.Getenv("ENABLE_WEBHOOKS") == "false" {
+		return
+	}
+
+	if err := (&aerostationv1.AeroClusterManager{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AeroClusterManager")
+		os.Exit(1)
+	}
+	if err := (&aerostationv1.AeroDatabase{}).SetupWebhookWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create webhook", "webhook", "AeroDatabase")
+		os.Exit(1)
+	}
+}
+
 func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 	// Set up a ClusterCacheTracker to provide to controllers
 	// requiring a connection to a remote cluster
@@ -158,11 +185,20 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		os.Exit(1)
 	}
 
+	// the overrides are read without the cache, the manager does not watch every ConfigMap
+	addons := bundle.New(mgr.GetAPIReader(), addonsNamespace)
+	// the operator versions of the clusters are validated against the overrides as well
+	aerostationv1.Addons = addons
+
+	// the add-ons and the databases of a cluster are applied with the same discovery
+	appliers := &utils.Appliers{}
 	aeroDbReconciler := &controllers.AeroDatabaseReconciler{
-		Client:  mgr.GetClient(),
-		Log:     ctrl.Log.WithName("controllers").WithName("AeroDatabase"),
-		Scheme:  mgr.GetScheme(),
-		Tracker: tracker,
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("AeroDatabase"),
+		Scheme:   mgr.GetScheme(),
+		Tracker:  tracker,
+		Addons:   addons,
+		Appliers: appliers,
 	}
 	if err = aeroDbReconciler.SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "AeroDatabase")
@@ -179,6 +215,16 @@ func setupReconcilers(ctx context.Context, mgr ctrl.Manager) {
 		setupLog.Error(err, "unable to create controller", "controller", "AeroClusterManager")
 		os.Exit(1)
 	}
+	if err = (&controllers.ClusterAddonReconciler{
+		Client:   mgr.GetClient(),
+		Log:      ctrl.Log.WithName("controllers").WithName("ClusterAddon"),
+		Scheme:   mgr.GetScheme(),
+		Addons:   addons,
+		Appliers: appliers,
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterAddon")
+		os.Exit(1)
+	}
 
  
 }
@@ -12,6 +12,7 @@ import (
 type CognitoClient struct {
 	AppClientId string
 	UserPoolId  string
+	Region      string
 	*cognitoidentityprovider.Client
 }
 
@@ -25,6 +26,12 @@ func Init() (*CognitoClient, error) {
 	return &CognitoClient{
 		AppClientId: os.Getenv("COGNITO_APP_CLIENT_ID"),
 		UserPoolId:  os.Getenv("COGNITO_USER_POOL_ID"),
+		Region:      cfg.Region,
 		Client:      cognitoidentityprovider.NewFromConfig(cfg),
 	}, nil
 }
+
+// Issuer - returns the oidc issuer of the user pool, the iss claim of the tokens of its users
+func (c CognitoClient) Issuer() string {
+	return "https://cognito-idp." + c.Region + ".amazonaws.com/" + c.UserPoolId
+}
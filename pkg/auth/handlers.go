@@ -2,13 +2,19 @@ package auth
 
 import (
 	"encoding/json"
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/aerospike/aerostation/pkg/tenant"
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	"log"
 	"net/http"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type CognitoRouter struct {
 	Client CognitoClient
+	// Namespaces creates the tenant namespace of the users that sign up, none is created if nil
+	Namespaces client.Client
 }
 
 func NewCognitoRouter(client CognitoClient) *CognitoRouter {
@@ -88,6 +94,14 @@ func (c *CognitoRouter) SignUp(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// the namespace is created again with the first database if this fails
+	if c.Namespaces != nil {
+		if _, err := tenant.EnsureNamespace(r.Context(), c.Namespaces, req.Username,
+			authn.Identity(authn.AuthenticatorOIDC, c.Client.Issuer(), req.Username)); err != nil {
+			log.Println("[WARN] unable to create tenant namespace of", req.Username, err)
+		}
+	}
+
 	w.Write([]byte("signup complete !"))
 }
 
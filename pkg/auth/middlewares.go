@@ -1,12 +1,8 @@
 package auth
 
 import (
-	"context"
-	"fmt"
 	//"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
 	"github.com/gorilla/handlers"
-	"github.com/lestrrat-go/jwx/jwk"
-	"github.com/lestrrat-go/jwx/jwt"
 	"net/http"
 	"os"
 	"strings"
@@ -34,24 +30,8 @@ func AuthMiddleware(next http.Handler) http.HandlerFunc {
 			return
 		}
 
-		publicKeyURL := "https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json"
-		formattedURLString := fmt.Sprintf(publicKeyURL, os.Getenv("AWS_REGION"), cognitoClient.UserPoolId)
 		//todo: the publicKeyURL is static and it's not the recommend way as per best security practices, we should use jwk.NewAutoRefresh()
-
-		// Use jwk.AutoRefresh if you intend to keep reuse the JWKS over and over
-		keySet, err := jwk.Fetch(context.Background(), formattedURLString)
-		if err != nil {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-			return
-		}
-
-		//parse token
-		_, err = jwt.Parse(
-			[]byte(splitAuthHeader[1]),
-			jwt.WithKeySet(keySet),
-			jwt.WithValidate(true),
-		)
-		if err != nil {
+		if _, err := cognitoClient.VerifyToken(r.Context(), splitAuthHeader[1]); err != nil {
 			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
@@ -0,0 +1,25 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jwt"
+)
+
+// KeySetURL returns the url of the keys that sign the tokens of the user pool
+func (c *CognitoClient) KeySetURL() string {
+	return fmt.Sprintf("https://cognito-idp.%s.amazonaws.com/%s/.well-known/jwks.json", os.Getenv("AWS_REGION"), c.UserPoolId)
+}
+
+// VerifyToken validates a token issued by cognito
+func (c *CognitoClient) VerifyToken(ctx context.Context, token string) (jwt.Token, error) {
+	keySet, err := jwk.Fetch(ctx, c.KeySetURL())
+	if err != nil {
+		return nil, err
+	}
+
+	return jwt.Parse([]byte(token), jwt.WithKeySet(keySet), jwt.WithValidate(true))
+}
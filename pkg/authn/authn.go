@@ -0,0 +1,147 @@
+// Package authn builds the chain of authenticators of the http servers from a config file. A request is
+// authenticated by the first authenticator that accepts it, the user and its roles are on the request context as
+// the go-guardian auth.Info, see auth.User.
+package authn
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/union"
+	"github.com/shaj13/libcache"
+	_ "github.com/shaj13/libcache/fifo"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// AuthenticatorExtension is the extension of the auth.Info naming the authenticator of the user
+	AuthenticatorExtension = "aerostation.io/authenticator"
+	// IssuerExtension is the extension of the auth.Info with the issuer of the oidc users
+	IssuerExtension = "aerostation.io/issuer"
+
+	AuthenticatorToken    = "token"
+	AuthenticatorOIDC     = "oidc"
+	AuthenticatorHtpasswd = "htpasswd"
+
+	// cacheTTL bounds how long an authenticated user is remembered, tokens are not remembered past their expiry
+	cacheTTL = 10 * time.Minute
+)
+
+// ErrNoAuthenticator is returned for configs without any authenticator, every request would be rejected
+var ErrNoAuthenticator = errors.New("no authenticator is configured")
+
+// Config selects the authenticators, a request is tried with the api tokens, then oidc, then htpasswd
+type Config struct {
+	// Htpasswd is a htpasswd file of users with bcrypt passwords, authenticated with basic auth
+	Htpasswd string `json:"htpasswd,omitempty"`
+	// Roles of the htpasswd users
+	Roles map[string][]string `json:"roles,omitempty"`
+	// Tokens is a yaml file of api tokens, see Token, passed as bearer tokens
+	Tokens string `json:"tokens,omitempty"`
+	// OIDC authenticates the bearer tokens issued by an openid connect provider
+	OIDC *OIDCConfig `json:"oidc,omitempty"`
+}
+
+// OIDCConfig validates the tokens of an issuer
+type OIDCConfig struct {
+	// Issuer is the iss claim of the tokens, its keys are discovered from /.well-known/openid-configuration
+	Issuer string `json:"issuer"`
+	// JWKSURL is the url of the keys that sign the tokens, skips discovery if set
+	JWKSURL string `json:"jwksURL,omitempty"`
+	// Audience is required in the aud claim if set
+	Audience string `json:"audience,omitempty"`
+	// UsernameClaim names the user, sub if empty
+	UsernameClaim string `json:"usernameClaim,omitempty"`
+	// RolesClaim is a list of the roles of the user, groups if empty
+	RolesClaim string `json:"rolesClaim,omitempty"`
+}
+
+// Load reads a yaml config, the environment variables in it are expanded
+func Load(path string) (Config, error) {
+	config := Config{}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.UnmarshalStrict([]byte(os.ExpandEnv(string(data))), &config); err != nil {
+		return config, err
+	}
+	return config, nil
+}
+
+// NewStrategy returns the chain of the configured authenticators. The keys of the oidc issuer are refreshed in the
+// background until ctx is done.
+func NewStrategy(ctx context.Context, config Config) (auth.Strategy, error) {
+	var strategies []auth.Strategy
+
+	if config.Tokens != "" {
+		tokens, err := LoadTokens(config.Tokens)
+		if err != nil {
+			return nil, err
+		}
+		strategies = append(strategies, NewTokenStrategy(tokens, newCache()))
+	}
+
+	if config.OIDC != nil {
+		verifier, err := NewVerifier(ctx, *config.OIDC)
+		if err != nil {
+			return nil, err
+		}
+		strategies = append(strategies, NewOIDCStrategy(verifier, newCache()))
+	}
+
+	if config.Htpasswd != "" {
+		users, err := LoadHtpasswd(config.Htpasswd)
+		if err != nil {
+			return nil, err
+		}
+		strategies = append(strategies, NewHtpasswdStrategy(users, config.Roles, newCache()))
+	}
+
+	if len(strategies) == 0 {
+		return nil, ErrNoAuthenticator
+	}
+	return union.New(strategies...), nil
+}
+
+// HasRole tells if a user has a role
+func HasRole(info auth.Info, role string) bool {
+	for _, r := range info.GetGroups() {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// Authenticator returns the authenticator that accepted a user
+func Authenticator(info auth.Info) string {
+	return info.GetExtensions().Get(AuthenticatorExtension)
+}
+
+// Identity is the key of a user across the authenticators, a user name of two authenticators or of two oidc issuers
+// is two users
+func Identity(authenticator, issuer, user string) string {
+	return authenticator + "\n" + issuer + "\n" + user
+}
+
+// IdentityOf returns the Identity of an authenticated user
+func IdentityOf(info auth.Info) string {
+	return Identity(Authenticator(info), info.GetExtensions().Get(IssuerExtension), info.GetUserName())
+}
+
+func newUser(name, id string, roles []string, authenticator string) auth.Info {
+	extensions := auth.Extensions{}
+	extensions.Set(AuthenticatorExtension, authenticator)
+	return auth.NewDefaultUser(name, id, roles, extensions)
+}
+
+func newCache() libcache.Cache {
+	cache := libcache.FIFO.New(0)
+	cache.SetTTL(cacheTTL)
+	return cache
+}
@@ -0,0 +1,280 @@
+package authn
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwa"
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+	. "github.com/onsi/gomega"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// issuer is a local stand-in of an oidc provider, it serves the discovery document and the public keys
+type issuer struct {
+	*httptest.Server
+
+	mu   sync.Mutex
+	keys map[string]*rsa.PrivateKey
+}
+
+func newIssuer(t *testing.T, kids ...string) *issuer {
+	i := &issuer{keys: map[string]*rsa.PrivateKey{}}
+	for _, kid := range kids {
+		i.addKey(t, kid)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": i.URL, "jwks_uri": i.URL + "/jwks.json"})
+	})
+	mux.HandleFunc("/jwks.json", func(w http.ResponseWriter, r *http.Request) {
+		i.mu.Lock()
+		defer i.mu.Unlock()
+		set := jwk.NewSet()
+		for kid, key := range i.keys {
+			public, _ := jwk.New(key.Public())
+			_ = public.Set(jwk.KeyIDKey, kid)
+			_ = public.Set(jwk.AlgorithmKey, jwa.RS256)
+			set.Add(public)
+		}
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	i.Server = httptest.NewServer(mux)
+	t.Cleanup(i.Close)
+	return i
+}
+
+func (i *issuer) addKey(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.keys[kid] = key
+}
+
+func (i *issuer) sign(t *testing.T, kid string, claims map[string]interface{}) string {
+	g := NewWithT(t)
+
+	token := jwt.New()
+	g.Expect(token.Set(jwt.IssuerKey, i.URL)).To(Succeed())
+	g.Expect(token.Set(jwt.ExpirationKey, time.Now().Add(time.Hour))).To(Succeed())
+	for name, value := range claims {
+		g.Expect(token.Set(name, value)).To(Succeed())
+	}
+
+	headers := jws.NewHeaders()
+	g.Expect(headers.Set(jws.KeyIDKey, kid)).To(Succeed())
+	i.mu.Lock()
+	key := i.keys[kid]
+	i.mu.Unlock()
+	signed, err := jwt.Sign(token, jwa.RS256, key, jwt.WithHeaders(headers))
+	g.Expect(err).NotTo(HaveOccurred())
+	return string(signed)
+}
+
+func writeFile(t *testing.T, name, content string) string {
+	path := filepath.Join(t.TempDir(), name)
+	NewWithT(t).Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+	return path
+}
+
+func htpasswd(t *testing.T, users ...string) string {
+	var content string
+	for _, user := range users {
+		hash, err := bcrypt.GenerateFromPassword([]byte(user+"-password"), bcrypt.MinCost)
+		NewWithT(t).Expect(err).NotTo(HaveOccurred())
+		content += user + ":" + string(hash) + "\n"
+	}
+	return writeFile(t, "htpasswd", "# users\n"+content)
+}
+
+func sha(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func authenticate(strategy auth.Strategy, set func(r *http.Request)) (auth.Info, error) {
+	r := httptest.NewRequest(http.MethodGet, "/", nil)
+	set(r)
+	return strategy.Authenticate(r.Context(), r)
+}
+
+func basicAuth(user, password string) func(r *http.Request) {
+	return func(r *http.Request) { r.SetBasicAuth(user, password) }
+}
+
+func bearer(token string) func(r *http.Request) {
+	return func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+token) }
+}
+
+func TestHtpasswd(t *testing.T) {
+	g := NewWithT(t)
+
+	strategy, err := NewStrategy(context.Background(), Config{
+		Htpasswd: htpasswd(t, "alice", "bob"),
+		Roles:    map[string][]string{"alice": {"admin"}},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	info, err := authenticate(strategy, basicAuth("alice", "alice-password"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.GetUserName()).To(Equal("alice"))
+	g.Expect(HasRole(info, "admin")).To(BeTrue())
+	g.Expect(Authenticator(info)).To(Equal(AuthenticatorHtpasswd))
+
+	info, err = authenticate(strategy, basicAuth("bob", "bob-password"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.GetGroups()).To(BeEmpty())
+
+	_, err = authenticate(strategy, basicAuth("alice", "bob-password"))
+	g.Expect(err).To(HaveOccurred())
+	_, err = authenticate(strategy, basicAuth("aerospike", "Aerospike123!"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestLoadHtpasswd(t *testing.T) {
+	g := NewWithT(t)
+
+	// md5 and sha1 hashes of htpasswd are not accepted
+	_, err := LoadHtpasswd(writeFile(t, "htpasswd", "alice:{SHA}W6ph5Mm5Pz8GgiULbPgzG37mj9g=\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("not a bcrypt hash")))
+
+	_, err = LoadHtpasswd(writeFile(t, "htpasswd", "alice\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("expected user:password")))
+}
+
+func TestTokens(t *testing.T) {
+	g := NewWithT(t)
+
+	tokens := writeFile(t, "tokens.yaml", `
+- name: ci
+  user: ci-bot
+  roles: [admin, ci]
+  sha256: `+sha("ci-token")+`
+- name: old
+  user: ci-bot
+  sha256: `+sha("old-token")+`
+  expires: "2020-01-01T00:00:00Z"
+`)
+	strategy, err := NewStrategy(context.Background(), Config{Tokens: tokens})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	info, err := authenticate(strategy, bearer("ci-token"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.GetUserName()).To(Equal("ci-bot"))
+	g.Expect(info.GetGroups()).To(ConsistOf("admin", "ci"))
+	g.Expect(Authenticator(info)).To(Equal(AuthenticatorToken))
+
+	_, err = authenticate(strategy, bearer("old-token"))
+	g.Expect(err).To(MatchError(ContainSubstring("expired")))
+	_, err = authenticate(strategy, bearer(sha("ci-token")))
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = LoadTokens(writeFile(t, "tokens.yaml", "- name: plain\n  user: ci-bot\n  sha256: ci-token\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("not a hex encoded sha256")))
+}
+
+func TestOIDC(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	i := newIssuer(t, "key-1")
+	strategy, err := NewStrategy(ctx, Config{OIDC: &OIDCConfig{
+		Issuer:        i.URL,
+		Audience:      "aerostation",
+		UsernameClaim: "username",
+		RolesClaim:    "cognito:groups",
+	}})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	claims := map[string]interface{}{
+		jwt.SubjectKey:   "0b5b3c",
+		jwt.AudienceKey:  "aerostation",
+		"username":       "dev1",
+		"cognito:groups": []string{"admin"},
+	}
+	info, err := authenticate(strategy, bearer(i.sign(t, "key-1", claims)))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(info.GetUserName()).To(Equal("dev1"))
+	g.Expect(info.GetID()).To(Equal("0b5b3c"))
+	g.Expect(info.GetGroups()).To(ConsistOf("admin"))
+	g.Expect(Authenticator(info)).To(Equal(AuthenticatorOIDC))
+	g.Expect(IdentityOf(info)).To(Equal(Identity(AuthenticatorOIDC, i.URL, "dev1")))
+	g.Expect(IdentityOf(info)).NotTo(Equal(Identity(AuthenticatorHtpasswd, "", "dev1")))
+
+	// the keys are refreshed when the issuer rotates them
+	i.addKey(t, "key-2")
+	_, err = authenticate(strategy, bearer(i.sign(t, "key-2", claims)))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	invalid := map[string]map[string]interface{}{
+		"audience": {jwt.AudienceKey: "other", "username": "dev1"},
+		"username": {jwt.AudienceKey: "aerostation"},
+		"expired":  {jwt.AudienceKey: "aerostation", "username": "dev1", jwt.ExpirationKey: time.Now().Add(-time.Hour)},
+	}
+	for name, claims := range invalid {
+		_, err := authenticate(strategy, bearer(i.sign(t, "key-1", claims)))
+		g.Expect(err).To(HaveOccurred(), name)
+	}
+
+	// tokens of another issuer are rejected, even when its keys are the same
+	other := newIssuer(t)
+	other.keys = i.keys
+	_, err = authenticate(strategy, bearer(other.sign(t, "key-1", claims)))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestChain(t *testing.T) {
+	g := NewWithT(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	i := newIssuer(t, "key-1")
+	config := writeFile(t, "config.yaml", `
+htpasswd: `+htpasswd(t, "alice")+`
+tokens: `+writeFile(t, "tokens.yaml", "- name: ci\n  user: ci-bot\n  sha256: "+sha("ci-token")+"\n")+`
+oidc:
+  issuer: ${TEST_ISSUER}
+  jwksURL: ${TEST_ISSUER}/jwks.json
+`)
+	t.Setenv("TEST_ISSUER", i.URL)
+	loaded, err := Load(config)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(loaded.OIDC.Issuer).To(Equal(i.URL))
+
+	strategy, err := NewStrategy(ctx, loaded)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	users := map[string]func(r *http.Request){
+		"alice":  basicAuth("alice", "alice-password"),
+		"ci-bot": bearer("ci-token"),
+		"dev1":   bearer(i.sign(t, "key-1", map[string]interface{}{jwt.SubjectKey: "dev1"})),
+	}
+	for user, set := range users {
+		info, err := authenticate(strategy, set)
+		g.Expect(err).NotTo(HaveOccurred(), user)
+		g.Expect(info.GetUserName()).To(Equal(user))
+	}
+
+	_, err = authenticate(strategy, func(r *http.Request) {})
+	g.Expect(err).To(HaveOccurred())
+
+	_, err = NewStrategy(ctx, Config{})
+	g.Expect(err).To(MatchError(ErrNoAuthenticator))
+}
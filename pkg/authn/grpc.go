@@ -0,0 +1,60 @@
+package authn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/shaj13/go-guardian/v2/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// MetadataKey is the grpc metadata carrying the Authorization header of the http request a call is made for
+const MetadataKey = "authorization"
+
+// ErrNoCredentials is returned for grpc calls without credentials
+var ErrNoCredentials = errors.New("no credentials")
+
+type credentialsKey struct{}
+
+// WithCredentials keeps the Authorization header of a request, the grpc calls made with the context forward it with
+// the client interceptors
+func WithCredentials(ctx context.Context, authorization string) context.Context {
+	return context.WithValue(ctx, credentialsKey{}, authorization)
+}
+
+func outgoing(ctx context.Context) context.Context {
+	if authorization, ok := ctx.Value(credentialsKey{}).(string); ok && authorization != "" {
+		return metadata.AppendToOutgoingContext(ctx, MetadataKey, authorization)
+	}
+	return ctx
+}
+
+// UnaryClientInterceptor forwards the credentials of WithCredentials
+func UnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	return invoker(outgoing(ctx), method, req, reply, cc, opts...)
+}
+
+// StreamClientInterceptor forwards the credentials of WithCredentials
+func StreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(outgoing(ctx), desc, cc, method, opts...)
+}
+
+// AuthenticateIncoming authenticates the forwarded credentials of a grpc call like the http request they come from
+func AuthenticateIncoming(ctx context.Context, strategy auth.Strategy) (auth.Info, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	values := md.Get(MetadataKey)
+	if len(values) == 0 || values[0] == "" {
+		return nil, ErrNoCredentials
+	}
+
+	r, err := http.NewRequestWithContext(ctx, http.MethodPost, "/", nil)
+	if err != nil {
+		return nil, err
+	}
+	r.Header.Set("Authorization", values[0])
+	return strategy.Authenticate(ctx, r)
+}
@@ -0,0 +1,61 @@
+package authn
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/basic"
+	"github.com/shaj13/libcache"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// LoadHtpasswd reads the users of a htpasswd file, only bcrypt passwords are accepted (htpasswd -B)
+func LoadHtpasswd(path string) (map[string][]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	users := map[string][]byte{}
+	scanner := bufio.NewScanner(f)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, fmt.Errorf("%s:%d: expected user:password", path, n)
+		}
+		if _, err := bcrypt.Cost([]byte(parts[1])); err != nil {
+			return nil, fmt.Errorf("%s:%d: password of %s is not a bcrypt hash: %w", path, n, parts[0], err)
+		}
+		users[parts[0]] = []byte(parts[1])
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// NewHtpasswdStrategy authenticates the users of a htpasswd file with basic auth, the users are cached because
+// bcrypt is slow on purpose
+func NewHtpasswdStrategy(users map[string][]byte, roles map[string][]string, cache libcache.Cache) auth.Strategy {
+	return basic.NewCached(func(ctx context.Context, r *http.Request, userName, password string) (auth.Info, error) {
+		hash, ok := users[userName]
+		if !ok {
+			return nil, fmt.Errorf("[ERROR] Invalid credentials")
+		}
+		if err := bcrypt.CompareHashAndPassword(hash, []byte(password)); err != nil {
+			return nil, fmt.Errorf("[ERROR] Invalid credentials")
+		}
+		return newUser(userName, userName, roles[userName], AuthenticatorHtpasswd), nil
+	}, cache)
+}
@@ -0,0 +1,198 @@
+package authn
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lestrrat-go/jwx/jwk"
+	"github.com/lestrrat-go/jwx/jws"
+	"github.com/lestrrat-go/jwx/jwt"
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
+	"github.com/shaj13/libcache"
+)
+
+const (
+	defaultUsernameClaim = "sub"
+	defaultRolesClaim    = "groups"
+
+	// minRefreshInterval bounds how often a token signed by an unknown key refreshes the keys
+	minRefreshInterval = time.Minute
+)
+
+// ErrNoUsername is returned for tokens without the username claim
+var ErrNoUsername = errors.New("token has no username")
+
+// Verifier validates the tokens of an oidc issuer with its keys, which are refreshed in the background and when a
+// token is signed by a key that is not known yet
+type Verifier struct {
+	config  OIDCConfig
+	jwksURL string
+	keys    *jwk.AutoRefresh
+
+	mu          sync.Mutex
+	lastRefresh time.Time
+}
+
+// NewVerifier discovers the keys of the issuer unless the config has a JWKSURL and fetches them
+func NewVerifier(ctx context.Context, config OIDCConfig) (*Verifier, error) {
+	if config.Issuer == "" {
+		return nil, errors.New("oidc issuer is required")
+	}
+	if config.UsernameClaim == "" {
+		config.UsernameClaim = defaultUsernameClaim
+	}
+	if config.RolesClaim == "" {
+		config.RolesClaim = defaultRolesClaim
+	}
+
+	jwksURL := config.JWKSURL
+	if jwksURL == "" {
+		discovered, err := discoverJWKSURL(ctx, config.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		jwksURL = discovered
+	}
+
+	keys := jwk.NewAutoRefresh(ctx)
+	keys.Configure(jwksURL)
+	if _, err := keys.Refresh(ctx, jwksURL); err != nil {
+		return nil, fmt.Errorf("unable to fetch the keys of %s: %w", config.Issuer, err)
+	}
+
+	return &Verifier{config: config, jwksURL: jwksURL, keys: keys}, nil
+}
+
+// discoverJWKSURL reads the jwks_uri of the openid configuration of the issuer
+func discoverJWKSURL(ctx context.Context, issuer string) (string, error) {
+	url := strings.TrimSuffix(issuer, "/") + "/.well-known/openid-configuration"
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unable to discover the keys of %s: %s", issuer, response.Status)
+	}
+
+	discovery := struct {
+		JWKSURI string `json:"jwks_uri"`
+	}{}
+	if err := json.NewDecoder(response.Body).Decode(&discovery); err != nil {
+		return "", err
+	}
+	if discovery.JWKSURI == "" {
+		return "", fmt.Errorf("openid configuration of %s has no jwks_uri", issuer)
+	}
+	return discovery.JWKSURI, nil
+}
+
+// Verify validates a token and returns its user, the user has the roles of the token
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (auth.Info, time.Time, error) {
+	keySet, err := v.keySet(ctx, tokenString)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	options := []jwt.ParseOption{
+		jwt.WithKeySet(keySet),
+		jwt.InferAlgorithmFromKey(true),
+		jwt.WithValidate(true),
+		jwt.WithIssuer(v.config.Issuer),
+	}
+	if v.config.Audience != "" {
+		options = append(options, jwt.WithAudience(v.config.Audience))
+	}
+	parsed, err := jwt.Parse([]byte(tokenString), options...)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	userName, ok := stringClaim(parsed, v.config.UsernameClaim)
+	if !ok {
+		return nil, time.Time{}, ErrNoUsername
+	}
+	user := newUser(userName, parsed.Subject(), roles(parsed, v.config.RolesClaim), AuthenticatorOIDC)
+	user.GetExtensions().Set(IssuerExtension, v.config.Issuer)
+	return user, parsed.Expiration(), nil
+}
+
+// keySet returns the keys of the issuer, they are refreshed first if the token is signed by an unknown key
+func (v *Verifier) keySet(ctx context.Context, tokenString string) (jwk.Set, error) {
+	keySet, err := v.keys.Fetch(ctx, v.jwksURL)
+	if err != nil {
+		return nil, err
+	}
+
+	message, err := jws.Parse([]byte(tokenString))
+	if err != nil {
+		return nil, err
+	}
+	signatures := message.Signatures()
+	if len(signatures) == 0 {
+		return keySet, nil
+	}
+	kid := signatures[0].ProtectedHeaders().KeyID()
+	if _, ok := keySet.LookupKeyID(kid); ok || kid == "" {
+		return keySet, nil
+	}
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if time.Since(v.lastRefresh) < minRefreshInterval {
+		return keySet, nil
+	}
+	v.lastRefresh = time.Now()
+	return v.keys.Refresh(ctx, v.jwksURL)
+}
+
+// NewOIDCStrategy authenticates the bearer tokens of an oidc issuer, a token is cached until it expires
+func NewOIDCStrategy(verifier *Verifier, cache libcache.Cache) auth.Strategy {
+	return token.New(func(ctx context.Context, r *http.Request, bearer string) (auth.Info, time.Time, error) {
+		return verifier.Verify(ctx, bearer)
+	}, cache)
+}
+
+func stringClaim(t jwt.Token, claim string) (string, bool) {
+	value, ok := t.Get(claim)
+	if !ok {
+		return "", false
+	}
+	s, ok := value.(string)
+	return s, ok && s != ""
+}
+
+// roles reads a claim that is a list of strings, or a single string
+func roles(t jwt.Token, claim string) []string {
+	value, ok := t.Get(claim)
+	if !ok {
+		return nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		return []string{v}
+	case []string:
+		return v
+	case []interface{}:
+		var out []string
+		for _, r := range v {
+			if s, ok := r.(string); ok {
+				out = append(out, s)
+			}
+		}
+		return out
+	}
+	return nil
+}
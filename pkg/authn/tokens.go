@@ -0,0 +1,72 @@
+package authn
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/shaj13/go-guardian/v2/auth"
+	"github.com/shaj13/go-guardian/v2/auth/strategies/token"
+	"github.com/shaj13/libcache"
+	"sigs.k8s.io/yaml"
+)
+
+// Token is an api token of a user. Only the sha256 of the token is kept, `echo -n $TOKEN | sha256sum`.
+type Token struct {
+	// Name tells the tokens of a user apart
+	Name   string   `json:"name"`
+	User   string   `json:"user"`
+	Roles  []string `json:"roles,omitempty"`
+	SHA256 string   `json:"sha256"`
+	// Expires is when the token stops being accepted, never if empty
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+// LoadTokens reads a yaml list of api tokens
+func LoadTokens(path string) ([]Token, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var tokens []Token
+	if err := yaml.UnmarshalStrict(data, &tokens); err != nil {
+		return nil, err
+	}
+	for _, t := range tokens {
+		if t.User == "" {
+			return nil, fmt.Errorf("%s: token %q has no user", path, t.Name)
+		}
+		if sum, err := hex.DecodeString(t.SHA256); err != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("%s: sha256 of token %q is not a hex encoded sha256", path, t.Name)
+		}
+	}
+	return tokens, nil
+}
+
+// NewTokenStrategy authenticates the api tokens passed as bearer tokens
+func NewTokenStrategy(tokens []Token, cache libcache.Cache) auth.Strategy {
+	return token.New(func(ctx context.Context, r *http.Request, bearer string) (auth.Info, time.Time, error) {
+		sum := sha256.Sum256([]byte(bearer))
+		for _, t := range tokens {
+			expected, _ := hex.DecodeString(t.SHA256)
+			if subtle.ConstantTimeCompare(sum[:], expected) != 1 {
+				continue
+			}
+			if t.Expires != nil && !time.Now().Before(*t.Expires) {
+				return nil, time.Time{}, fmt.Errorf("[ERROR] token %s of %s expired", t.Name, t.User)
+			}
+			expires := time.Now().Add(cacheTTL)
+			if t.Expires != nil && t.Expires.Before(expires) {
+				expires = *t.Expires
+			}
+			return newUser(t.User, t.User, t.Roles, AuthenticatorToken), expires, nil
+		}
+		return nil, time.Time{}, fmt.Errorf("[ERROR] Invalid token")
+	}, cache)
+}
@@ -0,0 +1,81 @@
+package authz
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/shaj13/go-guardian/v2/auth"
+)
+
+// Event is a line of the audit log
+type Event struct {
+	Time          time.Time `json:"time"`
+	Decision      string    `json:"decision"`
+	User          string    `json:"user"`
+	Authenticator string    `json:"authenticator,omitempty"`
+	Roles         []string  `json:"roles"`
+	Resource      string    `json:"resource"`
+	Verb          string    `json:"verb"`
+	// Request is the route or the rpc, e.g. "DELETE /api/v1/admin/kubernetes/clusters/default/c1"
+	Request string `json:"request"`
+}
+
+// DecisionDeny is the decision of the denied requests
+const DecisionDeny = "deny"
+
+// AuditLog writes an Event per line as json
+type AuditLog struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewAuditLog returns an AuditLog writing to w
+func NewAuditLog(w io.Writer) *AuditLog {
+	return &AuditLog{w: w}
+}
+
+// OpenAuditLog appends to the file at path, or writes to stdout if path is empty
+func OpenAuditLog(path string) (*AuditLog, error) {
+	if path == "" {
+		return NewAuditLog(os.Stdout), nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuditLog(f), nil
+}
+
+// Deny records a denied request
+func (l *AuditLog) Deny(info auth.Info, roles []string, resource, verb, request string) {
+	if l == nil {
+		return
+	}
+
+	l.Record(Event{
+		Time:          time.Now().UTC(),
+		Decision:      DecisionDeny,
+		User:          info.GetUserName(),
+		Authenticator: authn.Authenticator(info),
+		Roles:         roles,
+		Resource:      resource,
+		Verb:          verb,
+		Request:       request,
+	})
+}
+
+// Record writes an event, the errors of the writer are dropped
+func (l *AuditLog) Record(event Event) {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	_, _ = l.w.Write(append(line, '\n'))
+}
@@ -0,0 +1,220 @@
+// Package authz decides what the authenticated users of the api-server and the capi-api may do. The roles of a user
+// come from its groups (the roles of authn) and from the bindings of the Policy, a role allows verbs on resources.
+package authz
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	"github.com/shaj13/go-guardian/v2/auth"
+	"sigs.k8s.io/yaml"
+)
+
+// The roles of the default policy
+const (
+	// RolePlatformAdmin manages the clusters and the databases of every tenant
+	RolePlatformAdmin = "platform-admin"
+	// RoleTenantAdmin manages the databases of its own tenant
+	RoleTenantAdmin = "tenant-admin"
+	// RoleTenantViewer reads the databases of its own tenant
+	RoleTenantViewer = "tenant-viewer"
+)
+
+// The resources the routes and the rpcs act on
+const (
+	// ResourceAdminClusters are the kubernetes clusters of every namespace
+	ResourceAdminClusters = "admin/clusters"
+	// ResourceAdminDatabases are the databases of every namespace
+	ResourceAdminDatabases = "admin/databases"
+	// ResourceDatabases are the databases of the tenant namespace of the user
+	ResourceDatabases = "databases"
+	// ResourceKubeconfigs are the kubeconfigs issued to the user
+	ResourceKubeconfigs = "kubeconfigs"
+	// ResourceClouds are the supported clouds, regions and instance types
+	ResourceClouds = "clouds"
+	// ResourceAdminOperations are the operations of every namespace
+	ResourceAdminOperations = "admin/operations"
+	// ResourceOperations are the operations of the tenant namespace of the user
+	ResourceOperations = "operations"
+)
+
+// The verbs of the rules
+const (
+	VerbGet    = "get"
+	VerbList   = "list"
+	VerbWatch  = "watch"
+	VerbCreate = "create"
+	VerbUpdate = "update"
+	VerbDelete = "delete"
+)
+
+// Any matches every resource or verb in a Rule
+const Any = "*"
+
+// ErrForbidden is returned when no role of a user allows the verb on the resource
+var ErrForbidden = errors.New("forbidden")
+
+// Rule allows the verbs on the resources
+type Rule struct {
+	Resources []string `json:"resources"`
+	Verbs     []string `json:"verbs"`
+}
+
+// Policy maps the users to roles and the roles to rules
+type Policy struct {
+	// Roles are the rules of every role
+	Roles map[string][]Rule `json:"roles"`
+	// Groups maps the groups of the users, e.g. a roles claim of a token, to roles. The groups that are roles of
+	// the policy are roles without a binding.
+	Groups map[string][]string `json:"groups,omitempty"`
+	// Users maps user names to roles
+	Users map[string][]string `json:"users,omitempty"`
+	// DefaultRoles are the roles of every authenticated user
+	DefaultRoles []string `json:"defaultRoles,omitempty"`
+}
+
+// DefaultPolicy is the policy without a policy file, every user manages the databases of its tenant
+func DefaultPolicy() Policy {
+	return Policy{
+		Roles: map[string][]Rule{
+			RolePlatformAdmin: {
+				{Resources: []string{Any}, Verbs: []string{Any}},
+			},
+			RoleTenantAdmin: {
+				{Resources: []string{ResourceDatabases, ResourceKubeconfigs}, Verbs: []string{Any}},
+				{Resources: []string{ResourceClouds, ResourceOperations}, Verbs: []string{VerbGet, VerbList}},
+			},
+			RoleTenantViewer: {
+				{Resources: []string{ResourceDatabases, ResourceClouds, ResourceOperations}, Verbs: []string{VerbGet, VerbList, VerbWatch}},
+			},
+		},
+		DefaultRoles: []string{RoleTenantAdmin},
+	}
+}
+
+// LoadPolicy reads a yaml policy
+func LoadPolicy(path string) (Policy, error) {
+	policy := Policy{}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return policy, err
+	}
+	if err := yaml.UnmarshalStrict(data, &policy); err != nil {
+		return policy, err
+	}
+	return policy, policy.Validate()
+}
+
+// Validate checks that the bindings refer to roles of the policy
+func (p Policy) Validate() error {
+	check := func(from string, roles []string) error {
+		for _, role := range roles {
+			if _, ok := p.Roles[role]; !ok {
+				return fmt.Errorf("%s is bound to unknown role %q", from, role)
+			}
+		}
+		return nil
+	}
+
+	for group, roles := range p.Groups {
+		if err := check("group "+group, roles); err != nil {
+			return err
+		}
+	}
+	for user, roles := range p.Users {
+		if err := check("user "+user, roles); err != nil {
+			return err
+		}
+	}
+	return check("defaultRoles", p.DefaultRoles)
+}
+
+// Authorizer enforces a policy and records the denials in an audit log
+type Authorizer struct {
+	Policy Policy
+	// Audit records the denials, they are not recorded if nil
+	Audit *AuditLog
+}
+
+// NewAuthorizer returns an Authorizer of a policy
+func NewAuthorizer(policy Policy, audit *AuditLog) *Authorizer {
+	return &Authorizer{Policy: policy, Audit: audit}
+}
+
+// Load returns the Authorizer of the policy file, or of the DefaultPolicy if path is empty. The denials are appended to
+// the auditLog file, see OpenAuditLog.
+func Load(path, auditLog string) (*Authorizer, error) {
+	policy := DefaultPolicy()
+	if path != "" {
+		var err error
+		if policy, err = LoadPolicy(path); err != nil {
+			return nil, err
+		}
+	}
+
+	audit, err := OpenAuditLog(auditLog)
+	if err != nil {
+		return nil, err
+	}
+	return NewAuthorizer(policy, audit), nil
+}
+
+// Roles returns the roles of a user
+func (a *Authorizer) Roles(info auth.Info) []string {
+	set := map[string]bool{}
+	for _, role := range a.Policy.DefaultRoles {
+		set[role] = true
+	}
+	for _, role := range a.Policy.Users[info.GetUserName()] {
+		set[role] = true
+	}
+	for _, group := range info.GetGroups() {
+		if _, ok := a.Policy.Roles[group]; ok {
+			set[group] = true
+		}
+		for _, role := range a.Policy.Groups[group] {
+			set[role] = true
+		}
+	}
+
+	roles := make([]string, 0, len(set))
+	for role := range set {
+		roles = append(roles, role)
+	}
+	sort.Strings(roles)
+	return roles
+}
+
+// Allowed tells if a role of a user allows the verb on the resource
+func (a *Authorizer) Allowed(info auth.Info, resource, verb string) bool {
+	for _, role := range a.Roles(info) {
+		for _, rule := range a.Policy.Roles[role] {
+			if matches(rule.Resources, resource) && matches(rule.Verbs, verb) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Authorize returns ErrForbidden and records a denial when the user may not act on the resource, the request names
+// the route or the rpc in the audit log
+func (a *Authorizer) Authorize(info auth.Info, resource, verb, request string) error {
+	if a.Allowed(info, resource, verb) {
+		return nil
+	}
+
+	a.Audit.Deny(info, a.Roles(info), resource, verb, request)
+	return fmt.Errorf("%w: %s may not %s %s", ErrForbidden, info.GetUserName(), verb, resource)
+}
+
+func matches(values []string, value string) bool {
+	for _, v := range values {
+		if v == Any || v == value {
+			return true
+		}
+	}
+	return false
+}
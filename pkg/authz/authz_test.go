@@ -0,0 +1,100 @@
+package authz
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/aerospike/aerostation/pkg/authn"
+	. "github.com/onsi/gomega"
+	"github.com/shaj13/go-guardian/v2/auth"
+)
+
+func user(name string, groups ...string) auth.Info {
+	extensions := auth.Extensions{}
+	extensions.Set(authn.AuthenticatorExtension, authn.AuthenticatorOIDC)
+	return auth.NewDefaultUser(name, name, groups, extensions)
+}
+
+func TestDefaultPolicy(t *testing.T) {
+	g := NewWithT(t)
+	a := NewAuthorizer(DefaultPolicy(), nil)
+
+	admin := user("root", RolePlatformAdmin)
+	g.Expect(a.Allowed(admin, ResourceAdminClusters, VerbDelete)).To(BeTrue())
+	g.Expect(a.Allowed(admin, ResourceDatabases, VerbCreate)).To(BeTrue())
+
+	// every user manages the databases of its tenant
+	dev := user("dev1")
+	g.Expect(a.Roles(dev)).To(ConsistOf(RoleTenantAdmin))
+	g.Expect(a.Allowed(dev, ResourceDatabases, VerbCreate)).To(BeTrue())
+	g.Expect(a.Allowed(dev, ResourceKubeconfigs, VerbCreate)).To(BeTrue())
+	g.Expect(a.Allowed(dev, ResourceClouds, VerbList)).To(BeTrue())
+	g.Expect(a.Allowed(dev, ResourceAdminClusters, VerbCreate)).To(BeFalse())
+	g.Expect(a.Allowed(dev, ResourceAdminDatabases, VerbList)).To(BeFalse())
+
+	viewer := NewAuthorizer(Policy{Roles: DefaultPolicy().Roles}, nil)
+	g.Expect(viewer.Allowed(user("ro", RoleTenantViewer), ResourceDatabases, VerbList)).To(BeTrue())
+	g.Expect(viewer.Allowed(user("ro", RoleTenantViewer), ResourceDatabases, VerbDelete)).To(BeFalse())
+	g.Expect(viewer.Allowed(user("nobody"), ResourceDatabases, VerbList)).To(BeFalse())
+}
+
+func TestLoadPolicy(t *testing.T) {
+	g := NewWithT(t)
+
+	path := filepath.Join(t.TempDir(), "policy.yaml")
+	g.Expect(ioutil.WriteFile(path, []byte(`
+roles:
+  platform-admin:
+    - resources: ["*"]
+      verbs: ["*"]
+  tenant-viewer:
+    - resources: [databases]
+      verbs: [get, list]
+groups:
+  ops: [platform-admin]
+users:
+  aerospike: [platform-admin]
+defaultRoles: [tenant-viewer]
+`), 0600)).To(Succeed())
+
+	policy, err := LoadPolicy(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	a := NewAuthorizer(policy, nil)
+
+	g.Expect(a.Roles(user("aerospike"))).To(ConsistOf(RolePlatformAdmin, RoleTenantViewer))
+	g.Expect(a.Roles(user("dev1", "ops"))).To(ConsistOf(RolePlatformAdmin, RoleTenantViewer))
+	g.Expect(a.Roles(user("dev2", "unknown"))).To(ConsistOf(RoleTenantViewer))
+	g.Expect(a.Allowed(user("dev2"), ResourceDatabases, VerbCreate)).To(BeFalse())
+
+	g.Expect(ioutil.WriteFile(path, []byte("roles: {}\nusers:\n  aerospike: [root]\n"), 0600)).To(Succeed())
+	_, err = LoadPolicy(path)
+	g.Expect(err).To(MatchError(ContainSubstring(`unknown role "root"`)))
+}
+
+func TestAuthorizeAudit(t *testing.T) {
+	g := NewWithT(t)
+
+	var out bytes.Buffer
+	a := NewAuthorizer(DefaultPolicy(), NewAuditLog(&out))
+
+	g.Expect(a.Authorize(user("dev1"), ResourceDatabases, VerbDelete, "DELETE /api/v1/aerospike/clusters/db1")).To(Succeed())
+	g.Expect(out.Len()).To(BeZero())
+
+	err := a.Authorize(user("dev1"), ResourceAdminClusters, VerbDelete, "DELETE /api/v1/admin/kubernetes/clusters/default/c1")
+	g.Expect(errors.Is(err, ErrForbidden)).To(BeTrue())
+
+	event := Event{}
+	g.Expect(json.Unmarshal(out.Bytes(), &event)).To(Succeed())
+	g.Expect(event.Decision).To(Equal(DecisionDeny))
+	g.Expect(event.User).To(Equal("dev1"))
+	g.Expect(event.Authenticator).To(Equal(authn.AuthenticatorOIDC))
+	g.Expect(event.Roles).To(ConsistOf(RoleTenantAdmin))
+	g.Expect(event.Resource).To(Equal(ResourceAdminClusters))
+	g.Expect(event.Verb).To(Equal(VerbDelete))
+	g.Expect(event.Request).To(Equal("DELETE /api/v1/admin/kubernetes/clusters/default/c1"))
+	g.Expect(event.Time.IsZero()).To(BeFalse())
+}
@@ -0,0 +1,290 @@
+// Package bundle holds the manifests of the add-ons installed on the workload clusters, embedded in the binary with a
+// version and a checksum. ConfigMaps on the management cluster override a version or add one, with the manifest
+// inline or in an OCI artifact.
+package bundle
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+	"sort"
+	"sync"
+	"text/template"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// The add-ons of the bundle
+const (
+	AddonCalico      = "calico"
+	AddonCertManager = "cert-manager"
+	AddonAKO         = "ako"
+	// AddonFeatures is the aerospike-secret with the feature key of the databases
+	AddonFeatures = "features"
+	// AddonRESTClient is a template of the rest client of a database, see RESTClient
+	AddonRESTClient = "rest-client"
+)
+
+// SourceEmbedded is the source of the manifests embedded in the binary
+const SourceEmbedded = "embedded"
+
+var (
+	// ErrUnknownAddon is returned for an add-on that is not in the bundle
+	ErrUnknownAddon = errors.New("unknown add-on")
+
+	// ErrUnknownVersion is returned for a version of an add-on that is neither embedded nor overridden
+	ErrUnknownVersion = errors.New("unknown add-on version")
+
+	// ErrChecksum is returned when a manifest does not match its sha256
+	ErrChecksum = errors.New("add-on checksum mismatch")
+)
+
+//go:embed addons
+var addons embed.FS
+
+// Manifest is a version of an add-on
+type Manifest struct {
+	Addon   string
+	Version string
+	// SHA256 of Data, hex encoded
+	SHA256 string
+	// Source is SourceEmbedded, configmap:<namespace>/<name> or oci:<reference>
+	Source string
+	// Template tells if Data is a text/template, see Render
+	Template bool
+	Data     []byte
+}
+
+// Render returns the manifest, executed with data if it is a template
+func (m *Manifest) Render(data interface{}) ([]byte, error) {
+	if !m.Template {
+		return m.Data, nil
+	}
+
+	t, err := template.New(m.Addon).Option("missingkey=error").Parse(string(m.Data))
+	if err != nil {
+		return nil, fmt.Errorf("parse %s %s: %w", m.Addon, m.Version, err)
+	}
+	var b bytes.Buffer
+	if err := t.Execute(&b, data); err != nil {
+		return nil, fmt.Errorf("render %s %s: %w", m.Addon, m.Version, err)
+	}
+	return b.Bytes(), nil
+}
+
+// RESTClient is the data of the rest-client template
+type RESTClient struct {
+	// Namespace of the database
+	Namespace string
+	// Hostname of the aerospike service
+	Hostname string
+}
+
+// index is addons/index.yaml
+type index struct {
+	Addons []struct {
+		Name     string `json:"name"`
+		Default  string `json:"default"`
+		Template bool   `json:"template,omitempty"`
+		// DependsOn are the add-ons installed before this one
+		DependsOn []string `json:"dependsOn,omitempty"`
+		Versions  []struct {
+			Version string `json:"version"`
+			SHA256  string `json:"sha256"`
+		} `json:"versions"`
+	} `json:"addons"`
+}
+
+type addon struct {
+	name           string
+	defaultVersion string
+	template       bool
+	dependsOn      []string
+	versions       map[string]*Manifest
+}
+
+var (
+	loadOnce sync.Once
+	embedded map[string]*addon
+	loadErr  error
+)
+
+// load reads the index and checks the embedded manifests against their checksums, once
+func load() (map[string]*addon, error) {
+	loadOnce.Do(func() {
+		embedded, loadErr = readIndex()
+	})
+	return embedded, loadErr
+}
+
+func readIndex() (map[string]*addon, error) {
+	data, err := addons.ReadFile("addons/index.yaml")
+	if err != nil {
+		return nil, err
+	}
+	idx := index{}
+	if err := yaml.UnmarshalStrict(data, &idx); err != nil {
+		return nil, fmt.Errorf("addons/index.yaml: %w", err)
+	}
+
+	result := map[string]*addon{}
+	for _, a := range idx.Addons {
+		entry := &addon{name: a.Name, defaultVersion: a.Default, template: a.Template, dependsOn: a.DependsOn,
+			versions: map[string]*Manifest{}}
+		for _, v := range a.Versions {
+			data, err := addons.ReadFile(path.Join("addons", a.Name, v.Version+".yaml"))
+			if err != nil {
+				return nil, err
+			}
+			if err := verify(data, v.SHA256); err != nil {
+				return nil, fmt.Errorf("%s %s: %w", a.Name, v.Version, err)
+			}
+			entry.versions[v.Version] = &Manifest{
+				Addon:    a.Name,
+				Version:  v.Version,
+				SHA256:   v.SHA256,
+				Source:   SourceEmbedded,
+				Template: a.Template,
+				Data:     data,
+			}
+		}
+		if _, ok := entry.versions[a.Default]; !ok {
+			return nil, fmt.Errorf("%s: default %s: %w", a.Name, a.Default, ErrUnknownVersion)
+		}
+		result[a.Name] = entry
+	}
+	return result, nil
+}
+
+func verify(data []byte, sum string) error {
+	actual := checksum(data)
+	if actual != sum {
+		return fmt.Errorf("%w: sha256 is %s, expected %s", ErrChecksum, actual, sum)
+	}
+	return nil
+}
+
+func checksum(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Bundle resolves the manifests of the add-ons. The ConfigMaps labeled with AddonLabel in Namespace override the
+// embedded manifests, a nil Bundle or one without a Reader has the embedded manifests only.
+type Bundle struct {
+	// Reader of the management cluster, an uncached reader keeps the ConfigMaps out of the cache of the manager
+	Reader    client.Reader
+	Namespace string
+	// HTTPClient pulls the OCI artifacts, http.DefaultClient if nil
+	HTTPClient *http.Client
+
+	mu sync.Mutex
+	// pulled are the OCI artifacts by reference and digest
+	pulled map[string][]byte
+}
+
+func New(reader client.Reader, namespace string) *Bundle {
+	return &Bundle{Reader: reader, Namespace: namespace}
+}
+
+// Get returns a version of an add-on, the default version if empty. An override of the version takes precedence over
+// the embedded manifest.
+func (b *Bundle) Get(ctx context.Context, name, version string) (*Manifest, error) {
+	embedded, err := load()
+	if err != nil {
+		return nil, err
+	}
+	a, ok := embedded[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownAddon, name)
+	}
+
+	overrides, err := b.overrides(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	if version == "" {
+		version = a.defaultVersion
+		for _, o := range overrides {
+			if o.isDefault {
+				version = o.version
+			}
+		}
+	}
+
+	for _, o := range overrides {
+		if o.version == version {
+			return b.resolve(ctx, a, o)
+		}
+	}
+	if m, ok := a.versions[version]; ok {
+		return m, nil
+	}
+	return nil, fmt.Errorf("%w: %s %q", ErrUnknownVersion, name, version)
+}
+
+// Versions returns the versions of an add-on, embedded and overridden, sorted
+func (b *Bundle) Versions(ctx context.Context, name string) ([]string, error) {
+	embedded, err := load()
+	if err != nil {
+		return nil, err
+	}
+	a, ok := embedded[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownAddon, name)
+	}
+	overrides, err := b.overrides(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[string]bool{}
+	var versions []string
+	for version := range a.versions {
+		seen[version] = true
+		versions = append(versions, version)
+	}
+	for _, o := range overrides {
+		if !seen[o.version] {
+			seen[o.version] = true
+			versions = append(versions, o.version)
+		}
+	}
+	sort.Strings(versions)
+	return versions, nil
+}
+
+// DependsOn returns the add-ons an add-on depends on, they are installed and upgraded before it
+func DependsOn(name string) ([]string, error) {
+	embedded, err := load()
+	if err != nil {
+		return nil, err
+	}
+	a, ok := embedded[name]
+	if !ok {
+		return nil, fmt.Errorf("%w %q", ErrUnknownAddon, name)
+	}
+	return append([]string(nil), a.dependsOn...), nil
+}
+
+// Addons returns the names of the add-ons, sorted
+func Addons() ([]string, error) {
+	embedded, err := load()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	for name := range embedded {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
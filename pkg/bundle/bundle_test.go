@@ -0,0 +1,239 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestEmbedded(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	names, err := Addons()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names).To(Equal([]string{AddonAKO, AddonCalico, AddonCertManager, AddonFeatures, AddonRESTClient}))
+
+	// a nil bundle has the embedded manifests, checked against the index
+	var b *Bundle
+	for _, name := range names {
+		m, err := b.Get(ctx, name, "")
+		g.Expect(err).NotTo(HaveOccurred(), name)
+		g.Expect(m.Source).To(Equal(SourceEmbedded))
+		g.Expect(m.SHA256).To(Equal(checksum(m.Data)))
+	}
+
+	ako, err := b.Get(ctx, AddonAKO, "2.0.0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(ako.Data)).To(ContainSubstring("aerospike-kubernetes-operator:2.0.0"))
+	rendered, err := ako.Render(nil)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rendered).To(Equal(ako.Data))
+
+	restClient, err := b.Get(ctx, AddonRESTClient, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	rendered, err = restClient.Render(RESTClient{Namespace: "aerospike-db", Hostname: "orders"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(rendered)).To(ContainSubstring("namespace: aerospike-db"))
+	g.Expect(string(rendered)).To(ContainSubstring("value: orders"))
+	_, err = restClient.Render(struct{}{})
+	g.Expect(err).To(HaveOccurred())
+
+	dependsOn, err := DependsOn(AddonAKO)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(dependsOn).To(Equal([]string{AddonCertManager}))
+
+	_, err = b.Get(ctx, "istio", "")
+	g.Expect(errors.Is(err, ErrUnknownAddon)).To(BeTrue())
+	_, err = b.Get(ctx, AddonAKO, "1.0.0")
+	g.Expect(errors.Is(err, ErrUnknownVersion)).To(BeTrue())
+}
+
+func configMap(name string, data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "aerostation-system", Labels: map[string]string{AddonLabel: AddonAKO}},
+		Data:       data,
+	}
+}
+
+func newBundle(t *testing.T, objects ...client.Object) *Bundle {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	return New(fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(), "aerostation-system")
+}
+
+func TestOverrides(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	ako210 := "kind: Namespace\n"
+	b := newBundle(t,
+		configMap("ako-2.1.0", map[string]string{KeyVersion: "2.1.0", KeyManifest: ako210, KeySHA256: checksum([]byte(ako210)), KeyDefault: "true"}),
+		configMap("ako-2.0.0", map[string]string{KeyVersion: "2.0.0", KeyManifest: "kind: ConfigMap\n"}),
+	)
+
+	m, err := b.Get(ctx, AddonAKO, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(m.Version).To(Equal("2.1.0"))
+	g.Expect(m.Source).To(Equal("configmap:aerostation-system/ako-2.1.0"))
+	g.Expect(string(m.Data)).To(Equal(ako210))
+
+	// the override of an embedded version takes precedence
+	m, err = b.Get(ctx, AddonAKO, "2.0.0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(m.Data)).To(Equal("kind: ConfigMap\n"))
+
+	versions, err := b.Versions(ctx, AddonAKO)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(versions).To(Equal([]string{"2.0.0", "2.1.0"}))
+
+	// the other add-ons are not overridden
+	m, err = b.Get(ctx, AddonCalico, "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(m.Source).To(Equal(SourceEmbedded))
+
+	b = newBundle(t, configMap("ako", map[string]string{KeyVersion: "2.1.0", KeyManifest: ako210, KeySHA256: checksum([]byte("other"))}))
+	_, err = b.Get(ctx, AddonAKO, "2.1.0")
+	g.Expect(errors.Is(err, ErrChecksum)).To(BeTrue())
+
+	for _, invalid := range []map[string]string{
+		{KeyManifest: ako210},
+		{KeyVersion: "2.1.0"},
+		{KeyVersion: "2.1.0", KeyManifest: ako210, KeyOCI: "ghcr.io/org/ako:2.1.0"},
+		{KeyVersion: "2.1.0", KeyManifest: ako210, KeyDefault: "yes please"},
+	} {
+		b = newBundle(t, configMap("ako", invalid))
+		_, err = b.Get(ctx, AddonAKO, "")
+		g.Expect(errors.Is(err, ErrInvalidOverride)).To(BeTrue(), "%v", invalid)
+	}
+
+	b = newBundle(t,
+		configMap("a", map[string]string{KeyVersion: "2.1.0", KeyManifest: ako210}),
+		configMap("b", map[string]string{KeyVersion: "2.1.0", KeyManifest: ako210}))
+	_, err = b.Get(ctx, AddonAKO, "")
+	g.Expect(errors.Is(err, ErrInvalidOverride)).To(BeTrue())
+}
+
+// artifact is the image manifest of an artifact with a layer
+func artifact(digest string) []byte {
+	data, _ := json.Marshal(imageManifest{Layers: []descriptor{
+		{MediaType: "application/vnd.oci.image.config.v1+json", Digest: "sha256:0"},
+		{MediaType: "application/yaml", Digest: digest, Annotations: map[string]string{"org.opencontainers.image.title": "ako.yaml"}},
+	}})
+	return data
+}
+
+// registry serves an artifact with a layer behind an anonymous bearer token, by tag and by the digest of its image
+// manifest
+func registry(t *testing.T, layer []byte, digest string) (*httptest.Server, *int) {
+	pulls := 0
+	manifest := artifact(digest)
+	var server *httptest.Server
+	server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/token":
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": "anonymous-" + r.URL.Query().Get("scope")})
+		case r.Header.Get("Authorization") != "Bearer anonymous-repository:org/ako:pull":
+			w.Header().Set("WWW-Authenticate", `Bearer realm="`+server.URL+`/token",service="test",scope="repository:org/ako:pull"`)
+			w.WriteHeader(http.StatusUnauthorized)
+		case r.URL.Path == "/v2/org/ako/manifests/2.1.0", r.URL.Path == "/v2/org/ako/manifests/sha256:"+checksum(manifest):
+			_, _ = w.Write(manifest)
+		case r.URL.Path == "/v2/org/ako/blobs/"+digest:
+			pulls++
+			_, _ = w.Write(layer)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &pulls
+}
+
+func TestOCI(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	layer := []byte("kind: Namespace\n")
+	server, pulls := registry(t, layer, "sha256:"+checksum(layer))
+	ref := strings.TrimPrefix(server.URL, "https://") + "/org/ako:2.1.0"
+
+	b := newBundle(t, configMap("ako-2.1.0", map[string]string{KeyVersion: "2.1.0", KeyOCI: ref}))
+	b.HTTPClient = server.Client()
+
+	for i := 0; i < 2; i++ {
+		m, err := b.Get(ctx, AddonAKO, "2.1.0")
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(m.Source).To(Equal("oci:" + ref))
+		g.Expect(m.Data).To(Equal(layer))
+		g.Expect(m.SHA256).To(Equal(checksum(layer)))
+	}
+	// the layer is pulled once
+	g.Expect(*pulls).To(Equal(1))
+
+	// the layer does not match its digest
+	server, _ = registry(t, []byte("kind: Secret\n"), "sha256:"+checksum(layer))
+	b = newBundle(t, configMap("ako-2.1.0", map[string]string{
+		KeyVersion: "2.1.0", KeyOCI: strings.TrimPrefix(server.URL, "https://") + "/org/ako:2.1.0"}))
+	b.HTTPClient = server.Client()
+	_, err := b.Get(ctx, AddonAKO, "2.1.0")
+	g.Expect(errors.Is(err, ErrChecksum)).To(BeTrue())
+}
+
+func TestOCIPinned(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	layer := []byte("kind: Namespace\n")
+	server, _ := registry(t, layer, "sha256:"+checksum(layer))
+	pinned := func(digest string) *Bundle {
+		b := newBundle(t, configMap("ako-2.1.0", map[string]string{
+			KeyVersion: "2.1.0", KeyOCI: strings.TrimPrefix(server.URL, "https://") + "/org/ako@sha256:" + digest}))
+		b.HTTPClient = server.Client()
+		return b
+	}
+
+	digest := checksum(artifact("sha256:" + checksum(layer)))
+	m, err := pinned(digest).Get(ctx, AddonAKO, "2.1.0")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(m.Data).To(Equal(layer))
+
+	// the registry serves another image manifest under the digest
+	other := artifact("sha256:" + checksum([]byte("kind: Secret\n")))
+	server.Config.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write(other)
+	})
+	_, err = pinned(digest).Get(ctx, AddonAKO, "2.1.0")
+	g.Expect(errors.Is(err, ErrChecksum)).To(BeTrue())
+
+	_, err = parseReference("ghcr.io/org/ako@sha512:abc")
+	g.Expect(errors.Is(err, ErrInvalidOverride)).To(BeTrue())
+}
+
+func TestParseReference(t *testing.T) {
+	g := NewWithT(t)
+
+	for ref, expected := range map[string]reference{
+		"ghcr.io/org/ako:2.1.0":          {registry: "ghcr.io", repository: "org/ako", version: "2.1.0"},
+		"localhost:5000/ako":             {registry: "localhost:5000", repository: "ako", version: "latest"},
+		"ghcr.io/org/ako@sha256:abc":     {registry: "ghcr.io", repository: "org/ako", version: "sha256:abc"},
+		"org/ako:2.1.0":                  {registry: "registry-1.docker.io", repository: "org/ako", version: "2.1.0"},
+		"ako":                            {registry: "registry-1.docker.io", repository: "library/ako", version: "latest"},
+		"registry.example.com:443/a/b/c": {registry: "registry.example.com:443", repository: "a/b/c", version: "latest"},
+	} {
+		r, err := parseReference(ref)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(r).To(Equal(expected), ref)
+	}
+}
@@ -0,0 +1,245 @@
+package bundle
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// maxArtifactSize is the largest manifest pulled from an OCI artifact
+const maxArtifactSize = 16 << 20
+
+// The media types of the image manifests the artifacts are pulled with
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// reference is a parsed OCI reference, registry/repository:tag or registry/repository@digest
+type reference struct {
+	registry   string
+	repository string
+	// tag or digest
+	version string
+}
+
+// digest returns the sha256 of the image manifest of a reference pinned by digest
+func (r reference) digest() (string, bool) {
+	if !strings.HasPrefix(r.version, "sha256:") {
+		return "", false
+	}
+	return strings.TrimPrefix(r.version, "sha256:"), true
+}
+
+func parseReference(ref string) (reference, error) {
+	r := reference{}
+	name := ref
+	if i := strings.Index(name, "@"); i >= 0 {
+		name, r.version = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, r.version = name[:i], name[i+1:]
+	}
+	if r.version == "" {
+		r.version = "latest"
+	}
+	if strings.Contains(ref, "@") && !strings.HasPrefix(r.version, "sha256:") {
+		return r, fmt.Errorf("%w: unsupported digest in OCI reference %q, only sha256 is", ErrInvalidOverride, ref)
+	}
+
+	parts := strings.SplitN(name, "/", 2)
+	if len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		r.registry, r.repository = parts[0], parts[1]
+	} else {
+		r.registry, r.repository = "registry-1.docker.io", name
+		if !strings.Contains(name, "/") {
+			r.repository = "library/" + name
+		}
+	}
+	if r.repository == "" {
+		return r, fmt.Errorf("%w: invalid OCI reference %q", ErrInvalidOverride, ref)
+	}
+	return r, nil
+}
+
+type descriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int64             `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+type imageManifest struct {
+	Layers []descriptor `json:"layers"`
+}
+
+// layer returns the layer with the manifest, the one titled *.yaml or else the first one
+func (m imageManifest) layer() (descriptor, bool) {
+	for _, l := range m.Layers {
+		title := l.Annotations["org.opencontainers.image.title"]
+		if strings.HasSuffix(title, ".yaml") || strings.HasSuffix(title, ".yml") {
+			return l, true
+		}
+	}
+	if len(m.Layers) == 0 {
+		return descriptor{}, false
+	}
+	return m.Layers[0], true
+}
+
+// pull returns the manifest of an OCI artifact, checked against the digest of its layer. The image manifest of a
+// reference pinned by digest is checked against it as well, the registry could point it at another layer otherwise. The
+// layers are kept by digest, a tag is resolved again at every pull.
+func (b *Bundle) pull(ctx context.Context, ref string) ([]byte, error) {
+	r, err := parseReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	base := "https://" + r.registry + "/v2/" + r.repository
+	body, token, err := b.fetch(ctx, base+"/manifests/"+r.version, strings.Join(manifestMediaTypes, ", "), "")
+	if err != nil {
+		return nil, err
+	}
+	if digest, ok := r.digest(); ok {
+		if err := verify(body, digest); err != nil {
+			return nil, fmt.Errorf("image manifest of %s: %w", ref, err)
+		}
+	}
+	m := imageManifest{}
+	if err := json.Unmarshal(body, &m); err != nil {
+		return nil, fmt.Errorf("decode the image manifest: %w", err)
+	}
+	layer, ok := m.layer()
+	if !ok {
+		return nil, fmt.Errorf("the artifact has no layer")
+	}
+	if !strings.HasPrefix(layer.Digest, "sha256:") {
+		return nil, fmt.Errorf("unsupported digest %q", layer.Digest)
+	}
+
+	b.mu.Lock()
+	data, ok := b.pulled[layer.Digest]
+	b.mu.Unlock()
+	if ok {
+		return data, nil
+	}
+
+	data, _, err = b.fetch(ctx, base+"/blobs/"+layer.Digest, "", token)
+	if err != nil {
+		return nil, err
+	}
+	if err := verify(data, strings.TrimPrefix(layer.Digest, "sha256:")); err != nil {
+		return nil, err
+	}
+
+	b.mu.Lock()
+	if b.pulled == nil {
+		b.pulled = map[string][]byte{}
+	}
+	b.pulled[layer.Digest] = data
+	b.mu.Unlock()
+	return data, nil
+}
+
+// fetch gets a resource of a registry, with an anonymous bearer token if the registry asks for one. The token is
+// returned for the next requests.
+func (b *Bundle) fetch(ctx context.Context, resource, accept, token string) ([]byte, string, error) {
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, resource, nil)
+		if err != nil {
+			return nil, token, err
+		}
+		if accept != "" {
+			req.Header.Set("Accept", accept)
+		}
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		res, err := b.httpClient().Do(req)
+		if err != nil {
+			return nil, token, err
+		}
+		data, err := ioutil.ReadAll(io.LimitReader(res.Body, maxArtifactSize+1))
+		_ = res.Body.Close()
+		if err != nil {
+			return nil, token, err
+		}
+
+		switch {
+		case res.StatusCode == http.StatusUnauthorized && attempt == 0:
+			if token, err = b.token(ctx, res.Header.Get("WWW-Authenticate")); err != nil {
+				return nil, token, err
+			}
+			continue
+		case res.StatusCode != http.StatusOK:
+			return nil, token, fmt.Errorf("GET %s: %s", resource, res.Status)
+		case len(data) > maxArtifactSize:
+			return nil, token, fmt.Errorf("GET %s: larger than %d bytes", resource, maxArtifactSize)
+		}
+		return data, token, nil
+	}
+}
+
+// token gets an anonymous token of the realm of a Bearer challenge
+func (b *Bundle) token(ctx context.Context, challenge string) (string, error) {
+	if !strings.HasPrefix(challenge, "Bearer ") {
+		return "", fmt.Errorf("the registry asks for credentials: %q", challenge)
+	}
+
+	params := map[string]string{}
+	for _, param := range strings.Split(strings.TrimPrefix(challenge, "Bearer "), ",") {
+		kv := strings.SplitN(strings.TrimSpace(param), "=", 2)
+		if len(kv) == 2 {
+			params[kv[0]] = strings.Trim(kv[1], `"`)
+		}
+	}
+	realm, err := url.Parse(params["realm"])
+	if err != nil || realm.Host == "" {
+		return "", fmt.Errorf("invalid realm in %q", challenge)
+	}
+	query := realm.Query()
+	for _, key := range []string{"service", "scope"} {
+		if params[key] != "" {
+			query.Set(key, params[key])
+		}
+	}
+	realm.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, realm.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	res, err := b.httpClient().Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("GET %s: %s", realm.Host, res.Status)
+	}
+
+	tokens := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(io.LimitReader(res.Body, 1<<20)).Decode(&tokens); err != nil {
+		return "", fmt.Errorf("decode the token: %w", err)
+	}
+	if tokens.Token != "" {
+		return tokens.Token, nil
+	}
+	return tokens.AccessToken, nil
+}
+
+func (b *Bundle) httpClient() *http.Client {
+	if b.HTTPClient != nil {
+		return b.HTTPClient
+	}
+	return http.DefaultClient
+}
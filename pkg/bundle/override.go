@@ -0,0 +1,120 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// AddonLabel marks the ConfigMaps overriding a version of an add-on, its value is the name of the add-on
+const AddonLabel = "aerostation.io/addon"
+
+// The keys of the data of an override
+const (
+	// KeyVersion is the version the ConfigMap overrides or adds, required
+	KeyVersion = "version"
+	// KeyManifest is the manifest inline, ConfigMaps are limited to 1MiB
+	KeyManifest = "manifest.yaml"
+	// KeyOCI is the reference of an OCI artifact with the manifest as its layer, e.g. ghcr.io/org/ako:2.1.0
+	KeyOCI = "oci"
+	// KeySHA256 is the checksum the manifest is checked against, optional
+	KeySHA256 = "sha256"
+	// KeyDefault makes the version the default of the add-on when "true"
+	KeyDefault = "default"
+)
+
+// ErrInvalidOverride is returned for a ConfigMap that cannot override an add-on
+var ErrInvalidOverride = errors.New("invalid add-on override")
+
+type override struct {
+	configMap client.ObjectKey
+	version   string
+	manifest  []byte
+	oci       string
+	sha256    string
+	isDefault bool
+}
+
+// overrides returns the overrides of an add-on, ordered by the name of their ConfigMaps
+func (b *Bundle) overrides(ctx context.Context, name string) ([]override, error) {
+	if b == nil || b.Reader == nil {
+		return nil, nil
+	}
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := b.Reader.List(ctx, configMaps, client.InNamespace(b.Namespace), client.MatchingLabels{AddonLabel: name}); err != nil {
+		return nil, fmt.Errorf("list the overrides of %s: %w", name, err)
+	}
+
+	var overrides []override
+	versions := map[string]string{}
+	defaults := 0
+	for _, cm := range configMaps.Items {
+		o := override{
+			configMap: client.ObjectKeyFromObject(&cm),
+			version:   cm.Data[KeyVersion],
+			manifest:  []byte(cm.Data[KeyManifest]),
+			oci:       cm.Data[KeyOCI],
+			sha256:    cm.Data[KeySHA256],
+		}
+		if data, ok := cm.BinaryData[KeyManifest]; ok {
+			o.manifest = data
+		}
+		if value, ok := cm.Data[KeyDefault]; ok {
+			isDefault, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w %s: %s is not a bool", ErrInvalidOverride, o.configMap, KeyDefault)
+			}
+			o.isDefault = isDefault
+		}
+
+		switch {
+		case o.version == "":
+			return nil, fmt.Errorf("%w %s: %s is required", ErrInvalidOverride, o.configMap, KeyVersion)
+		case (len(o.manifest) == 0) == (o.oci == ""):
+			return nil, fmt.Errorf("%w %s: one of %s and %s is required", ErrInvalidOverride, o.configMap, KeyManifest, KeyOCI)
+		case versions[o.version] != "":
+			return nil, fmt.Errorf("%w %s: %s %s is overridden by %s as well", ErrInvalidOverride, o.configMap, name,
+				o.version, versions[o.version])
+		}
+		versions[o.version] = o.configMap.String()
+		if o.isDefault {
+			defaults++
+		}
+		overrides = append(overrides, o)
+	}
+	if defaults > 1 {
+		return nil, fmt.Errorf("%w: %d ConfigMaps make their version the default of %s", ErrInvalidOverride, defaults, name)
+	}
+	return overrides, nil
+}
+
+// resolve returns the manifest of an override, pulling its OCI artifact
+func (b *Bundle) resolve(ctx context.Context, a *addon, o override) (*Manifest, error) {
+	m := &Manifest{
+		Addon:    a.name,
+		Version:  o.version,
+		Source:   "configmap:" + o.configMap.String(),
+		Template: a.template,
+		Data:     o.manifest,
+	}
+
+	if o.oci != "" {
+		data, err := b.pull(ctx, o.oci)
+		if err != nil {
+			return nil, fmt.Errorf("pull %s of %s: %w", o.oci, o.configMap, err)
+		}
+		m.Source, m.Data = "oci:"+o.oci, data
+	}
+	if o.sha256 != "" {
+		if err := verify(m.Data, o.sha256); err != nil {
+			return nil, fmt.Errorf("%s: %w", m.Source, err)
+		}
+	}
+	m.SHA256 = checksum(m.Data)
+	return m, nil
+}
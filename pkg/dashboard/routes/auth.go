@@ -2,33 +2,19 @@ package routes
 
 import (
 	"context"
-	"fmt"
-	"github.com/shaj13/libcache"
-	"net/http"
-	"time"
 
+	"github.com/aerospike/aerostation/pkg/authn"
 	"github.com/shaj13/go-guardian/v2/auth"
-	"github.com/shaj13/go-guardian/v2/auth/strategies/basic"
-	_ "github.com/shaj13/libcache/fifo"
 )
 
 var strategy auth.Strategy
-var cacheObj libcache.Cache
 
-func SetupGoGuardian() {
-	cacheObj = libcache.FIFO.New(0)
-	cacheObj.SetTTL(time.Hour * 24)
-	cacheObj.RegisterOnExpired(func(key, _ interface{}) {
-		cacheObj.Peek(key)
-	})
-	strategy = basic.NewCached(validateUser, cacheObj)
-}
-
-func validateUser(ctx context.Context, r *http.Request, userName, password string) (auth.Info, error) {
-	// here connect to db or any other service to fetch user and validate it.
-	if userName == "aerospike" && password == "Aerospike123!" {
-		return auth.NewDefaultUser("aerospike", "1", nil, nil), nil
+// SetupGoGuardian sets the authenticators of the routes, see authn.Config
+func SetupGoGuardian(ctx context.Context, config authn.Config) error {
+	s, err := authn.NewStrategy(ctx, config)
+	if err != nil {
+		return err
 	}
-
-	return nil, fmt.Errorf("[ERROR] Invalid credentials")
+	strategy = s
+	return nil
 }
@@ -3,6 +3,9 @@ package routes
 import (
 	"log"
 	"net/http"
+
+	"github.com/aerospike/aerostation/pkg/authn"
+	"github.com/shaj13/go-guardian/v2/auth"
 )
 
 func AuthMiddleware(next http.Handler) http.HandlerFunc {
@@ -14,7 +17,7 @@ func AuthMiddleware(next http.Handler) http.HandlerFunc {
 			http.Error(w, http.StatusText(code), code)
 			return
 		}
-		log.Printf("[INFO] User %s Authenticated\n", user.GetUserName())
-		next.ServeHTTP(w, r)
+		log.Printf("[INFO] User %s Authenticated by %s\n", user.GetUserName(), authn.Authenticator(user))
+		next.ServeHTTP(w, auth.RequestWithUser(user, r))
 	})
 }
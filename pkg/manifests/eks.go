@@ -1,17 +1,5 @@
 package manifests
 
-import (
-	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
-	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
-)
-
-func GetEKSStorage() *unstructured.Unstructured {
-	obj := &unstructured.Unstructured{}
-	dec := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
-	_, _, _ = dec.Decode([]byte(ssd), nil, obj)
-	return obj
-}
-
 var ssd = `kind: StorageClass
 apiVersion: storage.k8s.io/v1
 metadata:
@@ -0,0 +1,60 @@
+package manifests
+
+import (
+	"errors"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/serializer/yaml"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+// ErrNoStorage is returned for the providers without a StorageClass for the persistent databases. Docker clusters have
+// none, their local path provisioner cannot provision the block volumes of the ssd databases.
+var ErrNoStorage = errors.New("no storage class for persistent databases")
+
+// storage is the StorageClass named ssd of every provider, the volumes of the ssd and performance databases are claimed
+// from it. Only the one of EKS is the default class, the clusters of the other providers have theirs.
+var storage = map[string]string{
+	v1.ProviderEKS: ssd,
+	v1.ProviderAKS: aksSSD,
+	v1.ProviderGKE: gkeSSD,
+}
+
+// GetStorage returns the StorageClass the persistent databases of a cluster of the provider claim their volumes from
+func GetStorage(provider string) (*unstructured.Unstructured, error) {
+	manifest, ok := storage[provider]
+	if !ok {
+		return nil, fmt.Errorf("%w on %s clusters, the ssd and performance databases need an eks, aks or gke cluster",
+			ErrNoStorage, provider)
+	}
+	obj := &unstructured.Unstructured{}
+	dec := yaml.NewDecodingSerializer(unstructured.UnstructuredJSONScheme)
+	if _, _, err := dec.Decode([]byte(manifest), nil, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+var aksSSD = `kind: StorageClass
+apiVersion: storage.k8s.io/v1
+metadata:
+  name: ssd
+provisioner: kubernetes.io/azure-disk
+parameters:
+  storageaccounttype: Premium_LRS
+  kind: Managed
+volumeBindingMode: WaitForFirstConsumer
+`
+
+var gkeSSD = `kind: StorageClass
+apiVersion: storage.k8s.io/v1
+metadata:
+  name: ssd
+provisioner: kubernetes.io/gce-pd
+parameters:
+  type: pd-ssd
+  fstype: ext4
+volumeBindingMode: WaitForFirstConsumer
+`
@@ -0,0 +1,26 @@
+package manifests
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils/ako"
+)
+
+func TestGetStorage(t *testing.T) {
+	g := NewWithT(t)
+
+	for _, provider := range []string{v1.ProviderEKS, v1.ProviderAKS, v1.ProviderGKE} {
+		obj, err := GetStorage(provider)
+		g.Expect(err).NotTo(HaveOccurred(), provider)
+		g.Expect(obj.GetKind()).To(Equal("StorageClass"), provider)
+		// the templates of the databases claim their volumes from it
+		g.Expect(obj.GetName()).To(Equal(ako.StorageClassName), provider)
+	}
+
+	_, err := GetStorage(v1.ProviderDocker)
+	g.Expect(errors.Is(err, ErrNoStorage)).To(BeTrue())
+}
@@ -1,5 +1,7 @@
 package secrets
 
+import "time"
+
 // Purpose is the name to append to the secret generated for a cluster.
 type Purpose string
 
@@ -30,6 +32,12 @@ const (
 
 	// APIServerEtcdClient is the secret name of user-supplied secret containing the apiserver-etcd-client key/cert.
 	APIServerEtcdClient Purpose = "apiserver-etcd-client"
+
+	// DefaultUserKubeconfigTTL is how long a user Kubeconfig is valid when no ttl is requested.
+	DefaultUserKubeconfigTTL = 8 * time.Hour
+
+	// MaxUserKubeconfigTTL is the longest a user Kubeconfig can be valid, client certificates can not be revoked.
+	MaxUserKubeconfigTTL = 24 * time.Hour
 )
 
 var (
@@ -3,8 +3,13 @@ package secrets
 import (
 	"context"
 	"crypto"
+	"crypto/rand"
 	"crypto/x509"
+	"crypto/x509/pkix"
 	"fmt"
+	"math"
+	"math/big"
+	"time"
 
 	areov1 "github.com/aerospike/aerostation/api/v1"
 	"github.com/aerospike/aerostation/pkg/aerostation/common"
@@ -23,6 +28,10 @@ import (
 
 var (
 	ErrDependentCertificateNotFound = errors.New("could not find secret ca")
+
+	// ErrCAKeyNotFound is returned for clusters with a control plane managed by the cloud provider,
+	// their CA secret only has the certificate
+	ErrCAKeyNotFound = errors.New("CA private key not found")
 )
 
 // Get retrieves the specified Secret (if any) from the given
@@ -118,38 +127,156 @@ func New(clusterName, endpoint string, caCert *x509.Certificate, caKey crypto.Si
 }
 
 func generateKubeconfig(ctx context.Context, c client.Client, clusterName client.ObjectKey, endpoint string) ([]byte, error) {
-	clusterCA, err := secret.GetFromNamespacedName(ctx, c, clusterName, secret.ClusterCA)
+	cert, key, err := getClusterCA(ctx, c, clusterName)
 	if err != nil {
-		if apierrors.IsNotFound(err) {
-			return nil, ErrDependentCertificateNotFound
-		}
 		return nil, err
 	}
 
-	cert, err := certs.DecodeCertPEM(clusterCA.Data[secret.TLSCrtDataName])
+	cfg, err := New(clusterName.Name, endpoint, cert, key)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to decode CA Cert")
-	} else if cert == nil {
-		return nil, errors.New("certificate not found in config")
+		return nil, errors.Wrap(err, "failed to generate a kubeconfig")
 	}
 
-	key, err := certs.DecodePrivateKeyPEM(clusterCA.Data[secret.TLSKeyDataName])
+	out, err := clientcmd.Write(*cfg)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to decode private key")
-	} else if key == nil {
-		return nil, errors.New("CA private key not found")
+		return nil, errors.Wrap(err, "failed to serialize config to yaml")
 	}
+	return out, nil
+}
 
-	cfg, err := New(clusterName.Name, endpoint, cert, key)
+// NewForUser creates a Kubeconfig for user using the cluster name and specified endpoint,
+// the client certificate has the groups as organizations and expires after ttl.
+func NewForUser(clusterName, endpoint, user string, groups []string, ttl time.Duration, caCert *x509.Certificate, caKey crypto.Signer) (*api.Config, error) {
+	if user == "" {
+		return nil, errors.New("user is required")
+	}
+	if err := ValidateUserKubeconfigTTL(ttl); err != nil {
+		return nil, err
+	}
+
+	clientKey, err := certs.NewPrivateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create private key")
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).SetInt64(math.MaxInt64))
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to create serial number")
+	}
+
+	// backdated a little for clocks that are behind the one of the api server
+	now := time.Now().UTC()
+	tmpl := x509.Certificate{
+		SerialNumber: serial,
+		Subject: pkix.Name{
+			CommonName:   user,
+			Organization: groups,
+		},
+		NotBefore:   now.Add(-5 * time.Minute),
+		NotAfter:    now.Add(ttl),
+		KeyUsage:    x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	b, err := x509.CreateCertificate(rand.Reader, &tmpl, caCert, clientKey.Public(), caKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to sign certificate")
+	}
+	clientCert, err := x509.ParseCertificate(b)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse certificate")
+	}
+
+	userName := fmt.Sprintf("%s-%s", clusterName, user)
+	contextName := fmt.Sprintf("%s@%s", user, clusterName)
+
+	return &api.Config{
+		Clusters: map[string]*api.Cluster{
+			clusterName: {
+				Server:                   endpoint,
+				CertificateAuthorityData: certs.EncodeCertPEM(caCert),
+			},
+		},
+		Contexts: map[string]*api.Context{
+			contextName: {
+				Cluster:  clusterName,
+				AuthInfo: userName,
+			},
+		},
+		AuthInfos: map[string]*api.AuthInfo{
+			userName: {
+				ClientKeyData:         certs.EncodePrivateKeyPEM(clientKey),
+				ClientCertificateData: certs.EncodeCertPEM(clientCert),
+			},
+		},
+		CurrentContext: contextName,
+	}, nil
+}
+
+// ValidateUserKubeconfigTTL checks that a user Kubeconfig expires, at the latest after MaxUserKubeconfigTTL
+func ValidateUserKubeconfigTTL(ttl time.Duration) error {
+	if ttl <= 0 || ttl > MaxUserKubeconfigTTL {
+		return errors.Errorf("ttl %s must be positive and at most %s", ttl, MaxUserKubeconfigTTL)
+	}
+	return nil
+}
+
+// GenerateUserKubeconfig returns a Kubeconfig for user signed by the cluster CA. The endpoint is
+// the one of the admin Kubeconfig secret, the credentials of that secret are never handed out.
+func GenerateUserKubeconfig(ctx context.Context, c client.Reader, clusterName client.ObjectKey, user string, groups []string, ttl time.Duration) (*api.Config, error) {
+	kubeconfigSecret, err := Get(ctx, c, clusterName, Kubeconfig)
+	if err != nil {
+		return nil, err
+	}
+	admin, err := clientcmd.Load(kubeconfigSecret.Data[KubeconfigDataName])
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load the cluster kubeconfig")
+	}
+	adminContext, ok := admin.Contexts[admin.CurrentContext]
+	if !ok || admin.Clusters[adminContext.Cluster] == nil {
+		return nil, errors.New("cluster kubeconfig has no current context")
+	}
+
+	cert, key, err := getClusterCA(ctx, c, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg, err := NewForUser(clusterName.Name, admin.Clusters[adminContext.Cluster].Server, user, groups, ttl, cert, key)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to generate a kubeconfig")
 	}
+	return cfg, nil
+}
 
-	out, err := clientcmd.Write(*cfg)
+// getClusterCA returns the certificate and the private key of the cluster CA
+func getClusterCA(ctx context.Context, c client.Reader, clusterName client.ObjectKey) (*x509.Certificate, crypto.Signer, error) {
+	clusterCA, err := secret.GetFromNamespacedName(ctx, c, clusterName, secret.ClusterCA)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to serialize config to yaml")
+		if apierrors.IsNotFound(err) {
+			return nil, nil, ErrDependentCertificateNotFound
+		}
+		return nil, nil, err
 	}
-	return out, nil
+
+	cert, err := certs.DecodeCertPEM(clusterCA.Data[secret.TLSCrtDataName])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode CA Cert")
+	} else if cert == nil {
+		return nil, nil, errors.New("certificate not found in config")
+	}
+
+	if len(clusterCA.Data[secret.TLSKeyDataName]) == 0 {
+		return nil, nil, ErrCAKeyNotFound
+	}
+	key, err := certs.DecodePrivateKeyPEM(clusterCA.Data[secret.TLSKeyDataName])
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to decode private key")
+	} else if key == nil {
+		return nil, nil, ErrCAKeyNotFound
+	}
+
+	return cert, key, nil
 }
 
 // GetFromNamespacedName retrieves the specified Secret (if any) from the given
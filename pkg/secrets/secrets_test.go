@@ -0,0 +1,129 @@
+package secrets
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func newTestCA(g *WithT) (*x509.Certificate, []byte) {
+	key, err := certs.NewPrivateKey()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "kubernetes"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	b, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	g.Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(b)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	return cert, certs.EncodePrivateKeyPEM(key)
+}
+
+func TestNewForUser(t *testing.T) {
+	g := NewWithT(t)
+
+	caCert, caKeyPEM := newTestCA(g)
+	caKey, err := certs.DecodePrivateKeyPEM(caKeyPEM)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	cfg, err := NewForUser("test-1", "https://10.0.0.1:6443", "alice", []string{"aerostation:users"}, time.Hour, caCert, caKey)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.CurrentContext).To(Equal("alice@test-1"))
+	g.Expect(cfg.Clusters["test-1"].Server).To(Equal("https://10.0.0.1:6443"))
+
+	cert, err := certs.DecodeCertPEM(cfg.AuthInfos["test-1-alice"].ClientCertificateData)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cert.Subject.CommonName).To(Equal("alice"))
+	g.Expect(cert.Subject.Organization).To(Equal([]string{"aerostation:users"}))
+	g.Expect(cert.NotAfter).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(caCert)
+	_, err = cert.Verify(x509.VerifyOptions{Roots: roots, KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = NewForUser("test-1", "https://10.0.0.1:6443", "alice", nil, 25*time.Hour, caCert, caKey)
+	g.Expect(err).To(MatchError("ttl 25h0m0s must be positive and at most 24h0m0s"))
+
+	_, err = NewForUser("test-1", "https://10.0.0.1:6443", "", nil, time.Hour, caCert, caKey)
+	g.Expect(err).To(MatchError("user is required"))
+}
+
+func TestGenerateUserKubeconfig(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	cluster := client.ObjectKey{Name: "test-1", Namespace: "default"}
+
+	caCert, caKeyPEM := newTestCA(g)
+	adminKubeconfig := []byte(`apiVersion: v1
+kind: Config
+clusters:
+- name: test-1
+  cluster:
+    server: https://10.0.0.1:6443
+users:
+- name: test-1-admin
+  user:
+    token: admin-token
+contexts:
+- name: test-1-admin@test-1
+  context:
+    cluster: test-1
+    user: test-1-admin
+current-context: test-1-admin@test-1
+`)
+
+	c := fake.NewClientBuilder().WithObjects(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-1-kubeconfig", Namespace: "default"},
+			Data:       map[string][]byte{KubeconfigDataName: adminKubeconfig},
+		},
+	).Build()
+
+	// the kubeconfig secret exists before the CA is created
+	_, err := GenerateUserKubeconfig(ctx, c, cluster, "alice", nil, time.Hour)
+	g.Expect(err).To(Equal(ErrDependentCertificateNotFound))
+
+	// managed control planes only publish the certificate of their CA
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-1-ca", Namespace: "default"},
+		Data:       map[string][]byte{TLSCrtDataName: certs.EncodeCertPEM(caCert)},
+	}
+	g.Expect(c.Create(ctx, caSecret)).To(Succeed())
+	_, err = GenerateUserKubeconfig(ctx, c, cluster, "alice", nil, time.Hour)
+	g.Expect(err).To(Equal(ErrCAKeyNotFound))
+
+	caSecret.Data[TLSKeyDataName] = caKeyPEM
+	g.Expect(c.Update(ctx, caSecret)).To(Succeed())
+
+	cfg, err := GenerateUserKubeconfig(ctx, c, cluster, "alice", nil, time.Hour)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(cfg.Clusters["test-1"].Server).To(Equal("https://10.0.0.1:6443"))
+	g.Expect(cfg.AuthInfos).To(HaveLen(1))
+	g.Expect(cfg.AuthInfos["test-1-alice"].Token).To(BeEmpty())
+
+	out, err := clientcmd.Write(*cfg)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(string(out)).NotTo(ContainSubstring("admin-token"))
+}
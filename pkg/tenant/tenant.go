@@ -0,0 +1,128 @@
+// Package tenant maps the users of aerostation to their namespaces on the management cluster.
+package tenant
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strings"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// NamespacePrefix starts the name of every tenant namespace
+	NamespacePrefix = "tenant-"
+
+	// UserAnnotation is the user a tenant namespace belongs to
+	UserAnnotation = "aerostation.io/user"
+
+	// MetadataKey is the grpc metadata of the calls made on behalf of a tenant, its value is the tenant namespace
+	MetadataKey = "x-aerostation-tenant"
+
+	// nameLength is how much of the user name is kept, the namespace is at most 63 characters
+	nameLength = 40
+	hashLength = 8
+)
+
+// ErrNoTenant is returned for users without a name
+var ErrNoTenant = errors.New("user has no tenant")
+
+// Namespace returns the tenant namespace of a user. The name is readable, the hash of the identity of the user, see
+// authn.Identity, keeps users whose names only differ in characters that are not allowed in a namespace apart, as well
+// as the users of the same name from two authenticators.
+func Namespace(user, identity string) (string, error) {
+	if user == "" {
+		return "", ErrNoTenant
+	}
+
+	var b strings.Builder
+	for _, r := range strings.ToLower(user) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('-')
+		}
+	}
+	name := b.String()
+	if len(name) > nameLength {
+		name = name[:nameLength]
+	}
+	name = strings.Trim(name, "-")
+
+	sum := sha256.Sum256([]byte(identity))
+	hash := hex.EncodeToString(sum[:])[:hashLength]
+	if name == "" {
+		return NamespacePrefix + hash, nil
+	}
+	return NamespacePrefix + name + "-" + hash, nil
+}
+
+// EnsureNamespace creates the tenant namespace of a user on the management cluster and returns its name
+func EnsureNamespace(ctx context.Context, c client.Client, user, identity string) (string, error) {
+	name, err := Namespace(user, identity)
+	if err != nil {
+		return "", err
+	}
+
+	if err := CreateNamespace(ctx, c, name, user); err != nil {
+		return "", err
+	}
+	return name, nil
+}
+
+// CreateNamespace creates a tenant namespace, it is not an error if it exists. The user is optional.
+func CreateNamespace(ctx context.Context, c client.Client, name, user string) error {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{v1.TenantLabel: name},
+		},
+	}
+	if user != "" {
+		namespace.Annotations = map[string]string{UserAnnotation: user}
+	}
+
+	if err := c.Create(ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
+
+// NewOutgoingContext scopes the grpc calls made with the context to the tenant namespace
+func NewOutgoingContext(ctx context.Context, namespace string) context.Context {
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, namespace)
+}
+
+// FromIncomingContext returns the tenant namespace of a grpc call, false for calls that are not made for a tenant
+func FromIncomingContext(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return "", false
+	}
+	return values[0], true
+}
+
+// NewIncomingContext scopes a grpc call received by a server to the tenant namespace, it replaces the namespace the
+// caller passed
+func NewIncomingContext(ctx context.Context, namespace string) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	md.Set(MetadataKey, namespace)
+	return metadata.NewIncomingContext(ctx, md)
+}
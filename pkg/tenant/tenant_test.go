@@ -0,0 +1,76 @@
+package tenant
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc/metadata"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNamespace(t *testing.T) {
+	g := NewWithT(t)
+
+	users := []string{"aerospike", "Dev1", "dev.1@example.com", "dev_1@example.com", "---", strings.Repeat("a", 100)}
+	seen := map[string]string{}
+	for _, user := range users {
+		name, err := Namespace(user, user)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(validation.IsDNS1123Label(name)).To(BeEmpty(), name)
+		g.Expect(name).To(HavePrefix(NamespacePrefix))
+		g.Expect(seen).NotTo(HaveKey(name), "%s and %s share a namespace", user, seen[name])
+		seen[name] = user
+
+		again, _ := Namespace(user, user)
+		g.Expect(again).To(Equal(name))
+	}
+
+	// the same name of two authenticators is two tenants
+	local, _ := Namespace("dev1", "htpasswd\n\ndev1")
+	oidc, _ := Namespace("dev1", "oidc\nhttps://issuer.example.com\ndev1")
+	g.Expect(local).NotTo(Equal(oidc))
+
+	_, err := Namespace("", "")
+	g.Expect(err).To(MatchError(ErrNoTenant))
+}
+
+func TestEnsureNamespace(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	name, err := EnsureNamespace(ctx, c, "dev1", "dev1")
+	g.Expect(err).NotTo(HaveOccurred())
+
+	namespace := &corev1.Namespace{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: name}, namespace)).To(Succeed())
+	g.Expect(namespace.Labels).To(HaveKeyWithValue(v1.TenantLabel, name))
+	g.Expect(namespace.Annotations).To(HaveKeyWithValue(UserAnnotation, "dev1"))
+
+	// signing in again is fine
+	_, err = EnsureNamespace(ctx, c, "dev1", "dev1")
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestContext(t *testing.T) {
+	g := NewWithT(t)
+
+	_, ok := FromIncomingContext(context.Background())
+	g.Expect(ok).To(BeFalse())
+
+	out := NewOutgoingContext(context.Background(), "tenant-a")
+	md, _ := metadata.FromOutgoingContext(out)
+	namespace, ok := FromIncomingContext(metadata.NewIncomingContext(context.Background(), md))
+	g.Expect(ok).To(BeTrue())
+	g.Expect(namespace).To(Equal("tenant-a"))
+}
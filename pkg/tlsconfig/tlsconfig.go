@@ -0,0 +1,204 @@
+// Package tlsconfig builds the TLS of the grpc link between the api-server and capi-api. The certificates are files,
+// e.g. the tls.crt, tls.key and ca.crt of a secret issued by cert-manager, they are read again at the handshakes after
+// they changed on disk.
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// ErrNoCertificate is returned for a server without a certificate
+var ErrNoCertificate = errors.New("certificate and key are required")
+
+// Options are the files of a side of the link
+type Options struct {
+	// CertFile and KeyFile are the certificate of the server, or the client certificate of a client (mTLS)
+	CertFile string
+	KeyFile  string
+	// CAFile verifies the peer: the certificate of the server for a client, the client certificates for a server. A
+	// client uses the system roots if empty, a server does not ask for client certificates.
+	CAFile string
+	// ServerName is the name a client verifies the certificate of the server with, the host it dials if empty
+	ServerName string
+	// RequireClientCert rejects the clients without a certificate signed by CAFile
+	RequireClientCert bool
+}
+
+// Server returns the TLS of a server
+func Server(options Options) (*tls.Config, error) {
+	if options.CertFile == "" || options.KeyFile == "" {
+		return nil, ErrNoCertificate
+	}
+	certificate, err := loadKeyPair(options.CertFile, options.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			return certificate.get().(*tls.Certificate), nil
+		},
+	}
+	if options.CAFile == "" {
+		if options.RequireClientCert {
+			return nil, fmt.Errorf("client certificates are required without a ca")
+		}
+		return config, nil
+	}
+
+	cas, err := loadCAs(options.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	clientAuth := tls.VerifyClientCertIfGiven
+	if options.RequireClientCert {
+		clientAuth = tls.RequireAndVerifyClientCert
+	}
+	// the ca is read at every handshake, the connections after a renewal are verified with the new ca
+	config.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c := config.Clone()
+		c.GetConfigForClient = nil
+		c.ClientAuth = clientAuth
+		c.ClientCAs = cas.get().(*x509.CertPool)
+		return c, nil
+	}
+	return config, nil
+}
+
+// Client returns the TLS of a client
+func Client(options Options) (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: options.ServerName,
+	}
+
+	if options.CertFile != "" || options.KeyFile != "" {
+		certificate, err := loadKeyPair(options.CertFile, options.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return certificate.get().(*tls.Certificate), nil
+		}
+	}
+
+	if options.CAFile == "" {
+		return config, nil
+	}
+	cas, err := loadCAs(options.CAFile)
+	if err != nil {
+		return nil, err
+	}
+	// crypto/tls takes the roots of a client once, the server is verified with the current ca instead. The
+	// verification is the one of crypto/tls, with the name of the server the connection was made to.
+	config.InsecureSkipVerify = true
+	config.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return fmt.Errorf("server has no certificate")
+		}
+		verify := x509.VerifyOptions{
+			DNSName:       state.ServerName,
+			Roots:         cas.get().(*x509.CertPool),
+			Intermediates: x509.NewCertPool(),
+		}
+		for _, intermediate := range state.PeerCertificates[1:] {
+			verify.Intermediates.AddCert(intermediate)
+		}
+		_, err := state.PeerCertificates[0].Verify(verify)
+		return err
+	}
+	return config, nil
+}
+
+func loadKeyPair(certFile, keyFile string) (*reloaded, error) {
+	return newReloaded(func() (interface{}, error) {
+		certificate, err := tls.LoadX509KeyPair(certFile, keyFile)
+		return &certificate, err
+	}, certFile, keyFile)
+}
+
+func loadCAs(caFile string) (*reloaded, error) {
+	return newReloaded(func() (interface{}, error) {
+		data, err := ioutil.ReadFile(caFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(data) {
+			return nil, fmt.Errorf("no certificate in %s", caFile)
+		}
+		return pool, nil
+	}, caFile)
+}
+
+// reloaded keeps what is read from files, it is read again when the files change. The value of the last valid read
+// is kept when they cannot be read, e.g. a certificate that does not match its key yet while both are replaced.
+type reloaded struct {
+	paths []string
+	read  func() (interface{}, error)
+
+	mu       sync.Mutex
+	modTimes []time.Time
+	value    interface{}
+}
+
+func newReloaded(read func() (interface{}, error), paths ...string) (*reloaded, error) {
+	r := &reloaded{paths: paths, read: read}
+	modTimes, err := r.stat()
+	if err != nil {
+		return nil, err
+	}
+	if r.value, err = read(); err != nil {
+		return nil, err
+	}
+	r.modTimes = modTimes
+	return r, nil
+}
+
+func (r *reloaded) get() interface{} {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	modTimes, err := r.stat()
+	if err != nil || !changed(modTimes, r.modTimes) {
+		return r.value
+	}
+	value, err := r.read()
+	if err != nil {
+		log.Printf("[ERROR] reloading %v: %v", r.paths, err)
+		return r.value
+	}
+	r.value, r.modTimes = value, modTimes
+	return r.value
+}
+
+// stat follows the symlinks, the files of a mounted secret are replaced by moving the link of their directory
+func (r *reloaded) stat() ([]time.Time, error) {
+	modTimes := make([]time.Time, len(r.paths))
+	for i, path := range r.paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[i] = info.ModTime()
+	}
+	return modTimes, nil
+}
+
+func changed(a, b []time.Time) bool {
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+)
+
+const serverName = "aerostation-capi-service"
+
+// authority signs the test certificates
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+var serial int64
+
+func newKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	NewWithT(t).Expect(err).NotTo(HaveOccurred())
+	return key
+}
+
+func template(name string) *x509.Certificate {
+	serial++
+	return &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+}
+
+func newAuthority(t *testing.T) *authority {
+	g := NewWithT(t)
+
+	key := newKey(t)
+	ca := template("test-ca")
+	ca.IsCA = true
+	ca.BasicConstraintsValid = true
+	ca.KeyUsage = x509.KeyUsageCertSign
+	der, err := x509.CreateCertificate(rand.Reader, ca, ca, key.Public(), key)
+	g.Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	g.Expect(err).NotTo(HaveOccurred())
+	return &authority{cert: cert, key: key, pem: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})}
+}
+
+// issue writes a certificate and its key signed by the authority to dir, and returns the serial of the certificate
+func (a *authority) issue(t *testing.T, dir, name string, usage x509.ExtKeyUsage) *big.Int {
+	g := NewWithT(t)
+
+	key := newKey(t)
+	cert := template(name)
+	cert.DNSNames = []string{name}
+	cert.KeyUsage = x509.KeyUsageDigitalSignature
+	cert.ExtKeyUsage = []x509.ExtKeyUsage{usage}
+	der, err := x509.CreateCertificate(rand.Reader, cert, a.cert, key.Public(), a.key)
+	g.Expect(err).NotTo(HaveOccurred())
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// the key first, the watcher reads the pair when the certificate changes
+	g.Expect(ioutil.WriteFile(filepath.Join(dir, "tls.key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600)).To(Succeed())
+	g.Expect(ioutil.WriteFile(filepath.Join(dir, "tls.crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+	return cert.SerialNumber
+}
+
+func (a *authority) write(t *testing.T, dir string) {
+	NewWithT(t).Expect(ioutil.WriteFile(filepath.Join(dir, "ca.crt"), a.pem, 0600)).To(Succeed())
+}
+
+func files(dir string) Options {
+	return Options{
+		CertFile: filepath.Join(dir, "tls.crt"),
+		KeyFile:  filepath.Join(dir, "tls.key"),
+		CAFile:   filepath.Join(dir, "ca.crt"),
+	}
+}
+
+// serve starts a grpc server with the TLS of options and returns its address
+func serve(t *testing.T, options Options) string {
+	g := NewWithT(t)
+
+	config, err := Server(options)
+	g.Expect(err).NotTo(HaveOccurred())
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(config)))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	g.Expect(err).NotTo(HaveOccurred())
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+	return listener.Addr().String()
+}
+
+// check makes a call on a new connection and returns the serial of the certificate of the server
+func check(address string, options Options) (*big.Int, error) {
+	config, err := Client(options)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(credentials.NewTLS(config)))
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	p := &peer.Peer{}
+	if _, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{}, grpc.Peer(p)); err != nil {
+		return nil, err
+	}
+	return p.AuthInfo.(credentials.TLSInfo).State.PeerCertificates[0].SerialNumber, nil
+}
+
+func TestMutualTLS(t *testing.T) {
+	g := NewWithT(t)
+
+	ca := newAuthority(t)
+	serverDir, clientDir, otherDir := t.TempDir(), t.TempDir(), t.TempDir()
+	ca.issue(t, serverDir, serverName, x509.ExtKeyUsageServerAuth)
+	ca.write(t, serverDir)
+	ca.issue(t, clientDir, "api-server", x509.ExtKeyUsageClientAuth)
+	ca.write(t, clientDir)
+	// a client certificate of another ca
+	newAuthority(t).issue(t, otherDir, "api-server", x509.ExtKeyUsageClientAuth)
+	ca.write(t, otherDir)
+
+	server := files(serverDir)
+	server.RequireClientCert = true
+	address := serve(t, server)
+
+	client := files(clientDir)
+	client.ServerName = serverName
+	_, err := check(address, client)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	rejected := map[string]Options{
+		"no client certificate":         {CAFile: client.CAFile, ServerName: serverName},
+		"client certificate of another": {CertFile: filepath.Join(otherDir, "tls.crt"), KeyFile: filepath.Join(otherDir, "tls.key"), CAFile: client.CAFile, ServerName: serverName},
+		"other server name":             {CertFile: client.CertFile, KeyFile: client.KeyFile, CAFile: client.CAFile, ServerName: "other"},
+		"server of an unknown ca":       {CertFile: client.CertFile, KeyFile: client.KeyFile, CAFile: filepath.Join(otherDir, "tls.crt"), ServerName: serverName},
+	}
+	for name, options := range rejected {
+		_, err := check(address, options)
+		g.Expect(err).To(HaveOccurred(), name)
+	}
+
+	// without a ca the server does not ask for client certificates
+	address = serve(t, Options{CertFile: server.CertFile, KeyFile: server.KeyFile})
+	_, err = check(address, Options{CAFile: client.CAFile, ServerName: serverName})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = Server(Options{CertFile: server.CertFile, KeyFile: server.KeyFile, RequireClientCert: true})
+	g.Expect(err).To(HaveOccurred())
+	_, err = Server(Options{CAFile: server.CAFile})
+	g.Expect(err).To(MatchError(ErrNoCertificate))
+}
+
+func TestReload(t *testing.T) {
+	g := NewWithT(t)
+
+	ca := newAuthority(t)
+	serverDir, clientDir := t.TempDir(), t.TempDir()
+	ca.issue(t, serverDir, serverName, x509.ExtKeyUsageServerAuth)
+	ca.write(t, serverDir)
+	ca.issue(t, clientDir, "api-server", x509.ExtKeyUsageClientAuth)
+	ca.write(t, clientDir)
+
+	server := files(serverDir)
+	server.RequireClientCert = true
+	address := serve(t, server)
+	client := files(clientDir)
+	client.ServerName = serverName
+
+	// the new connections get the renewed certificate of the server
+	renewed := ca.issue(t, serverDir, serverName, x509.ExtKeyUsageServerAuth)
+	g.Eventually(func() (*big.Int, error) { return check(address, client) }, 5*time.Second).Should(Equal(renewed))
+
+	// a new ca is trusted once both sides have it, then the certificates it signs are accepted
+	rotated := newAuthority(t)
+	bundle := append(append([]byte{}, ca.pem...), rotated.pem...)
+	g.Expect(ioutil.WriteFile(server.CAFile, bundle, 0600)).To(Succeed())
+	g.Expect(ioutil.WriteFile(client.CAFile, bundle, 0600)).To(Succeed())
+	renewed = rotated.issue(t, serverDir, serverName, x509.ExtKeyUsageServerAuth)
+	rotated.issue(t, clientDir, "api-server", x509.ExtKeyUsageClientAuth)
+	g.Eventually(func() (*big.Int, error) { return check(address, client) }, 5*time.Second).Should(Equal(renewed))
+}
@@ -0,0 +1,90 @@
+package ako
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+// StorageClassName is the StorageClass the persistent volumes of the ssd and performance databases are claimed from
+const StorageClassName = "ssd"
+
+// GetDatabase renders the AerospikeCluster matching the DatabaseType of the spec.
+// An empty type is treated as memory.
+func GetDatabase(options v1.AeroDatabaseSpec) ([]byte, error) {
+	switch options.DatabaseType {
+	case v1.DatabaseTypeMemory, "":
+		return GetMemoryDB(options)
+	case v1.DatabaseTypeSSD:
+		return GetSSDDB(options)
+	case v1.DatabaseTypePerformance:
+		return GetPerformanceDB(options)
+	}
+
+	return nil, ValidateDatabaseType(options.DatabaseType)
+}
+
+// ValidateDatabaseType returns an error if there is no template for the database type
+func ValidateDatabaseType(databaseType string) error {
+	if databaseType == "" || v1.IsValidDatabaseType(databaseType) {
+		return nil
+	}
+
+	return fmt.Errorf("unknown database type %q, must be one of %s",
+		databaseType, strings.Join(v1.DatabaseTypes, "/"))
+}
+
+// RequiresStorage returns true if the database type claims persistent volumes
+func RequiresStorage(databaseType string) bool {
+	return databaseType == v1.DatabaseTypeSSD || databaseType == v1.DatabaseTypePerformance
+}
+
+func render(name, text string, options v1.AeroDatabaseSpec) ([]byte, error) {
+	type customization struct {
+		Name         string
+		Namespace    string
+		Replicas     int32
+		StorageClass string
+		Monitoring   *v1.MonitoringOptions
+	}
+
+	c := customization{
+		Name:         options.Name,
+		Namespace:    options.Namespace,
+		Replicas:     options.Options.Replicas,
+		StorageClass: StorageClassName,
+	}
+	if options.Options.Monitoring.IsEnabled() {
+		c.Monitoring = options.Options.Monitoring.DeepCopy()
+		c.Monitoring.Default()
+	}
+
+	t := template.Must(template.Must(template.New(name).Parse(text)).Parse(Exporter))
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, c); err != nil {
+		return nil, fmt.Errorf("failed to render the %s database: %w", name, err)
+	}
+	return b.Bytes(), nil
+}
+
+// Exporter is the aerospike prometheus exporter sidecar of the aerospike pods, it scrapes the aerospike server of its pod
+var Exporter = `
+{{- define "exporter" }}{{ with .Monitoring }}
+    sidecars:
+      - name: exporter
+        image: {{ .Image }}
+        ports:
+          - name: exporter
+            containerPort: {{ .Port }}
+        env:
+          - name: AS_HOST
+            value: localhost
+          - name: AS_PORT
+            value: "3000"
+          - name: BIND_PORT
+            value: "{{ .Port }}"
+{{- end }}{{ end }}`
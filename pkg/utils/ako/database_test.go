@@ -0,0 +1,77 @@
+package ako
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+func TestGetDatabase(t *testing.T) {
+	tests := []struct {
+		databaseType string
+		// the volume modes of the persistent volumes, by volume name
+		volumes     map[string]string
+		storageType string
+	}{
+		{databaseType: "", storageType: "memory"},
+		{databaseType: v1.DatabaseTypeMemory, storageType: "memory"},
+		{databaseType: v1.DatabaseTypeSSD, storageType: "device", volumes: map[string]string{"workdir": "Filesystem", "ns": "Block"}},
+		{databaseType: v1.DatabaseTypePerformance, storageType: "device", volumes: map[string]string{"workdir": "Filesystem", "ns": "Filesystem"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.databaseType, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := v1.AeroDatabaseSpec{Name: "demo", Namespace: "aerospike", DatabaseType: tt.databaseType}
+			spec.Options.Replicas = 3
+
+			data, err := GetDatabase(spec)
+			g.Expect(err).NotTo(HaveOccurred())
+			objects := decode(t, data)
+			g.Expect(objects).To(HaveLen(1))
+			cluster := objects[0]
+			g.Expect(cluster.GetAPIVersion()).To(Equal("asdb.aerospike.com/v1beta1"))
+			g.Expect(cluster.GetKind()).To(Equal("AerospikeCluster"))
+			g.Expect(cluster.GetName()).To(Equal("demo"))
+			g.Expect(cluster.GetNamespace()).To(Equal("aerospike"))
+			size, _, _ := unstructured.NestedFieldNoCopy(cluster.Object, "spec", "size")
+			g.Expect(size).To(BeNumerically("==", 3))
+
+			namespaces, _, _ := unstructured.NestedSlice(cluster.Object, "spec", "aerospikeConfig", "namespaces")
+			g.Expect(namespaces).To(HaveLen(1))
+			storageType, _, _ := unstructured.NestedString(namespaces[0].(map[string]interface{}), "storage-engine", "type")
+			g.Expect(storageType).To(Equal(tt.storageType))
+
+			volumes, _, _ := unstructured.NestedSlice(cluster.Object, "spec", "storage", "volumes")
+			persistent := map[string]string{}
+			for _, v := range volumes {
+				volume := v.(map[string]interface{})
+				pv, ok, _ := unstructured.NestedMap(volume, "source", "persistentVolume")
+				if !ok {
+					continue
+				}
+				// the volumes are claimed from the StorageClass the controller creates
+				g.Expect(pv["storageClass"]).To(Equal(StorageClassName))
+				persistent[volume["name"].(string)] = pv["volumeMode"].(string)
+			}
+			if tt.volumes == nil {
+				g.Expect(persistent).To(BeEmpty())
+			} else {
+				g.Expect(persistent).To(Equal(tt.volumes))
+			}
+			g.Expect(RequiresStorage(tt.databaseType)).To(Equal(tt.volumes != nil))
+		})
+	}
+}
+
+func TestGetDatabaseUnknownType(t *testing.T) {
+	g := NewWithT(t)
+
+	_, err := GetDatabase(v1.AeroDatabaseSpec{Name: "demo", DatabaseType: "tape"})
+	g.Expect(err).To(MatchError(ContainSubstring(`unknown database type "tape"`)))
+	g.Expect(ValidateDatabaseType("tape")).To(HaveOccurred())
+	g.Expect(ValidateDatabaseType("")).To(Succeed())
+}
@@ -1,37 +1,12 @@
 package ako
 
 import (
-	"bytes"
-	"fmt"
-	"text/template"
-
 	v1 "github.com/aerospike/aerostation/api/v1"
 )
 
-func GetMemoryDB(options v1.AeroDatabaseSpec) []byte {
-	type customization struct {
-		Name      string
-		Namespace string
-		Replicas  int32
-	}
-
-	c := customization{
-		Name:      options.Name,
-		Namespace: options.Namespace,
-		Replicas:  options.Options.Replicas,
-	}
-
-	t := template.Must(template.New("db").Parse(Nostorage))
-
-	var b bytes.Buffer
-	err := t.Execute(&b, c)
-	if err != nil {
-		fmt.Printf("error in templating %s\n", err.Error())
-	}
-
-	fmt.Printf("AerospikeCluster is going to be:  %s\n", b.String())
-
-	return b.Bytes()
+// GetMemoryDB renders an AerospikeCluster with in-memory storage only
+func GetMemoryDB(options v1.AeroDatabaseSpec) ([]byte, error) {
+	return render("db", Nostorage, options)
 }
 
 var Nostorage = `
@@ -45,6 +20,7 @@ spec:
   image: aerospike/aerospike-server-enterprise:5.6.0.7
   podSpec:
     multiPodPerHost: true
+{{- template "exporter" . }}
   validationPolicy:
     skipWorkDirValidate: true
     skipXdrDlogFileValidate: true
@@ -0,0 +1,128 @@
+package ako
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+// ExporterContainerName is the name of the exporter sidecar and of its metrics port
+const ExporterContainerName = "exporter"
+
+// MonitoringGroup is the api group of the ServiceMonitors and PodMonitors of the prometheus operator
+const MonitoringGroup = "monitoring.coreos.com"
+
+// ExporterName is the name of the exporter Service and of the monitor of a database
+func ExporterName(name string) string {
+	return name + "-" + ExporterContainerName
+}
+
+// MonitorCRD is the name of the CustomResourceDefinition the prometheus operator installs for a kind of monitor
+func MonitorCRD(kind string) string {
+	return strings.ToLower(kind) + "s." + MonitoringGroup
+}
+
+// ValidateMonitorKind returns an error if the prometheus operator has no such monitor
+func ValidateMonitorKind(kind string) error {
+	if kind == "" || v1.IsValidMonitorKind(kind) {
+		return nil
+	}
+
+	return fmt.Errorf("unknown monitor kind %q, must be one of %s",
+		kind, strings.Join(v1.MonitorKinds, "/"))
+}
+
+// GetMonitoring renders the headless Service of the exporters of a database and, if monitor is set, the
+// ServiceMonitor or PodMonitor that tells the prometheus operator to scrape them. Nothing is rendered if the
+// monitoring of the database is disabled.
+func GetMonitoring(options v1.AeroDatabaseSpec, monitor bool) ([]byte, error) {
+	if !options.Options.Monitoring.IsEnabled() {
+		return nil, nil
+	}
+
+	monitoring := options.Options.Monitoring.DeepCopy()
+	monitoring.Default()
+	if err := ValidateMonitorKind(monitoring.MonitorKind); err != nil {
+		return nil, err
+	}
+
+	type customization struct {
+		Name     string
+		Port     int32
+		Interval string
+		Labels   map[string]string
+		Kind     string
+	}
+
+	c := customization{
+		Name:     options.Name,
+		Port:     monitoring.Port,
+		Interval: monitoring.Interval,
+		Labels:   monitoring.Labels,
+	}
+	if monitor {
+		c.Kind = monitoring.MonitorKind
+	}
+
+	t := template.Must(template.New("monitoring").Parse(Monitoring))
+
+	var b bytes.Buffer
+	if err := t.Execute(&b, c); err != nil {
+		return nil, err
+	}
+
+	return b.Bytes(), nil
+}
+
+// Monitoring is the exporter Service of a database and its monitor
+var Monitoring = `
+apiVersion: v1
+kind: Service
+metadata:
+  name: {{ .Name }}-exporter
+  labels:
+    aerostation.io/exporter: {{ .Name }}
+spec:
+  clusterIP: None
+  selector:
+    app: aerospike-cluster
+    aerospike.com/cr: {{ .Name }}
+  ports:
+    - name: exporter
+      port: {{ .Port }}
+      targetPort: exporter
+{{- if .Kind }}
+---
+apiVersion: monitoring.coreos.com/v1
+kind: {{ .Kind }}
+metadata:
+  name: {{ .Name }}-exporter
+{{- with .Labels }}
+  labels:
+{{- range $key, $value := . }}
+    {{ printf "%q" $key }}: {{ printf "%q" $value }}
+{{- end }}
+{{- end }}
+spec:
+{{- if eq .Kind "ServiceMonitor" }}
+  selector:
+    matchLabels:
+      aerostation.io/exporter: {{ .Name }}
+  endpoints:
+{{- else }}
+  selector:
+    matchLabels:
+      app: aerospike-cluster
+      aerospike.com/cr: {{ .Name }}
+  podMetricsEndpoints:
+{{- end }}
+    - port: exporter
+      path: /metrics
+{{- with .Interval }}
+      interval: {{ . }}
+{{- end }}
+{{- end }}
+`
@@ -0,0 +1,97 @@
+package ako
+
+import (
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+func decode(t *testing.T, data []byte) []*unstructured.Unstructured {
+	g := NewWithT(t)
+	var objects []*unstructured.Unstructured
+	for _, doc := range strings.Split(string(data), "\n---\n") {
+		obj := &unstructured.Unstructured{}
+		g.Expect(yaml.Unmarshal([]byte(doc), &obj.Object)).To(Succeed())
+		objects = append(objects, obj)
+	}
+	return objects
+}
+
+func monitoredDatabase(monitoring *v1.MonitoringOptions) v1.AeroDatabaseSpec {
+	spec := v1.AeroDatabaseSpec{Name: "demo", Namespace: "aerospike", DatabaseType: v1.DatabaseTypeSSD}
+	spec.Options.Replicas = 2
+	spec.Options.Monitoring = monitoring
+	return spec
+}
+
+func TestDatabaseExporter(t *testing.T) {
+	g := NewWithT(t)
+
+	data, err := GetDatabase(monitoredDatabase(nil))
+	g.Expect(err).NotTo(HaveOccurred())
+	sidecars, _, _ := unstructured.NestedSlice(decode(t, data)[0].Object, "spec", "podSpec", "sidecars")
+	g.Expect(sidecars).To(BeEmpty())
+
+	data, err = GetDatabase(monitoredDatabase(&v1.MonitoringOptions{Enabled: true, Port: 9200}))
+	g.Expect(err).NotTo(HaveOccurred())
+	obj := decode(t, data)[0]
+	multiPodPerHost, _, _ := unstructured.NestedBool(obj.Object, "spec", "podSpec", "multiPodPerHost")
+	g.Expect(multiPodPerHost).To(BeTrue())
+	sidecars, _, _ = unstructured.NestedSlice(obj.Object, "spec", "podSpec", "sidecars")
+	g.Expect(sidecars).To(HaveLen(1))
+	exporter := sidecars[0].(map[string]interface{})
+	g.Expect(exporter["name"]).To(Equal(ExporterContainerName))
+	g.Expect(exporter["image"]).To(Equal(v1.DefaultExporterImage))
+	g.Expect(exporter["ports"]).To(ConsistOf(map[string]interface{}{"name": "exporter", "containerPort": float64(9200)}))
+	g.Expect(exporter["env"]).To(ContainElement(map[string]interface{}{"name": "BIND_PORT", "value": "9200"}))
+}
+
+func TestGetMonitoring(t *testing.T) {
+	g := NewWithT(t)
+
+	data, err := GetMonitoring(monitoredDatabase(&v1.MonitoringOptions{}), true)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(data).To(BeEmpty())
+
+	// the prometheus operator is missing, only the service is rendered
+	monitoring := &v1.MonitoringOptions{Enabled: true, Interval: "15s", Labels: map[string]string{"release": "prometheus"}}
+	data, err = GetMonitoring(monitoredDatabase(monitoring), false)
+	g.Expect(err).NotTo(HaveOccurred())
+	objects := decode(t, data)
+	g.Expect(objects).To(HaveLen(1))
+	g.Expect(objects[0].GetKind()).To(Equal("Service"))
+	g.Expect(objects[0].GetName()).To(Equal("demo-exporter"))
+
+	data, err = GetMonitoring(monitoredDatabase(monitoring), true)
+	g.Expect(err).NotTo(HaveOccurred())
+	objects = decode(t, data)
+	g.Expect(objects).To(HaveLen(2))
+	g.Expect(objects[1].GetKind()).To(Equal(v1.MonitorKindServiceMonitor))
+	g.Expect(objects[1].GetLabels()).To(Equal(map[string]string{"release": "prometheus"}))
+	selector, _, _ := unstructured.NestedStringMap(objects[1].Object, "spec", "selector", "matchLabels")
+	g.Expect(selector).To(Equal(objects[0].GetLabels()))
+	endpoints, _, _ := unstructured.NestedSlice(objects[1].Object, "spec", "endpoints")
+	g.Expect(endpoints).To(ConsistOf(map[string]interface{}{"port": "exporter", "path": "/metrics", "interval": "15s"}))
+
+	monitoring.MonitorKind = v1.MonitorKindPodMonitor
+	monitoring.Labels = nil
+	data, err = GetMonitoring(monitoredDatabase(monitoring), true)
+	g.Expect(err).NotTo(HaveOccurred())
+	objects = decode(t, data)
+	g.Expect(objects[1].GetKind()).To(Equal(v1.MonitorKindPodMonitor))
+	g.Expect(objects[1].GetLabels()).To(BeEmpty())
+	selector, _, _ = unstructured.NestedStringMap(objects[1].Object, "spec", "selector", "matchLabels")
+	g.Expect(selector).To(HaveKeyWithValue("aerospike.com/cr", "demo"))
+	endpoints, _, _ = unstructured.NestedSlice(objects[1].Object, "spec", "podMetricsEndpoints")
+	g.Expect(endpoints).To(HaveLen(1))
+
+	monitoring.MonitorKind = "Probe"
+	_, err = GetMonitoring(monitoredDatabase(monitoring), true)
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(MonitorCRD(v1.MonitorKindPodMonitor)).To(Equal("podmonitors.monitoring.coreos.com"))
+}
@@ -0,0 +1,70 @@
+package ako
+
+import (
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+// GetSSDDB renders an AerospikeCluster storing its data on persistent block devices
+func GetSSDDB(options v1.AeroDatabaseSpec) ([]byte, error) {
+	return render("ssd", SSDStorage, options)
+}
+
+var SSDStorage = `
+apiVersion: asdb.aerospike.com/v1beta1
+kind: AerospikeCluster
+metadata:
+  name: {{ .Name }}
+  namespace: {{ .Namespace }}
+spec:
+  size: {{ .Replicas }}
+  image: aerospike/aerospike-server-enterprise:5.6.0.7
+  podSpec:
+    multiPodPerHost: true
+{{- template "exporter" . }}
+  validationPolicy:
+    skipWorkDirValidate: false
+    skipXdrDlogFileValidate: true
+  storage:
+    filesystemVolumePolicy:
+      initMethod: deleteFiles
+      cascadeDelete: true
+    blockVolumePolicy:
+      initMethod: dd
+      cascadeDelete: true
+    volumes:
+      - name: workdir
+        source:
+          persistentVolume:
+            storageClass: {{ .StorageClass }}
+            volumeMode: Filesystem
+            size: 1Gi
+        aerospike:
+          path: /opt/aerospike
+      - name: ns
+        source:
+          persistentVolume:
+            storageClass: {{ .StorageClass }}
+            volumeMode: Block
+            size: 5Gi
+        aerospike:
+          path: /test/dev/xvdf
+      - name: aerospike-config-secret
+        source:
+          secret:
+            secretName: aerospike-secret
+        aerospike:
+          path: /etc/aerospike/secret
+  aerospikeConfig:
+    service:
+      feature-key-file: /etc/aerospike/secret/features.conf
+    security:
+      enable-security: false
+    namespaces:
+      - name: test
+        memory-size: 3000000000
+        replication-factor: 2
+        storage-engine:
+          type: device
+          devices:
+            - /test/dev/xvdf
+`
@@ -0,0 +1,58 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/cluster-api/util/secret"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Appliers caches the appliers of the workload clusters, the discovery of a cluster is shared by the reconciles instead
+// of being done again by each of them. The applier of a cluster is created again once its kubeconfig secret changes,
+// and dropped once the secret is deleted with the cluster.
+type Appliers struct {
+	// New returns the applier of a workload cluster, NewApplier if nil
+	New func(ctx context.Context, c client.Client, cluster client.ObjectKey) (*Applier, error)
+
+	mu       sync.Mutex
+	appliers map[client.ObjectKey]cachedApplier
+}
+
+type cachedApplier struct {
+	applier *Applier
+	// kubeconfig is the resource version of the kubeconfig secret the applier was created with
+	kubeconfig string
+}
+
+// Get returns the applier of a workload cluster, it takes a client to the management cluster!
+func (a *Appliers) Get(ctx context.Context, c client.Client, cluster client.ObjectKey) (*Applier, error) {
+	kubeconfig, err := secret.GetFromNamespacedName(ctx, c, cluster, secret.Kubeconfig)
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if apierrors.IsNotFound(err) {
+		delete(a.appliers, cluster)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if cached, ok := a.appliers[cluster]; ok && cached.kubeconfig == kubeconfig.ResourceVersion {
+		return cached.applier, nil
+	}
+
+	newApplier := a.New
+	if newApplier == nil {
+		newApplier = NewApplier
+	}
+	applier, err := newApplier(ctx, c, cluster)
+	if err != nil {
+		return nil, err
+	}
+	if a.appliers == nil {
+		a.appliers = map[client.ObjectKey]cachedApplier{}
+	}
+	a.appliers[cluster] = cachedApplier{applier: applier, kubeconfig: kubeconfig.ResourceVersion}
+	return applier, nil
+}
@@ -0,0 +1,51 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestAppliers(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	kubeconfig := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "edge-kubeconfig"}}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(kubeconfig).Build()
+	edge := client.ObjectKey{Namespace: "default", Name: "edge"}
+
+	created := 0
+	appliers := &Appliers{New: func(_ context.Context, c client.Client, cluster client.ObjectKey) (*Applier, error) {
+		created++
+		return &Applier{Client: c, Cluster: cluster}, nil
+	}}
+
+	first, err := appliers.Get(ctx, c, edge)
+	g.Expect(err).NotTo(HaveOccurred())
+	again, err := appliers.Get(ctx, c, edge)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(again).To(BeIdenticalTo(first))
+	g.Expect(created).To(Equal(1))
+
+	// rotated credentials
+	kubeconfig.Data = map[string][]byte{"value": []byte("rotated")}
+	g.Expect(c.Update(ctx, kubeconfig)).To(Succeed())
+	rotated, err := appliers.Get(ctx, c, edge)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rotated).NotTo(BeIdenticalTo(first))
+	g.Expect(created).To(Equal(2))
+
+	// the cluster is gone
+	g.Expect(c.Delete(ctx, kubeconfig)).To(Succeed())
+	_, err = appliers.Get(ctx, c, edge)
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	g.Expect(appliers.appliers).NotTo(HaveKey(edge))
+}
@@ -0,0 +1,527 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	"github.com/aerospike/aerostation/pkg/remote"
+	"github.com/pkg/errors"
+	"github.com/pmezard/go-difflib/difflib"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/discovery"
+	memory "k8s.io/client-go/discovery/cached"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/restmapper"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// FieldManager owns the fields aerostation applies to the workload clusters
+const FieldManager = "aerostation"
+
+// legacyFieldManagers applied the objects of earlier versions with force, their fields are taken over once
+var legacyFieldManagers = []string{"aerostation-controller"}
+
+const (
+	// PruneAnnotation set to "false" on a live object keeps it when it is dropped from its manifest
+	PruneAnnotation = "aerostation.io/prune"
+
+	// InventoryLabel is set on the inventory ConfigMaps, its value is the name of the inventory
+	InventoryLabel = "aerostation.io/inventory"
+
+	// inventoryKey is the key of the objects in the data of an inventory, one ObjectRef per line
+	inventoryKey = "objects"
+)
+
+// ObjectRef identifies an object of a workload cluster
+type ObjectRef struct {
+	Group     string
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func refOf(obj *unstructured.Unstructured) ObjectRef {
+	gvk := obj.GroupVersionKind()
+	return ObjectRef{Group: gvk.Group, Kind: gvk.Kind, Namespace: obj.GetNamespace(), Name: obj.GetName()}
+}
+
+// String is kind.group/namespace/name, the namespace is empty for the objects of the cluster scope
+func (r ObjectRef) String() string {
+	kind := r.Kind
+	if r.Group != "" {
+		kind += "." + r.Group
+	}
+	return kind + "/" + r.Namespace + "/" + r.Name
+}
+
+func parseRef(s string) (ObjectRef, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 3 {
+		return ObjectRef{}, fmt.Errorf("invalid object %q", s)
+	}
+	kind := strings.SplitN(parts[0], ".", 2)
+	ref := ObjectRef{Kind: kind[0], Namespace: parts[1], Name: parts[2]}
+	if len(kind) == 2 {
+		ref.Group = kind[1]
+	}
+	return ref, nil
+}
+
+// ApplyResult is the result of an object of a manifest, or of an object dropped from it
+type ApplyResult struct {
+	Object ObjectRef
+	Code   common.ResultCode
+	// Changed tells if the object was created or changed, or would be with a dry run
+	Changed bool
+	// Diff is the unified diff of the live object and the applied one, with a dry run
+	Diff    string
+	Message string
+}
+
+// ApplyOptions of Applier.Apply
+type ApplyOptions struct {
+	// Inventory names the objects of the manifest on the cluster, the objects of the last apply missing from the
+	// manifest are pruned. Nothing is pruned without an inventory.
+	Inventory string
+	// Namespace of the objects of the manifest, the namespaces of the manifest if empty
+	Namespace string
+	// DryRun diffs the manifest against the live objects, nothing is changed
+	DryRun bool
+	// Force takes over the fields owned by other field managers, a conflict fails the object otherwise
+	Force bool
+}
+
+// Resources reads and writes the objects of a workload cluster
+type Resources interface {
+	Get(ctx context.Context, ref ObjectRef, version string) (*unstructured.Unstructured, error)
+	Apply(ctx context.Context, obj *unstructured.Unstructured, options metav1.PatchOptions) (*unstructured.Unstructured, error)
+	Delete(ctx context.Context, ref ObjectRef, version string, options metav1.DeleteOptions) error
+	// List lists the objects of the group and the kind of ref in its namespace, in every namespace if empty
+	List(ctx context.Context, ref ObjectRef, version string, options metav1.ListOptions) (*unstructured.UnstructuredList, error)
+}
+
+// Applier applies manifests to a workload cluster with server side apply under FieldManager. The objects applied are
+// kept in an inventory ConfigMap per cluster and per manifest on the management cluster, in the namespace of the cluster.
+type Applier struct {
+	// Client of the management cluster
+	Client    client.Client
+	Cluster   client.ObjectKey
+	Resources Resources
+}
+
+// NewApplier returns an Applier of a workload cluster, it takes a client to the management cluster!
+func NewApplier(ctx context.Context, c client.Client, cluster client.ObjectKey) (*Applier, error) {
+	restcfg, err := remote.RESTConfig(ctx, c, cluster)
+	if err != nil {
+		return nil, err
+	}
+	resources, err := NewDynamicResources(restcfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Applier{Client: c, Cluster: cluster, Resources: resources}, nil
+}
+
+// Apply applies the objects of a manifest and prunes the objects of the inventory dropped from it. Every object is
+// tried, an error is returned if one of them failed and nothing is pruned then.
+func (a *Applier) Apply(ctx context.Context, data []byte, options ApplyOptions) ([]ApplyResult, error) {
+	objects, err := decodeObjects(data, options.Namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	inventory, err := a.inventory(ctx, options.Inventory)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ApplyResult
+	applied := map[ObjectRef]string{}
+	failed := 0
+	for _, obj := range objects {
+		result := a.apply(ctx, obj, options)
+		if result.Code == common.ResultCodeSyncFailed {
+			failed++
+		}
+		applied[result.Object] = obj.GroupVersionKind().Version
+		results = append(results, result)
+	}
+
+	// the objects of a failed apply are added to the inventory, they are pruned once the manifest applies
+	kept := map[ObjectRef]string{}
+	for ref, version := range applied {
+		kept[ref] = version
+	}
+	for ref, version := range inventory {
+		if _, ok := applied[ref]; ok {
+			continue
+		}
+		if failed > 0 {
+			kept[ref] = version
+			continue
+		}
+		result := a.prune(ctx, ref, version, options.DryRun)
+		if result.Code == common.ResultCodeSyncFailed {
+			kept[ref] = version
+			failed++
+		}
+		results = append(results, result)
+	}
+
+	if options.Inventory != "" && !options.DryRun {
+		if err := a.saveInventory(ctx, options.Inventory, kept); err != nil {
+			return results, err
+		}
+	}
+	if failed > 0 {
+		return results, fmt.Errorf("%d of the objects failed to sync to %s", failed, a.Cluster)
+	}
+	return results, nil
+}
+
+// decodeObjects decodes the objects of a manifest, in the namespace if it is not empty
+func decodeObjects(data []byte, namespace string) ([]*unstructured.Unstructured, error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var objects []*unstructured.Unstructured
+	chanObj, chanErr := DecodeYAML(ctx, data)
+	for done := false; !done; {
+		select {
+		case obj := <-chanObj:
+			if obj == nil {
+				done = true
+				break
+			}
+			if namespace != "" {
+				obj.SetNamespace(namespace)
+			}
+			objects = append(objects, obj)
+		case err := <-chanErr:
+			if err != nil {
+				return nil, errors.Wrap(err, "received error while decoding yaml")
+			}
+			done = true
+		}
+	}
+	return objects, nil
+}
+
+// Remove prunes the objects of an inventory, like an apply of an empty manifest, and deletes the inventory once they
+// are gone. The objects the prune skips are left on the cluster.
+func (a *Applier) Remove(ctx context.Context, inventory string) ([]ApplyResult, error) {
+	results, err := a.Apply(ctx, nil, ApplyOptions{Inventory: inventory})
+	if err != nil {
+		return results, err
+	}
+
+	cm := &corev1.ConfigMap{}
+	key := a.inventoryKey(inventory)
+	cm.Name, cm.Namespace = key.Name, key.Namespace
+	if err := a.Client.Delete(ctx, cm); err != nil && !apierrors.IsNotFound(err) {
+		return results, errors.Wrapf(err, "failed to delete the inventory %s", inventory)
+	}
+	return results, nil
+}
+
+func (a *Applier) apply(ctx context.Context, obj *unstructured.Unstructured, options ApplyOptions) ApplyResult {
+	result := ApplyResult{Object: refOf(obj), Code: common.ResultCodeSynced}
+	failed := func(err error) ApplyResult {
+		result.Code, result.Message = common.ResultCodeSyncFailed, err.Error()
+		return result
+	}
+
+	live, err := a.Resources.Get(ctx, result.Object, obj.GroupVersionKind().Version)
+	if apierrors.IsNotFound(err) {
+		live = nil
+	} else if err != nil {
+		return failed(err)
+	}
+
+	patchOptions := metav1.PatchOptions{FieldManager: FieldManager}
+	if options.DryRun {
+		patchOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	// the fields applied with force by the earlier versions are taken over, not the ones of other managers
+	force := options.Force || live != nil && managedBy(live, legacyFieldManagers...) && !managedBy(live, FieldManager)
+	if force {
+		patchOptions.Force = &force
+	}
+
+	applied, err := a.Resources.Apply(ctx, obj, patchOptions)
+	if err != nil {
+		if apierrors.IsConflict(err) {
+			return failed(fmt.Errorf("fields are owned by another manager, apply with force to take them: %w", err))
+		}
+		return failed(err)
+	}
+
+	from, err := diffYAML(live)
+	if err != nil {
+		return failed(err)
+	}
+	to, err := diffYAML(applied)
+	if err != nil {
+		return failed(err)
+	}
+	result.Changed = from != to
+	if options.DryRun && result.Changed {
+		result.Diff, err = difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(from),
+			B:        difflib.SplitLines(to),
+			FromFile: "live/" + result.Object.String(),
+			ToFile:   "applied/" + result.Object.String(),
+			Context:  3,
+		})
+		if err != nil {
+			return failed(err)
+		}
+	}
+	return result
+}
+
+// prune deletes an object dropped from its manifest. The namespaces, the custom resource definitions, the objects
+// annotated with PruneAnnotation "false" and the objects aerostation no longer manages are left alone.
+func (a *Applier) prune(ctx context.Context, ref ObjectRef, version string, dryRun bool) ApplyResult {
+	result := ApplyResult{Object: ref, Code: common.ResultCodePruned, Changed: true}
+
+	live, err := a.Resources.Get(ctx, ref, version)
+	if apierrors.IsNotFound(err) {
+		result.Changed, result.Message = false, "already deleted"
+		return result
+	}
+	if err != nil {
+		result.Code, result.Message = common.ResultCodeSyncFailed, err.Error()
+		return result
+	}
+
+	skipped := func(message string) ApplyResult {
+		result.Code, result.Changed, result.Message = common.ResultCodePruneSkipped, false, message
+		return result
+	}
+	switch {
+	case live.GetAnnotations()[PruneAnnotation] == "false":
+		return skipped("annotated " + PruneAnnotation + "=false")
+	case ref.Kind == "Namespace" && ref.Group == "",
+		ref.Kind == "CustomResourceDefinition" && ref.Group == "apiextensions.k8s.io":
+		return skipped("namespaces and custom resource definitions are not pruned")
+	case !managedBy(live, append([]string{FieldManager}, legacyFieldManagers...)...):
+		return skipped("not managed by " + FieldManager)
+	case dryRun:
+		return result
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	options := metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+		// the object is not deleted if it was replaced since it was read
+		Preconditions: &metav1.Preconditions{UID: func(uid types.UID) *types.UID { return &uid }(live.GetUID())},
+	}
+	if err := a.Resources.Delete(ctx, ref, version, options); err != nil && !apierrors.IsNotFound(err) {
+		result.Code, result.Changed, result.Message = common.ResultCodeSyncFailed, false, err.Error()
+	}
+	return result
+}
+
+func managedBy(obj *unstructured.Unstructured, managers ...string) bool {
+	for _, entry := range obj.GetManagedFields() {
+		for _, manager := range managers {
+			if entry.Manager == manager {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AddonInventory is the inventory of an add-on, add-on.namespace if it is applied to a namespace
+func AddonInventory(name, namespace string) string {
+	if namespace == "" {
+		return name
+	}
+	return name + "." + namespace
+}
+
+func (a *Applier) inventoryKey(name string) client.ObjectKey {
+	return client.ObjectKey{Namespace: a.Cluster.Namespace, Name: a.Cluster.Name + "-" + name}
+}
+
+// inventory returns the objects of the last apply of a manifest with their api version
+func (a *Applier) inventory(ctx context.Context, name string) (map[ObjectRef]string, error) {
+	objects := map[ObjectRef]string{}
+	if name == "" {
+		return objects, nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := a.Client.Get(ctx, a.inventoryKey(name), cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return objects, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read the inventory %s", name)
+	}
+	for _, line := range strings.Split(cm.Data[inventoryKey], "\n") {
+		if line == "" {
+			continue
+		}
+		// the api version the object was applied with follows the ref
+		fields := strings.Fields(line)
+		ref, err := parseRef(fields[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid inventory %s", name)
+		}
+		objects[ref] = ""
+		if len(fields) > 1 {
+			objects[ref] = schema.FromAPIVersionAndKind(fields[1], ref.Kind).Version
+		}
+	}
+	return objects, nil
+}
+
+func (a *Applier) saveInventory(ctx context.Context, name string, objects map[ObjectRef]string) error {
+	var lines []string
+	for ref, version := range objects {
+		apiVersion := version
+		if ref.Group != "" {
+			apiVersion = ref.Group + "/" + version
+		}
+		lines = append(lines, ref.String()+" "+apiVersion)
+	}
+	sort.Strings(lines)
+
+	key := a.inventoryKey(name)
+	cm := &corev1.ConfigMap{}
+	err := a.Client.Get(ctx, key, cm)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrapf(err, "failed to read the inventory %s", name)
+	}
+	exists := err == nil
+
+	cm.Name, cm.Namespace = key.Name, key.Namespace
+	cm.Labels = map[string]string{InventoryLabel: name, common.ClusterLabelName: a.Cluster.Name}
+	cm.Data = map[string]string{inventoryKey: strings.Join(lines, "\n")}
+	if exists {
+		return a.Client.Update(ctx, cm)
+	}
+	return a.Client.Create(ctx, cm)
+}
+
+// diffYAML is the YAML of the fields an apply can change, the status and the metadata of the server are left out
+func diffYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	u := obj.DeepCopy()
+	unstructured.RemoveNestedField(u.Object, "status")
+	for _, f := range []string{"managedFields", "resourceVersion", "generation", "uid", "creationTimestamp", "selfLink"} {
+		unstructured.RemoveNestedField(u.Object, "metadata", f)
+	}
+
+	data, err := yaml.Marshal(u.Object)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// dynamicResources are the Resources of a rest config, with the dynamic client
+type dynamicResources struct {
+	mapper  *restmapper.DeferredDiscoveryRESTMapper
+	dynamic dynamic.Interface
+}
+
+// NewDynamicResources returns the Resources of a cluster
+func NewDynamicResources(cfg *rest.Config) (Resources, error) {
+	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dyn, err := dynamic.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &dynamicResources{mapper: restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc)), dynamic: dyn}, nil
+}
+
+func (d *dynamicResources) resource(ref ObjectRef, version string) (dynamic.ResourceInterface, error) {
+	gk := schema.GroupKind{Group: ref.Group, Kind: ref.Kind}
+	var versions []string
+	if version != "" {
+		versions = append(versions, version)
+	}
+
+	mapping, err := d.mapper.RESTMapping(gk, versions...)
+	if meta.IsNoMatchError(err) {
+		// the kind may be a custom resource definition applied after the discovery
+		d.mapper.Reset()
+		mapping, err = d.mapper.RESTMapping(gk, versions...)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		// namespaced resources should specify the namespace
+		return d.dynamic.Resource(mapping.Resource).Namespace(ref.Namespace), nil
+	}
+	// for cluster-wide resources
+	return d.dynamic.Resource(mapping.Resource), nil
+}
+
+func (d *dynamicResources) Get(ctx context.Context, ref ObjectRef, version string) (*unstructured.Unstructured, error) {
+	dr, err := d.resource(ref, version)
+	if err != nil {
+		return nil, err
+	}
+	return dr.Get(ctx, ref.Name, metav1.GetOptions{})
+}
+
+func (d *dynamicResources) Apply(ctx context.Context, obj *unstructured.Unstructured, options metav1.PatchOptions) (*unstructured.Unstructured, error) {
+	dr, err := d.resource(refOf(obj), obj.GroupVersionKind().Version)
+	if err != nil {
+		return nil, err
+	}
+	data, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return dr.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, options)
+}
+
+func (d *dynamicResources) Delete(ctx context.Context, ref ObjectRef, version string, options metav1.DeleteOptions) error {
+	dr, err := d.resource(ref, version)
+	if err != nil {
+		return err
+	}
+	return dr.Delete(ctx, ref.Name, options)
+}
+
+func (d *dynamicResources) List(ctx context.Context, ref ObjectRef, version string, options metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	dr, err := d.resource(ref, version)
+	if err != nil {
+		return nil, err
+	}
+	return dr.List(ctx, options)
+}
+
+func logResults(cluster client.ObjectKey, results []ApplyResult) {
+	for _, result := range results {
+		if result.Code == common.ResultCodeSynced && !result.Changed {
+			continue
+		}
+		log.Printf("%s %s on %s %s\n", result.Object, result.Code, cluster, result.Message)
+	}
+}
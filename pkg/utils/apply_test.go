@@ -0,0 +1,244 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// fakeResources is a workload cluster, the fake clients do not support server side apply
+type fakeResources struct {
+	objects map[ObjectRef]*unstructured.Unstructured
+	// conflicts are owned by another manager, applying them without force fails
+	conflicts map[ObjectRef]bool
+	applied   []metav1.PatchOptions
+	deleted   []ObjectRef
+}
+
+func (f *fakeResources) Get(_ context.Context, ref ObjectRef, _ string) (*unstructured.Unstructured, error) {
+	obj, ok := f.objects[ref]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Group: ref.Group, Resource: ref.Kind}, ref.Name)
+	}
+	return obj.DeepCopy(), nil
+}
+
+func (f *fakeResources) Apply(_ context.Context, obj *unstructured.Unstructured, options metav1.PatchOptions) (*unstructured.Unstructured, error) {
+	f.applied = append(f.applied, options)
+	ref := refOf(obj)
+	if f.conflicts[ref] && (options.Force == nil || !*options.Force) {
+		return nil, apierrors.NewConflict(schema.GroupResource{Resource: ref.Kind}, ref.Name, nil)
+	}
+
+	applied := obj.DeepCopy()
+	applied.SetUID(types.UID("uid-" + ref.Name))
+	applied.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: options.FieldManager, Operation: metav1.ManagedFieldsOperationApply}})
+	if len(options.DryRun) == 0 {
+		f.objects[ref] = applied
+	}
+	return applied.DeepCopy(), nil
+}
+
+func (f *fakeResources) Delete(_ context.Context, ref ObjectRef, _ string, _ metav1.DeleteOptions) error {
+	f.deleted = append(f.deleted, ref)
+	delete(f.objects, ref)
+	return nil
+}
+
+func (f *fakeResources) List(_ context.Context, ref ObjectRef, _ string, _ metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	list := &unstructured.UnstructuredList{}
+	for r, obj := range f.objects {
+		if r.Group == ref.Group && r.Kind == ref.Kind && (ref.Namespace == "" || r.Namespace == ref.Namespace) {
+			list.Items = append(list.Items, *obj.DeepCopy())
+		}
+	}
+	return list, nil
+}
+
+func newApplier(t *testing.T) (*Applier, *fakeResources) {
+	scheme := runtime.NewScheme()
+	NewWithT(t).Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+	resources := &fakeResources{objects: map[ObjectRef]*unstructured.Unstructured{}, conflicts: map[ObjectRef]bool{}}
+	return &Applier{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).Build(),
+		Cluster:   client.ObjectKey{Namespace: "default", Name: "edge"},
+		Resources: resources,
+	}, resources
+}
+
+const (
+	configMapA = `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+  namespace: ns
+data:
+  key: one
+`
+	clusterRoleB = `apiVersion: rbac.authorization.k8s.io/v1
+kind: ClusterRole
+metadata:
+  name: b
+`
+	manifest = configMapA + "---\n" + clusterRoleB
+)
+
+var (
+	refA = ObjectRef{Kind: "ConfigMap", Namespace: "ns", Name: "a"}
+	refB = ObjectRef{Group: "rbac.authorization.k8s.io", Kind: "ClusterRole", Name: "b"}
+)
+
+func codes(results []ApplyResult) map[ObjectRef]common.ResultCode {
+	result := map[ObjectRef]common.ResultCode{}
+	for _, r := range results {
+		result[r.Object] = r.Code
+	}
+	return result
+}
+
+func TestApplyPrune(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	results, err := a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodeSynced, refB: common.ResultCodeSynced}))
+	g.Expect(results[0].Changed).To(BeTrue())
+	g.Expect(resources.applied[0].FieldManager).To(Equal(FieldManager))
+	g.Expect(resources.applied[0].Force).To(BeNil())
+
+	cm := &corev1.ConfigMap{}
+	g.Expect(a.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "edge-addon"}, cm)).To(Succeed())
+	g.Expect(cm.Labels).To(HaveKeyWithValue(InventoryLabel, "addon"))
+	g.Expect(cm.Data[inventoryKey]).To(Equal("ClusterRole.rbac.authorization.k8s.io//b rbac.authorization.k8s.io/v1\nConfigMap/ns/a v1"))
+
+	// unchanged
+	results, err = a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(results[0].Changed).To(BeFalse())
+
+	// b is dropped from the manifest
+	results, err = a.Apply(ctx, []byte(configMapA), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodeSynced, refB: common.ResultCodePruned}))
+	g.Expect(resources.deleted).To(Equal([]ObjectRef{refB}))
+	g.Expect(a.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "edge-addon"}, cm)).To(Succeed())
+	g.Expect(cm.Data[inventoryKey]).To(Equal("ConfigMap/ns/a v1"))
+}
+
+func TestApplyPruneSkipped(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	_, err := a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// a is kept by its annotation, b was taken over by another manager
+	resources.objects[refA].SetAnnotations(map[string]string{PruneAnnotation: "false"})
+	resources.objects[refB].SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+
+	results, err := a.Apply(ctx, []byte("---\n"), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodePruneSkipped, refB: common.ResultCodePruneSkipped}))
+	g.Expect(resources.deleted).To(BeEmpty())
+
+	// the skipped objects leave the inventory
+	cm := &corev1.ConfigMap{}
+	g.Expect(a.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "edge-addon"}, cm)).To(Succeed())
+	g.Expect(cm.Data[inventoryKey]).To(BeEmpty())
+}
+
+func TestApplyDryRun(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	_, err := a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	changed := []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+  namespace: ns
+data:
+  key: two
+`)
+	results, err := a.Apply(ctx, changed, ApplyOptions{Inventory: "addon", DryRun: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodeSynced, refB: common.ResultCodePruned}))
+	g.Expect(results[0].Changed).To(BeTrue())
+	g.Expect(results[0].Diff).To(ContainSubstring("-  key: one\n+  key: two\n"))
+	g.Expect(resources.applied[len(resources.applied)-1].DryRun).To(Equal([]string{metav1.DryRunAll}))
+
+	// nothing changed
+	g.Expect(resources.deleted).To(BeEmpty())
+	g.Expect(resources.objects[refA].Object["data"]).To(Equal(map[string]interface{}{"key": "one"}))
+	cm := &corev1.ConfigMap{}
+	g.Expect(a.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "edge-addon"}, cm)).To(Succeed())
+	g.Expect(cm.Data[inventoryKey]).To(ContainSubstring("ClusterRole"))
+}
+
+func TestApplyConflicts(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	_, err := a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// another manager owns a field of a, nothing is pruned while a fails
+	resources.conflicts[refA] = true
+	results, err := a.Apply(ctx, []byte(configMapA), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).To(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodeSyncFailed}))
+	g.Expect(resources.deleted).To(BeEmpty())
+
+	results, err = a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon", Force: true})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodeSynced, refB: common.ResultCodeSynced}))
+
+	// the objects of the earlier versions are taken over with force
+	a, resources = newApplier(t)
+	resources.conflicts[refA] = true
+	resources.objects[refA] = &unstructured.Unstructured{}
+	resources.objects[refA].SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "aerostation-controller"}})
+	_, err = a.Apply(ctx, []byte(manifest), ApplyOptions{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(*resources.applied[0].Force).To(BeTrue())
+}
+
+func TestApplyRemove(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	_, err := a.Apply(ctx, []byte(manifest), ApplyOptions{Inventory: "addon"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	results, err := a.Remove(ctx, "addon")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(codes(results)).To(Equal(map[ObjectRef]common.ResultCode{refA: common.ResultCodePruned, refB: common.ResultCodePruned}))
+	g.Expect(resources.objects).To(BeEmpty())
+	err = a.Client.Get(ctx, client.ObjectKey{Namespace: "default", Name: "edge-addon"}, &corev1.ConfigMap{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+	// removing again is a no-op
+	results, err = a.Remove(ctx, "addon")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(results).To(BeEmpty())
+}
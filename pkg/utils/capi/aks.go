@@ -0,0 +1,215 @@
+package capi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capiexp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CAPZ is not a dependency of aerostation, its objects are built unstructured
+const (
+	capzAPIVersion = infrastructureAPIVersion
+
+	aksControlPlaneKind = "AzureManagedControlPlane"
+	aksClusterKind      = "AzureManagedCluster"
+	aksMachinePoolKind  = "AzureManagedMachinePool"
+	azureIdentityKind   = "AzureClusterIdentity"
+
+	// aks agent pool names are lowercase alphanumeric and at most 12 characters
+	aksAgentPoolSuffix    = "pool0"
+	aksAgentPoolMaxLength = 12
+	aksSystemPoolMode     = "System"
+	aksOSDiskSizeGB       = 128
+)
+
+// getAgentPoolName is the name of the agent pool in AKS, it is only unique within the AKS cluster
+func getAgentPoolName(aks *v1.ClusterOptions) string {
+	prefix := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			return r
+		}
+		return -1
+	}, strings.ToLower(aks.Name))
+
+	if limit := aksAgentPoolMaxLength - len(aksAgentPoolSuffix); len(prefix) > limit {
+		prefix = prefix[:limit]
+	}
+
+	return prefix + aksAgentPoolSuffix
+}
+
+// getAKSMachinePoolName is the name of the MachinePool and the AzureManagedMachinePool of the agent pool, the short
+// names of the agent pools of two clusters may be the same
+func getAKSMachinePoolName(aks *v1.ClusterOptions) string {
+	return getDefaultName(aks) + "-" + aksAgentPoolSuffix
+}
+
+// ValidateAKSOptions returns an error if the options can not describe an AKS cluster
+func ValidateAKSOptions(aksOptions *v1.ClusterOptions) error {
+	aks := aksOptions.AKSOptions
+	if aks == nil {
+		return errors.New("aksOptions are required for provider aks")
+	}
+
+	required := []struct{ field, value string }{
+		{"location", aks.Location},
+		{"vmSize", aks.VMSize},
+		{"resourceGroup", aks.ResourceGroup},
+		{"subscriptionID", aks.SubscriptionID},
+		{"identityRef", aks.IdentityRef.Name},
+	}
+
+	missing := []string{}
+	for _, r := range required {
+		if r.value == "" {
+			missing = append(missing, r.field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("aksOptions are missing %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func ApplyAks(kubeClient client.Client, aksOptions *v1.ClusterOptions, config *rest.Config) error {
+	if err := ValidateAKSOptions(aksOptions); err != nil {
+		return err
+	}
+
+	cluster := getAKSCapiCluster(aksOptions)
+	log.Println("[INFO] creating Cluster")
+	if err := utils.ApplyObject(context.Background(), cluster, config); err != nil {
+		log.Print("Failed to create cluster: ", err)
+		return err
+	}
+
+	azureManagedControlPlane := getAzureManagedControlPlane(aksOptions)
+	log.Println("[INFO] creating AzureManagedControlPlane")
+	if err := utils.ApplyObject(context.Background(), azureManagedControlPlane, config); err != nil {
+		log.Print("Failed to create AzureManagedControlPlane: ", err)
+		return err
+	}
+
+	azureManagedCluster := getAzureManagedCluster(aksOptions)
+	log.Println("[INFO] creating AzureManagedCluster")
+	if err := utils.ApplyObject(context.Background(), azureManagedCluster, config); err != nil {
+		log.Print("Failed to create AzureManagedCluster: ", err)
+		return err
+	}
+
+	machinePool := getAKSMachinePool(aksOptions)
+	log.Println("[INFO] creating MachinePool")
+	if err := utils.ApplyObject(context.Background(), machinePool, config); err != nil {
+		log.Print("Failed to create MachinePool: ", err)
+		return err
+	}
+
+	azureManagedMachinePool := getAzureManagedMachinePool(aksOptions)
+	log.Println("[INFO] creating AzureManagedMachinePool")
+	if err := utils.ApplyObject(context.Background(), azureManagedMachinePool, config); err != nil {
+		log.Print("Failed to create AzureManagedMachinePool: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// getAKSCapiCluster return a CAPI Cluster object backed by an AKS managed cluster
+func getAKSCapiCluster(createOpt *v1.ClusterOptions) *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       clusterKind,
+			APIVersion: capiv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getDefaultName(createOpt),
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: capiv1beta1.ClusterSpec{
+			ClusterNetwork: &capiv1beta1.ClusterNetwork{
+				Services: &capiv1beta1.NetworkRanges{
+					CIDRBlocks: []string{"192.168.0.0/16"},
+				},
+			},
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind:       aksControlPlaneKind,
+				Namespace:  metav1.NamespaceDefault,
+				Name:       getControlplaneName(createOpt),
+				APIVersion: capzAPIVersion,
+			},
+			InfrastructureRef: &corev1.ObjectReference{
+				Kind:       aksClusterKind,
+				Namespace:  metav1.NamespaceDefault,
+				Name:       getDefaultName(createOpt),
+				APIVersion: capzAPIVersion,
+			},
+		},
+	}
+}
+
+// getAzureManagedControlPlane returns CAPZ AzureManagedControlPlane object
+func getAzureManagedControlPlane(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	aks := createOpts.AKSOptions
+
+	identityNamespace := aks.IdentityRef.Namespace
+	if identityNamespace == "" {
+		identityNamespace = metav1.NamespaceDefault
+	}
+
+	obj := newUnstructured(capzAPIVersion, aksControlPlaneKind, getControlplaneName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{
+		"version":           createOpts.KubeVersion,
+		"location":          aks.Location,
+		"resourceGroupName": aks.ResourceGroup,
+		"subscriptionID":    aks.SubscriptionID,
+		"sshPublicKey":      aks.SSHPublicKey,
+		"identityRef": map[string]interface{}{
+			"apiVersion": capzAPIVersion,
+			"kind":       azureIdentityKind,
+			"name":       aks.IdentityRef.Name,
+			"namespace":  identityNamespace,
+		},
+	}
+
+	return obj
+}
+
+// getAzureManagedCluster returns CAPZ AzureManagedCluster object, it only carries the control plane endpoint
+func getAzureManagedCluster(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	obj := newUnstructured(capzAPIVersion, aksClusterKind, getDefaultName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{}
+
+	return obj
+}
+
+// getAKSMachinePool returns the CAPI MachinePool of the AKS system node pool
+func getAKSMachinePool(createOpts *v1.ClusterOptions) *capiexp.MachinePool {
+	return getMachinePool(createOpts, getAKSMachinePoolName(createOpts), aksMachinePoolKind)
+}
+
+// getAzureManagedMachinePool returns CAPZ AzureManagedMachinePool object
+func getAzureManagedMachinePool(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	obj := newUnstructured(capzAPIVersion, aksMachinePoolKind, getAKSMachinePoolName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{
+		"name":         getAgentPoolName(createOpts),
+		"mode":         aksSystemPoolMode,
+		"sku":          createOpts.AKSOptions.VMSize,
+		"osDiskSizeGB": int64(aksOSDiskSizeGB),
+	}
+
+	return obj
+}
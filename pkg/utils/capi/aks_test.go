@@ -0,0 +1,108 @@
+package capi
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func aksClusterOptions() *v1.ClusterOptions {
+	return &v1.ClusterOptions{
+		Name:        "demo-cluster",
+		Provider:    "aks",
+		KubeVersion: "v1.21.2",
+		Replicas:    3,
+		AKSOptions: &v1.AKSOptions{
+			Location:       "eastus",
+			VMSize:         "Standard_D2s_v3",
+			ResourceGroup:  "aerostation",
+			SubscriptionID: "00000000-0000-0000-0000-000000000000",
+			IdentityRef:    v1.NamespacedName{Name: "cluster-identity"},
+			SSHPublicKey:   "c3NoLXJzYSBBQUFB",
+		},
+	}
+}
+
+func TestValidateAKSOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ValidateAKSOptions(aksClusterOptions())).To(Succeed())
+
+	opts := aksClusterOptions()
+	opts.AKSOptions = nil
+	g.Expect(ValidateAKSOptions(opts)).To(MatchError("aksOptions are required for provider aks"))
+
+	opts = aksClusterOptions()
+	opts.AKSOptions.Location = ""
+	opts.AKSOptions.IdentityRef.Name = ""
+	g.Expect(ValidateAKSOptions(opts)).To(MatchError("aksOptions are missing location, identityRef"))
+}
+
+func TestGetAgentPoolName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(getAgentPoolName(&v1.ClusterOptions{Name: "demo"})).To(Equal("demopool0"))
+	g.Expect(getAgentPoolName(&v1.ClusterOptions{Name: "Demo-Cluster-1"})).To(Equal("democlupool0"))
+
+	// the objects of two clusters whose agent pools have the same name don't collide
+	a, b := &v1.ClusterOptions{Name: "demo-cluster-1"}, &v1.ClusterOptions{Name: "demo-cluster-2"}
+	g.Expect(getAgentPoolName(a)).To(Equal(getAgentPoolName(b)))
+	g.Expect(getAKSMachinePoolName(a)).NotTo(Equal(getAKSMachinePoolName(b)))
+}
+
+func TestGetAKSCapiCluster(t *testing.T) {
+	g := NewWithT(t)
+
+	cluster := getAKSCapiCluster(aksClusterOptions())
+
+	g.Expect(cluster.Name).To(Equal("demo-cluster"))
+	g.Expect(cluster.Spec.ControlPlaneRef.Kind).To(Equal("AzureManagedControlPlane"))
+	g.Expect(cluster.Spec.ControlPlaneRef.Name).To(Equal("demo-cluster-control-plane"))
+	g.Expect(cluster.Spec.ControlPlaneRef.APIVersion).To(Equal("infrastructure.cluster.x-k8s.io/v1beta1"))
+	g.Expect(cluster.Spec.InfrastructureRef.Kind).To(Equal("AzureManagedCluster"))
+	g.Expect(cluster.Spec.InfrastructureRef.Name).To(Equal("demo-cluster"))
+}
+
+func TestGetAzureManagedControlPlane(t *testing.T) {
+	g := NewWithT(t)
+
+	obj := getAzureManagedControlPlane(aksClusterOptions())
+
+	g.Expect(obj.GetKind()).To(Equal("AzureManagedControlPlane"))
+	g.Expect(obj.GetName()).To(Equal("demo-cluster-control-plane"))
+	g.Expect(nestedString(obj, "spec", "version")).To(Equal("v1.21.2"))
+	g.Expect(nestedString(obj, "spec", "location")).To(Equal("eastus"))
+	g.Expect(nestedString(obj, "spec", "resourceGroupName")).To(Equal("aerostation"))
+	g.Expect(nestedString(obj, "spec", "subscriptionID")).To(Equal("00000000-0000-0000-0000-000000000000"))
+	g.Expect(nestedString(obj, "spec", "sshPublicKey")).To(Equal("c3NoLXJzYSBBQUFB"))
+	g.Expect(nestedString(obj, "spec", "identityRef", "kind")).To(Equal("AzureClusterIdentity"))
+	g.Expect(nestedString(obj, "spec", "identityRef", "name")).To(Equal("cluster-identity"))
+	g.Expect(nestedString(obj, "spec", "identityRef", "namespace")).To(Equal("default"))
+}
+
+func TestGetAKSMachinePools(t *testing.T) {
+	g := NewWithT(t)
+
+	opts := aksClusterOptions()
+	machinePool := getAKSMachinePool(opts)
+	azurePool := getAzureManagedMachinePool(opts)
+
+	g.Expect(machinePool.Spec.ClusterName).To(Equal("demo-cluster"))
+	g.Expect(*machinePool.Spec.Replicas).To(Equal(int32(3)))
+	g.Expect(*machinePool.Spec.Template.Spec.Version).To(Equal("v1.21.2"))
+	g.Expect(machinePool.Spec.Template.Spec.InfrastructureRef.Kind).To(Equal(azurePool.GetKind()))
+	g.Expect(machinePool.Spec.Template.Spec.InfrastructureRef.Name).To(Equal(azurePool.GetName()))
+	g.Expect(machinePool.Name).To(Equal("demo-cluster-pool0"))
+
+	g.Expect(nestedString(azurePool, "spec", "name")).To(Equal("democlupool0"))
+	g.Expect(nestedString(azurePool, "spec", "mode")).To(Equal("System"))
+	g.Expect(nestedString(azurePool, "spec", "sku")).To(Equal("Standard_D2s_v3"))
+}
+
+func nestedString(obj *unstructured.Unstructured, fields ...string) string {
+	value, _, _ := unstructured.NestedString(obj.Object, fields...)
+	return value
+}
@@ -9,12 +9,14 @@ import (
 	"github.com/aerospike/aerostation/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/pointer"
 	capiawsv1beta1 "sigs.k8s.io/cluster-api-provider-aws/api/v1beta1"
 	awsbootstrap "sigs.k8s.io/cluster-api-provider-aws/bootstrap/eks/api/v1beta1"
 	ekscontrolplanev1beta1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
 	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capiexp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -28,6 +30,10 @@ const (
 
 	awsMachineTemplateKind = "AWSMachineTemplate"
 	eksConfigTemplateKind  = "EKSConfigTemplate"
+
+	// api version of the infrastructure providers that are not a dependency of aerostation
+	infrastructureAPIVersion = "infrastructure.cluster.x-k8s.io/v1beta1"
+	machinePoolKind          = "MachinePool"
 )
 
 func getControlplaneName(eks *v1.ClusterOptions) string {
@@ -43,6 +49,15 @@ func getMachineDeploymentName(eks *v1.ClusterOptions) string {
 }
 
 func ApplyEks(kubeClient client.Client, eksOptions *v1.ClusterOptions, config *rest.Config) error {
+	if err := ApplyEksControlPlane(kubeClient, eksOptions, config); err != nil {
+		return err
+	}
+
+	return ApplyEksWorkers(kubeClient, eksOptions, config)
+}
+
+// ApplyEksControlPlane applies the cluster and the AWSManagedControlPlane
+func ApplyEksControlPlane(kubeClient client.Client, eksOptions *v1.ClusterOptions, config *rest.Config) error {
 	cluster := getCapiCluster(eksOptions)
 	log.Println("[INFO] creating Cluster")
 	if err := utils.ApplyObject(context.Background(), cluster, config); err != nil {
@@ -57,6 +72,11 @@ func ApplyEks(kubeClient client.Client, eksOptions *v1.ClusterOptions, config *r
 		return err
 	}
 
+	return nil
+}
+
+// ApplyEksWorkers applies the MachineDeployment of the workers with its templates
+func ApplyEksWorkers(kubeClient client.Client, eksOptions *v1.ClusterOptions, config *rest.Config) error {
 	machineDeployment := getMachineDeployment(eksOptions)
 	log.Println("[INFO] creating MachineDeployment")
 	if err := utils.ApplyObject(context.Background(), machineDeployment, config); err != nil {
@@ -208,3 +228,46 @@ func getEksConfigTemplate(createOpts *v1.ClusterOptions) *awsbootstrap.EKSConfig
 		},
 	}
 }
+
+// getMachinePool returns a CAPI MachinePool for a managed node pool, the cloud bootstraps the nodes itself
+func getMachinePool(createOpts *v1.ClusterOptions, name, infrastructureKind string) *capiexp.MachinePool {
+	return &capiexp.MachinePool{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       machinePoolKind,
+			APIVersion: capiexp.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: capiexp.MachinePoolSpec{
+			ClusterName: getDefaultName(createOpts),
+			Replicas:    pointer.Int32Ptr(createOpts.Replicas),
+			Template: capiv1beta1.MachineTemplateSpec{
+				Spec: capiv1beta1.MachineSpec{
+					Bootstrap: capiv1beta1.Bootstrap{
+						DataSecretName: pointer.StringPtr(""),
+					},
+					ClusterName: getDefaultName(createOpts),
+					InfrastructureRef: corev1.ObjectReference{
+						Kind:       infrastructureKind,
+						Name:       name,
+						APIVersion: infrastructureAPIVersion,
+					},
+					Version: pointer.StringPtr(createOpts.KubeVersion),
+				},
+			},
+		},
+	}
+}
+
+// newUnstructured returns an empty object of a provider that is not a dependency of aerostation
+func newUnstructured(apiVersion, kind, name string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(apiVersion)
+	obj.SetKind(kind)
+	obj.SetName(name)
+	obj.SetNamespace(metav1.NamespaceDefault)
+
+	return obj
+}
@@ -2,18 +2,10 @@ package capi
 
 import (
 	"context"
-	"io/ioutil"
 	"log"
-	"os"
-	"path"
 
-	aeroremote "github.com/aerospike/aerostation/pkg/remote"
 	corev1 "k8s.io/api/core/v1"
-	apierrors "k8s.io/apimachinery/pkg/api/errors"
 
-	"k8s.io/apimachinery/pkg/types"
-
-	"sigs.k8s.io/cluster-api/controllers/remote"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
@@ -30,6 +22,15 @@ import (
 )
 
 func ApplyDocker(kubeClient client.Client, manager *v1.AeroClusterManagerSpec, config *rest.Config) error {
+	if err := ApplyDockerControlPlane(kubeClient, manager, config); err != nil {
+		return err
+	}
+
+	return ApplyDockerWorkers(kubeClient, manager, config)
+}
+
+// ApplyDockerControlPlane applies the cluster and the KubeadmControlPlane with its machine template
+func ApplyDockerControlPlane(kubeClient client.Client, manager *v1.AeroClusterManagerSpec, config *rest.Config) error {
 	cluster := getCapiClusterDocker(&manager.ClusterOptions)
 
 	// debug(cluster)
@@ -71,6 +72,11 @@ func ApplyDocker(kubeClient client.Client, manager *v1.AeroClusterManagerSpec, c
 		return err
 	}
 
+	return nil
+}
+
+// ApplyDockerWorkers applies the MachineDeployment of the workers with its templates
+func ApplyDockerWorkers(kubeClient client.Client, manager *v1.AeroClusterManagerSpec, config *rest.Config) error {
 	mddockerMachineTemplate := getDockerMachineTemplate(manager, "-md-0")
 	// debug(mddockerMachineTemplate)
 
@@ -310,48 +316,3 @@ func debug(o interface{}) {
 	}
 	log.Printf("%s\n", string(y))
 }
-
-func ApplyCNI(ctx context.Context, c client.Client, tracker *remote.ClusterCacheTracker, clusterkey client.ObjectKey) error {
-	// see if a calico resource already exists on the aerostation deployed cluster, if so, assume we have already applied CNI
-	cniSA := corev1.ServiceAccount{}
-	thing := types.NamespacedName{
-		Namespace: "kube-system",
-		Name:      "calico-kube-controllers",
-	}
-
-	cli, err := tracker.GetClient(ctx, clusterkey)
-	if err != nil {
-		return err
-	}
-
-	err = cli.Get(ctx, thing, &cniSA)
-	if err == nil {
-		log.Println("calico SA found, so assuming CNI is applied")
-		return nil
-	}
-
-	if apierrors.IsNotFound(err) {
-		// apply CNI logic follows
-		wd, err := os.Getwd()
-		if err != nil {
-			return err
-		}
-		data, err := ioutil.ReadFile(path.Join(wd, "/manifests/calico.yaml"))
-		if err != nil {
-			return err
-		}
-
-		// use the client for the capi cluster, not the aerostation deployed cluster
-		restcfg, err := aeroremote.RESTConfig(ctx, c, clusterkey)
-		if err != nil {
-			return err
-		}
-
-		return utils.ForEachObjectInYAML(ctx, restcfg, data, "", utils.ApplyResource)
-	}
-
-	if err != nil {
-		log.Printf("error getting calico SA %s\n", err.Error())
-	}
-	return nil
-}
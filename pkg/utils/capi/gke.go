@@ -0,0 +1,195 @@
+package capi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	"github.com/aerospike/aerostation/pkg/utils"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	capiexp "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CAPG is not a dependency of aerostation, its objects are built unstructured
+const (
+	capgAPIVersion = infrastructureAPIVersion
+
+	gkeControlPlaneKind = "GCPManagedControlPlane"
+	gkeClusterKind      = "GCPManagedCluster"
+	gkeMachinePoolKind  = "GCPManagedMachinePool"
+
+	gkeDefaultNetwork   = "default"
+	gkeNodePoolSuffix   = "-pool0"
+	gkeNodePoolMinCount = 1
+)
+
+func getNodePoolName(gke *v1.ClusterOptions) string {
+	return fmt.Sprintf("%s%s", gke.Name, gkeNodePoolSuffix)
+}
+
+// ValidateGKEOptions returns an error if the options can not describe a GKE cluster
+func ValidateGKEOptions(gkeOptions *v1.ClusterOptions) error {
+	gke := gkeOptions.GKEOptions
+	if gke == nil {
+		return errors.New("gkeOptions are required for provider gke")
+	}
+
+	required := []struct{ field, value string }{
+		{"project", gke.Project},
+		{"region", gke.Region},
+		{"machineType", gke.MachineType},
+	}
+
+	missing := []string{}
+	for _, r := range required {
+		if r.value == "" {
+			missing = append(missing, r.field)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("gkeOptions are missing %s", strings.Join(missing, ", "))
+	}
+
+	return nil
+}
+
+func ApplyGke(kubeClient client.Client, gkeOptions *v1.ClusterOptions, config *rest.Config) error {
+	if err := ValidateGKEOptions(gkeOptions); err != nil {
+		return err
+	}
+
+	cluster := getGKECapiCluster(gkeOptions)
+	log.Println("[INFO] creating Cluster")
+	if err := utils.ApplyObject(context.Background(), cluster, config); err != nil {
+		log.Print("Failed to create cluster: ", err)
+		return err
+	}
+
+	gcpManagedCluster := getGCPManagedCluster(gkeOptions)
+	log.Println("[INFO] creating GCPManagedCluster")
+	if err := utils.ApplyObject(context.Background(), gcpManagedCluster, config); err != nil {
+		log.Print("Failed to create GCPManagedCluster: ", err)
+		return err
+	}
+
+	gcpManagedControlPlane := getGCPManagedControlPlane(gkeOptions)
+	log.Println("[INFO] creating GCPManagedControlPlane")
+	if err := utils.ApplyObject(context.Background(), gcpManagedControlPlane, config); err != nil {
+		log.Print("Failed to create GCPManagedControlPlane: ", err)
+		return err
+	}
+
+	machinePool := getGKEMachinePool(gkeOptions)
+	log.Println("[INFO] creating MachinePool")
+	if err := utils.ApplyObject(context.Background(), machinePool, config); err != nil {
+		log.Print("Failed to create MachinePool: ", err)
+		return err
+	}
+
+	gcpManagedMachinePool := getGCPManagedMachinePool(gkeOptions)
+	log.Println("[INFO] creating GCPManagedMachinePool")
+	if err := utils.ApplyObject(context.Background(), gcpManagedMachinePool, config); err != nil {
+		log.Print("Failed to create GCPManagedMachinePool: ", err)
+		return err
+	}
+
+	return nil
+}
+
+// getGKECapiCluster return a CAPI Cluster object backed by a GKE managed cluster
+func getGKECapiCluster(createOpt *v1.ClusterOptions) *capiv1beta1.Cluster {
+	return &capiv1beta1.Cluster{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       clusterKind,
+			APIVersion: capiv1beta1.GroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getDefaultName(createOpt),
+			Namespace: metav1.NamespaceDefault,
+		},
+		Spec: capiv1beta1.ClusterSpec{
+			ClusterNetwork: &capiv1beta1.ClusterNetwork{
+				Pods: &capiv1beta1.NetworkRanges{
+					CIDRBlocks: []string{"192.168.0.0/16"},
+				},
+			},
+			ControlPlaneRef: &corev1.ObjectReference{
+				Kind:       gkeControlPlaneKind,
+				Namespace:  metav1.NamespaceDefault,
+				Name:       getControlplaneName(createOpt),
+				APIVersion: capgAPIVersion,
+			},
+			InfrastructureRef: &corev1.ObjectReference{
+				Kind:       gkeClusterKind,
+				Namespace:  metav1.NamespaceDefault,
+				Name:       getDefaultName(createOpt),
+				APIVersion: capgAPIVersion,
+			},
+		},
+	}
+}
+
+// getGCPManagedCluster returns CAPG GCPManagedCluster object, it holds the project and network
+func getGCPManagedCluster(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	gke := createOpts.GKEOptions
+
+	network := gke.Network
+	if network == "" {
+		network = gkeDefaultNetwork
+	}
+
+	obj := newUnstructured(capgAPIVersion, gkeClusterKind, getDefaultName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{
+		"project": gke.Project,
+		"region":  gke.Region,
+		"network": map[string]interface{}{
+			"name": network,
+		},
+	}
+
+	return obj
+}
+
+// getGCPManagedControlPlane returns CAPG GCPManagedControlPlane object
+func getGCPManagedControlPlane(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	gke := createOpts.GKEOptions
+
+	obj := newUnstructured(capgAPIVersion, gkeControlPlaneKind, getControlplaneName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{
+		"clusterName":         getDefaultName(createOpts),
+		"project":             gke.Project,
+		"location":            gke.Region,
+		"controlPlaneVersion": createOpts.KubeVersion,
+	}
+
+	return obj
+}
+
+// getGKEMachinePool returns the CAPI MachinePool of the GKE node pool
+func getGKEMachinePool(createOpts *v1.ClusterOptions) *capiexp.MachinePool {
+	return getMachinePool(createOpts, getNodePoolName(createOpts), gkeMachinePoolKind)
+}
+
+// getGCPManagedMachinePool returns CAPG GCPManagedMachinePool object
+func getGCPManagedMachinePool(createOpts *v1.ClusterOptions) *unstructured.Unstructured {
+	obj := newUnstructured(capgAPIVersion, gkeMachinePoolKind, getNodePoolName(createOpts))
+	obj.Object["spec"] = map[string]interface{}{
+		"nodePoolName": getNodePoolName(createOpts),
+		"machineType":  createOpts.GKEOptions.MachineType,
+		"scaling": map[string]interface{}{
+			"minCount": int64(gkeNodePoolMinCount),
+			"maxCount": int64(createOpts.Replicas),
+		},
+	}
+
+	return obj
+}
@@ -0,0 +1,64 @@
+package capi
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+func gkeClusterOptions() *v1.ClusterOptions {
+	return &v1.ClusterOptions{
+		Name:        "demo-cluster",
+		Provider:    "gke",
+		KubeVersion: "v1.21.2",
+		Replicas:    3,
+		GKEOptions: &v1.GKEOptions{
+			Project:     "aerostation-dev",
+			Region:      "us-central1",
+			MachineType: "e2-standard-4",
+		},
+	}
+}
+
+func TestValidateGKEOptions(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(ValidateGKEOptions(gkeClusterOptions())).To(Succeed())
+
+	opts := gkeClusterOptions()
+	opts.GKEOptions = nil
+	g.Expect(ValidateGKEOptions(opts)).To(MatchError("gkeOptions are required for provider gke"))
+
+	opts = gkeClusterOptions()
+	opts.GKEOptions.Project = ""
+	g.Expect(ValidateGKEOptions(opts)).To(MatchError("gkeOptions are missing project"))
+}
+
+func TestGetGKEObjects(t *testing.T) {
+	g := NewWithT(t)
+
+	opts := gkeClusterOptions()
+
+	cluster := getGKECapiCluster(opts)
+	g.Expect(cluster.Spec.ControlPlaneRef.Kind).To(Equal("GCPManagedControlPlane"))
+	g.Expect(cluster.Spec.InfrastructureRef.Kind).To(Equal("GCPManagedCluster"))
+
+	managedCluster := getGCPManagedCluster(opts)
+	g.Expect(managedCluster.GetName()).To(Equal(cluster.Spec.InfrastructureRef.Name))
+	g.Expect(nestedString(managedCluster, "spec", "project")).To(Equal("aerostation-dev"))
+	g.Expect(nestedString(managedCluster, "spec", "region")).To(Equal("us-central1"))
+	g.Expect(nestedString(managedCluster, "spec", "network", "name")).To(Equal("default"))
+
+	controlPlane := getGCPManagedControlPlane(opts)
+	g.Expect(controlPlane.GetName()).To(Equal(cluster.Spec.ControlPlaneRef.Name))
+	g.Expect(nestedString(controlPlane, "spec", "location")).To(Equal("us-central1"))
+	g.Expect(nestedString(controlPlane, "spec", "controlPlaneVersion")).To(Equal("v1.21.2"))
+
+	machinePool := getGKEMachinePool(opts)
+	nodePool := getGCPManagedMachinePool(opts)
+	g.Expect(machinePool.Spec.Template.Spec.InfrastructureRef.Kind).To(Equal(nodePool.GetKind()))
+	g.Expect(machinePool.Spec.Template.Spec.InfrastructureRef.Name).To(Equal(nodePool.GetName()))
+	g.Expect(nestedString(nodePool, "spec", "machineType")).To(Equal("e2-standard-4"))
+}
@@ -0,0 +1,69 @@
+package capi
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+)
+
+// ErrProviderNotInstalled is returned by CheckProvider when the management cluster does not serve the kinds of the
+// infrastructure provider of a cluster
+var ErrProviderNotInstalled = errors.New("infrastructure provider is not installed")
+
+// providerKinds are the kinds of the providers whose objects are applied unstructured. CAPZ and CAPG are not
+// dependencies of aerostation, their types are not in the scheme and their CRDs are only known at runtime.
+var providerKinds = map[string]struct {
+	name  string
+	kinds []string
+}{
+	v1.ProviderAKS: {"CAPZ", []string{aksControlPlaneKind, aksClusterKind, aksMachinePoolKind}},
+	v1.ProviderGKE: {"CAPG", []string{gkeControlPlaneKind, gkeClusterKind, gkeMachinePoolKind}},
+}
+
+// CheckProvider returns ErrProviderNotInstalled if the infrastructure provider of an unstructured provider is missing
+// from the management cluster, the other providers are in the scheme and always pass
+func CheckProvider(config *rest.Config, provider string) error {
+	if _, ok := providerKinds[provider]; !ok {
+		return nil
+	}
+
+	dc, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		return err
+	}
+	resources, err := dc.ServerResourcesForGroupVersion(infrastructureAPIVersion)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	return checkProviderKinds(resources, provider)
+}
+
+// checkProviderKinds checks that the resources of the infrastructure group serve the kinds of a provider
+func checkProviderKinds(resources *metav1.APIResourceList, provider string) error {
+	p := providerKinds[provider]
+
+	served := map[string]bool{}
+	if resources != nil {
+		for _, r := range resources.APIResources {
+			served[r.Kind] = true
+		}
+	}
+	var missing []string
+	for _, kind := range p.kinds {
+		if !served[kind] {
+			missing = append(missing, kind)
+		}
+	}
+
+	if len(missing) > 0 {
+		return fmt.Errorf("%w: %s of provider %s is required, %s is not served", ErrProviderNotInstalled, p.name, provider,
+			strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,28 @@
+package capi
+
+import (
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+)
+
+func TestCheckProviderKinds(t *testing.T) {
+	g := NewWithT(t)
+
+	capz := &metav1.APIResourceList{APIResources: []metav1.APIResource{
+		{Kind: "AzureManagedControlPlane"}, {Kind: "AzureManagedCluster"}, {Kind: "AzureManagedMachinePool"},
+	}}
+	g.Expect(checkProviderKinds(capz, v1.ProviderAKS)).To(Succeed())
+
+	err := checkProviderKinds(capz, v1.ProviderGKE)
+	g.Expect(errors.Is(err, ErrProviderNotInstalled)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("CAPG of provider gke is required"))
+
+	// the infrastructure group is not served at all
+	err = checkProviderKinds(nil, v1.ProviderAKS)
+	g.Expect(errors.Is(err, ErrProviderNotInstalled)).To(BeTrue())
+}
@@ -0,0 +1,113 @@
+package capi
+
+import (
+	"context"
+	"fmt"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ekscontrolplanev1beta1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// ControlPlaneUpgraded reports whether the control plane runs the kubernetes version of the spec,
+// the message describes the progress of the upgrade. EKS does not report the version it runs, so
+// the control plane counts as upgraded once it finished an update that started after since.
+func ControlPlaneUpgraded(ctx context.Context, c client.Client, manager *v1.AeroClusterManagerSpec, since metav1.Time) (bool, string, error) {
+	target := manager.ClusterOptions.KubeVersion
+
+	switch manager.ClusterOptions.Provider {
+	case v1.ProviderDocker:
+		kcp := getKubeadmControlPlane(manager)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(kcp), kcp); err != nil {
+			return false, "", err
+		}
+		upgraded, message := kubeadmControlPlaneUpgraded(kcp, target)
+		return upgraded, message, nil
+	case v1.ProviderEKS:
+		cp := getAWSManagedControlPlane(&manager.ClusterOptions)
+		if err := c.Get(ctx, client.ObjectKeyFromObject(cp), cp); err != nil {
+			return false, "", err
+		}
+		upgraded, message := awsManagedControlPlaneUpgraded(cp, since)
+		return upgraded, message, nil
+	}
+
+	return false, "", fmt.Errorf("kubernetes upgrades are not supported for provider %q", manager.ClusterOptions.Provider)
+}
+
+func kubeadmControlPlaneUpgraded(kcp *controlplanev1.KubeadmControlPlane, target string) (bool, string) {
+	if kcp.Status.ObservedGeneration < kcp.Generation {
+		return false, "waiting for the KubeadmControlPlane to observe " + target
+	}
+
+	replicas := kcp.Status.Replicas
+	if kcp.Spec.Replicas != nil {
+		replicas = *kcp.Spec.Replicas
+	}
+
+	// status.version is the lowest version of all control plane machines
+	if kcp.Status.Version == nil || *kcp.Status.Version != target ||
+		kcp.Status.UpdatedReplicas != replicas || kcp.Status.Replicas != replicas || kcp.Status.ReadyReplicas != replicas {
+		return false, fmt.Sprintf("%d/%d control plane machines upgraded to %s", kcp.Status.UpdatedReplicas, replicas, target)
+	}
+
+	return true, ""
+}
+
+func awsManagedControlPlaneUpgraded(cp *ekscontrolplanev1beta1.AWSManagedControlPlane, since metav1.Time) (bool, string) {
+	updating := conditions.Get(cp, ekscontrolplanev1beta1.EKSControlPlaneUpdatingCondition)
+	if updating == nil || updating.LastTransitionTime.Before(&since) {
+		return false, "waiting for the EKS control plane update to start"
+	}
+
+	if updating.Status != corev1.ConditionFalse || !cp.Status.Ready {
+		return false, "EKS control plane is updating"
+	}
+
+	return true, ""
+}
+
+// WorkersUpgraded reports whether all machines of the workers MachineDeployment run the kubernetes version of the spec
+func WorkersUpgraded(ctx context.Context, c client.Client, manager *v1.AeroClusterManagerSpec) (bool, string, error) {
+	var md *capiv1beta1.MachineDeployment
+
+	switch manager.ClusterOptions.Provider {
+	case v1.ProviderDocker:
+		md = getMachineDeploymentDocker(manager)
+	case v1.ProviderEKS:
+		md = getMachineDeployment(&manager.ClusterOptions)
+	default:
+		return false, "", fmt.Errorf("kubernetes upgrades are not supported for provider %q", manager.ClusterOptions.Provider)
+	}
+
+	if err := c.Get(ctx, client.ObjectKeyFromObject(md), md); err != nil {
+		return false, "", err
+	}
+
+	upgraded, message := machineDeploymentUpgraded(md, manager.ClusterOptions.KubeVersion)
+	return upgraded, message, nil
+}
+
+func machineDeploymentUpgraded(md *capiv1beta1.MachineDeployment, target string) (bool, string) {
+	if md.Spec.Template.Spec.Version == nil || *md.Spec.Template.Spec.Version != target ||
+		md.Status.ObservedGeneration < md.Generation {
+		return false, "waiting for the MachineDeployment to observe " + target
+	}
+
+	replicas := md.Status.Replicas
+	if md.Spec.Replicas != nil {
+		replicas = *md.Spec.Replicas
+	}
+
+	// old machines are only counted in status.replicas until they are deleted
+	if md.Status.UpdatedReplicas != replicas || md.Status.Replicas != replicas || md.Status.ReadyReplicas != replicas {
+		return false, fmt.Sprintf("%d/%d workers upgraded to %s", md.Status.UpdatedReplicas, replicas, target)
+	}
+
+	return true, ""
+}
@@ -0,0 +1,117 @@
+package capi
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+
+	v1 "github.com/aerospike/aerostation/api/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/pointer"
+	ekscontrolplanev1beta1 "sigs.k8s.io/cluster-api-provider-aws/controlplane/eks/api/v1beta1"
+	capiv1beta1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	controlplanev1 "sigs.k8s.io/cluster-api/controlplane/kubeadm/api/v1beta1"
+)
+
+func TestKubeadmControlPlaneUpgraded(t *testing.T) {
+	g := NewWithT(t)
+
+	kcp := &controlplanev1.KubeadmControlPlane{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+		Spec:       controlplanev1.KubeadmControlPlaneSpec{Replicas: pointer.Int32Ptr(3), Version: "v1.22.0"},
+		Status: controlplanev1.KubeadmControlPlaneStatus{
+			ObservedGeneration: 1,
+			Version:            pointer.StringPtr("v1.21.2"),
+			Replicas:           3,
+			ReadyReplicas:      3,
+		},
+	}
+
+	upgraded, message := kubeadmControlPlaneUpgraded(kcp, "v1.22.0")
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(message).To(Equal("waiting for the KubeadmControlPlane to observe v1.22.0"))
+
+	// rolling, a new machine is surging
+	kcp.Status.ObservedGeneration = 2
+	kcp.Status.Replicas = 4
+	kcp.Status.UpdatedReplicas = 1
+	upgraded, message = kubeadmControlPlaneUpgraded(kcp, "v1.22.0")
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(message).To(Equal("1/3 control plane machines upgraded to v1.22.0"))
+
+	kcp.Status.Version = pointer.StringPtr("v1.22.0")
+	kcp.Status.Replicas = 3
+	kcp.Status.UpdatedReplicas = 3
+	upgraded, _ = kubeadmControlPlaneUpgraded(kcp, "v1.22.0")
+	g.Expect(upgraded).To(BeTrue())
+}
+
+func TestAWSManagedControlPlaneUpgraded(t *testing.T) {
+	g := NewWithT(t)
+
+	start := metav1.NewTime(time.Now().Truncate(time.Second))
+	cp := &ekscontrolplanev1beta1.AWSManagedControlPlane{
+		Status: ekscontrolplanev1beta1.AWSManagedControlPlaneStatus{
+			Ready: true,
+			Conditions: capiv1beta1.Conditions{{
+				Type:               ekscontrolplanev1beta1.EKSControlPlaneUpdatingCondition,
+				Status:             corev1.ConditionFalse,
+				LastTransitionTime: metav1.NewTime(start.Add(-time.Hour)),
+			}},
+		},
+	}
+
+	// the update that finished an hour ago is not this one
+	upgraded, _ := awsManagedControlPlaneUpgraded(cp, start)
+	g.Expect(upgraded).To(BeFalse())
+
+	cp.Status.Conditions[0].Status = corev1.ConditionTrue
+	cp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(start.Add(time.Minute))
+	upgraded, message := awsManagedControlPlaneUpgraded(cp, start)
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(message).To(Equal("EKS control plane is updating"))
+
+	cp.Status.Conditions[0].Status = corev1.ConditionFalse
+	cp.Status.Conditions[0].LastTransitionTime = metav1.NewTime(start.Add(20 * time.Minute))
+	upgraded, _ = awsManagedControlPlaneUpgraded(cp, start)
+	g.Expect(upgraded).To(BeTrue())
+}
+
+func TestMachineDeploymentUpgraded(t *testing.T) {
+	g := NewWithT(t)
+
+	md := getMachineDeploymentDocker(dockerSpec("v1.21.2"))
+	md.Generation = 1
+	md.Status = capiv1beta1.MachineDeploymentStatus{ObservedGeneration: 1, Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3}
+
+	// the workers are held back while the control plane upgrades
+	upgraded, message := machineDeploymentUpgraded(md, "v1.22.0")
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(message).To(Equal("waiting for the MachineDeployment to observe v1.22.0"))
+
+	md.Spec.Template.Spec.Version = pointer.StringPtr("v1.22.0")
+	md.Generation = 2
+	md.Status = capiv1beta1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 4, UpdatedReplicas: 2, ReadyReplicas: 4}
+	upgraded, message = machineDeploymentUpgraded(md, "v1.22.0")
+	g.Expect(upgraded).To(BeFalse())
+	g.Expect(message).To(Equal("2/3 workers upgraded to v1.22.0"))
+
+	md.Status = capiv1beta1.MachineDeploymentStatus{ObservedGeneration: 2, Replicas: 3, UpdatedReplicas: 3, ReadyReplicas: 3}
+	upgraded, _ = machineDeploymentUpgraded(md, "v1.22.0")
+	g.Expect(upgraded).To(BeTrue())
+}
+
+func dockerSpec(kubeVersion string) *v1.AeroClusterManagerSpec {
+	return &v1.AeroClusterManagerSpec{
+		ClusterOptions: v1.ClusterOptions{
+			Name:          "demo-cluster",
+			Provider:      "docker",
+			KubeVersion:   kubeVersion,
+			Replicas:      3,
+			DockerOptions: &v1.DockerOptions{},
+		},
+		ClusterID: v1.NamespacedName{Name: "demo-cluster", Namespace: "default"},
+	}
+}
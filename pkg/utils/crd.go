@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ErrIncompatibleCRD is returned by CheckCRDs for a CustomResourceDefinition that would stop serving the version of
+// existing custom resources, or a version aerostation writes
+var ErrIncompatibleCRD = errors.New("incompatible custom resource definition")
+
+// CheckCRDs checks that the CustomResourceDefinitions of a manifest keep serving the custom resources of the cluster
+// and the required kinds, the versions of the kinds aerostation writes.
+//
+// A version the existing custom resources may be stored in, one of the storedVersions of the live definition, has to
+// stay served while there are custom resources of the definition: they could neither be read nor migrated. Stored
+// versions without any custom resource left don't block, the definition may drop them. A required kind has to be
+// served in its version by the definition of the manifest that defines it, whether resources exist or not.
+func (a *Applier) CheckCRDs(ctx context.Context, data []byte, required ...schema.GroupVersionKind) error {
+	objects, err := decodeObjects(data, "")
+	if err != nil {
+		return err
+	}
+
+	var incompatible []string
+	for _, obj := range objects {
+		ref := refOf(obj)
+		if ref.Group != "apiextensions.k8s.io" || ref.Kind != "CustomResourceDefinition" {
+			continue
+		}
+
+		group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+		served := servedVersions(obj)
+		var missing []string
+		for _, gvk := range required {
+			if gvk.Group == group && gvk.Kind == kind && !served[gvk.Version] {
+				missing = append(missing, gvk.Version+" (required)")
+			}
+		}
+
+		live, err := a.Resources.Get(ctx, ref, obj.GroupVersionKind().Version)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return errors.Wrapf(err, "failed to get %s", ref)
+		}
+		if err == nil {
+			stored, _, _ := unstructured.NestedStringSlice(live.Object, "status", "storedVersions")
+			var dropped []string
+			for _, version := range stored {
+				if !served[version] {
+					dropped = append(dropped, version)
+				}
+			}
+			if len(dropped) > 0 {
+				exist, err := a.customResourcesExist(ctx, group, kind)
+				if err != nil {
+					return err
+				}
+				if exist {
+					missing = append(missing, dropped...)
+				}
+			}
+		}
+
+		if len(missing) > 0 {
+			incompatible = append(incompatible, fmt.Sprintf("%s no longer serves %s", ref.Name, strings.Join(missing, ", ")))
+		}
+	}
+
+	if len(incompatible) > 0 {
+		return fmt.Errorf("%w: %s", ErrIncompatibleCRD, strings.Join(incompatible, "; "))
+	}
+	return nil
+}
+
+// customResourcesExist tells if the cluster has any custom resource of a kind, in any namespace
+func (a *Applier) customResourcesExist(ctx context.Context, group, kind string) (bool, error) {
+	list, err := a.Resources.List(ctx, ObjectRef{Group: group, Kind: kind}, "", metav1.ListOptions{Limit: 1})
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to list %s.%s", kind, group)
+	}
+	return len(list.Items) > 0, nil
+}
+
+// servedVersions are the versions a CustomResourceDefinition serves
+func servedVersions(crd *unstructured.Unstructured) map[string]bool {
+	served := map[string]bool{}
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	for _, v := range versions {
+		version, _ := v.(map[string]interface{})
+		name, _ := version["name"].(string)
+		if isServed, _ := version["served"].(bool); isServed {
+			served[name] = true
+		}
+	}
+	return served
+}
@@ -0,0 +1,82 @@
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const aerospikeClustersCRD = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: aerospikeclusters.asdb.aerospike.com
+spec:
+  group: asdb.aerospike.com
+  names:
+    kind: AerospikeCluster
+  versions:
+    - name: v1alpha1
+      served: %s
+      storage: false
+    - name: v1beta1
+      served: %s
+      storage: true
+`
+
+const aerospikeCluster = `apiVersion: asdb.aerospike.com/v1beta1
+kind: AerospikeCluster
+metadata:
+  name: demo
+  namespace: aerospike
+`
+
+func TestCheckCRDs(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+	manifest := func(alpha string) []byte {
+		return []byte(configMapA + "---\n" + fmt.Sprintf(aerospikeClustersCRD, alpha, "true"))
+	}
+
+	// nothing is installed yet
+	g.Expect(a.CheckCRDs(ctx, manifest("false"))).To(Succeed())
+
+	live := object(t, fmt.Sprintf(aerospikeClustersCRD, "true", "true"))
+	live.Object["status"] = map[string]interface{}{"storedVersions": []interface{}{"v1alpha1", "v1beta1"}}
+	resources.objects[refOf(live)] = live
+
+	// a stored version without custom resources left can be dropped
+	g.Expect(a.CheckCRDs(ctx, manifest("false"))).To(Succeed())
+
+	cr := object(t, aerospikeCluster)
+	resources.objects[refOf(cr)] = cr
+	g.Expect(a.CheckCRDs(ctx, manifest("true"))).To(Succeed())
+	err := a.CheckCRDs(ctx, manifest("false"))
+	g.Expect(errors.Is(err, ErrIncompatibleCRD)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("aerospikeclusters.asdb.aerospike.com no longer serves v1alpha1"))
+
+	// once the custom resources are migrated and the stored versions are trimmed the version can be dropped
+	live.Object["status"] = map[string]interface{}{"storedVersions": []interface{}{"v1beta1"}}
+	g.Expect(a.CheckCRDs(ctx, manifest("false"))).To(Succeed())
+}
+
+func TestCheckCRDsRequired(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, _ := newApplier(t)
+	required := schema.GroupVersionKind{Group: "asdb.aerospike.com", Version: "v1beta1", Kind: "AerospikeCluster"}
+
+	g.Expect(a.CheckCRDs(ctx, []byte(fmt.Sprintf(aerospikeClustersCRD, "true", "true")), required)).To(Succeed())
+
+	// the version the controller writes has to be served, on a new cluster as well
+	err := a.CheckCRDs(ctx, []byte(fmt.Sprintf(aerospikeClustersCRD, "true", "false")), required)
+	g.Expect(errors.Is(err, ErrIncompatibleCRD)).To(BeTrue())
+	g.Expect(err.Error()).To(ContainSubstring("no longer serves v1beta1 (required)"))
+
+	// manifests without the definition are not concerned
+	g.Expect(a.CheckCRDs(ctx, []byte(configMapA), required)).To(Succeed())
+}
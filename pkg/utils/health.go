@@ -0,0 +1,90 @@
+package utils
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// Health checks the objects of an apply: the deployments, daemon sets and stateful sets have rolled out and their
+// replicas are available, the custom resource definitions are established. It returns why the first object that is
+// not ready is not.
+func (a *Applier) Health(ctx context.Context, results []ApplyResult) (bool, string, error) {
+	for _, result := range results {
+		if result.Code != common.ResultCodeSynced || !checked(result.Object) {
+			continue
+		}
+
+		obj, err := a.Resources.Get(ctx, result.Object, "")
+		if apierrors.IsNotFound(err) {
+			return false, result.Object.String() + " not found", nil
+		}
+		if err != nil {
+			return false, "", errors.Wrapf(err, "failed to get %s", result.Object)
+		}
+		if ready, message := healthy(obj); !ready {
+			return false, result.Object.String() + ": " + message, nil
+		}
+	}
+	return true, "", nil
+}
+
+func checked(ref ObjectRef) bool {
+	switch ref.Group + "/" + ref.Kind {
+	case "apps/Deployment", "apps/DaemonSet", "apps/StatefulSet", "apiextensions.k8s.io/CustomResourceDefinition":
+		return true
+	}
+	return false
+}
+
+// healthy tells if a workload rolled out its spec or a custom resource definition is established
+func healthy(obj *unstructured.Unstructured) (bool, string) {
+	status := func(field string) int64 {
+		value, _, _ := unstructured.NestedInt64(obj.Object, "status", field)
+		return value
+	}
+
+	if obj.GetKind() == "CustomResourceDefinition" {
+		conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+		for _, c := range conditions {
+			condition, _ := c.(map[string]interface{})
+			if condition["type"] == "Established" && condition["status"] == string(corev1.ConditionTrue) {
+				return true, ""
+			}
+		}
+		return false, "not established"
+	}
+
+	if status("observedGeneration") < obj.GetGeneration() {
+		return false, "the spec is not observed yet"
+	}
+
+	var desired, updated, available int64
+	switch obj.GetKind() {
+	case "Deployment":
+		desired, updated, available = 1, status("updatedReplicas"), status("availableReplicas")
+		if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+			desired = replicas
+		}
+	case "StatefulSet":
+		desired, updated, available = 1, status("updatedReplicas"), status("readyReplicas")
+		if replicas, found, _ := unstructured.NestedInt64(obj.Object, "spec", "replicas"); found {
+			desired = replicas
+		}
+	case "DaemonSet":
+		desired, updated, available = status("desiredNumberScheduled"), status("updatedNumberScheduled"), status("numberAvailable")
+	}
+
+	switch {
+	case updated < desired:
+		return false, fmt.Sprintf("%d of %d replicas updated", updated, desired)
+	case available < desired:
+		return false, fmt.Sprintf("%d of %d replicas available", available, desired)
+	}
+	return true, ""
+}
@@ -0,0 +1,63 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+func object(t *testing.T, data string) *unstructured.Unstructured {
+	g := NewWithT(t)
+	// decoded like the objects of the dynamic client, with int64 numbers
+	json, err := yaml.YAMLToJSON([]byte("apiVersion: v1\n" + data))
+	g.Expect(err).NotTo(HaveOccurred())
+	obj := &unstructured.Unstructured{}
+	g.Expect(obj.UnmarshalJSON(json)).To(Succeed())
+	return obj
+}
+
+func TestHealthy(t *testing.T) {
+	g := NewWithT(t)
+
+	for data, expected := range map[string]string{
+		"kind: Deployment\nmetadata: {generation: 2}\nspec: {replicas: 2}\nstatus: {observedGeneration: 2, updatedReplicas: 2, availableReplicas: 2}": "",
+		"kind: Deployment\nmetadata: {generation: 2}\nspec: {replicas: 2}\nstatus: {observedGeneration: 1, updatedReplicas: 2, availableReplicas: 2}": "the spec is not observed yet",
+		"kind: Deployment\nmetadata: {generation: 1}\nspec: {replicas: 2}\nstatus: {observedGeneration: 1, updatedReplicas: 2, availableReplicas: 1}": "1 of 2 replicas available",
+		"kind: Deployment\nmetadata: {generation: 1}\nspec: {}\nstatus: {observedGeneration: 1}":                                                      "0 of 1 replicas updated",
+		"kind: StatefulSet\nspec: {replicas: 3}\nstatus: {updatedReplicas: 3, readyReplicas: 3}":                                                      "",
+		"kind: DaemonSet\nstatus: {desiredNumberScheduled: 3, updatedNumberScheduled: 3, numberAvailable: 2}":                                         "2 of 3 replicas available",
+		"kind: CustomResourceDefinition\nstatus: {conditions: [{type: Established, status: \"True\"}]}":                                               "",
+		"kind: CustomResourceDefinition\nstatus: {conditions: [{type: Established, status: \"False\"}]}":                                              "not established",
+	} {
+		ready, message := healthy(object(t, data))
+		g.Expect(message).To(Equal(expected), data)
+		g.Expect(ready).To(Equal(expected == ""), data)
+	}
+}
+
+func TestHealth(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	a, resources := newApplier(t)
+
+	deployment := ObjectRef{Group: "apps", Kind: "Deployment", Namespace: "ns", Name: "operator"}
+	resources.objects[deployment] = object(t, "kind: Deployment\nspec: {replicas: 1}\nstatus: {updatedReplicas: 1}")
+	results := []ApplyResult{
+		{Object: refA, Code: common.ResultCodeSynced},
+		{Object: deployment, Code: common.ResultCodeSynced},
+	}
+
+	ready, message, err := a.Health(ctx, results)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ready).To(BeFalse())
+	g.Expect(message).To(Equal("Deployment.apps/ns/operator: 0 of 1 replicas available"))
+
+	resources.objects[deployment] = object(t, "kind: Deployment\nspec: {replicas: 1}\nstatus: {updatedReplicas: 1, availableReplicas: 1}")
+	ready, _, err = a.Health(ctx, results)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(ready).To(BeTrue())
+}
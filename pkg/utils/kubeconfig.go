@@ -0,0 +1,147 @@
+package utils
+
+import (
+	"context"
+	"time"
+
+	"github.com/aerospike/aerostation/pkg/remote"
+	"github.com/aerospike/aerostation/pkg/secrets"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/cluster-api/util/certs"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// UserKubeconfigGroup is the group of the Kubeconfigs issued by aerostation to the platform admins
+	UserKubeconfigGroup = "aerostation:users"
+
+	// UserKubeconfigClusterRole is the ClusterRole of the workload cluster bound to the groups of the Kubeconfigs
+	UserKubeconfigClusterRole = "view"
+
+	userKubeconfigBindingName = "aerostation-users-view"
+	userKubeconfigPrefix      = "aerostation:"
+)
+
+// KubeconfigScope is what the user of a Kubeconfig may view on the workload cluster
+type KubeconfigScope struct {
+	// Tenant is the tenant namespace of the user, empty for the platform admins who view the whole cluster
+	Tenant string
+	// Namespaced restricts the view of the tenant to its namespace, it is set on the clusters shared by the tenants
+	Namespaced bool
+}
+
+// group is the group of the Kubeconfigs issued in the scope
+func (s KubeconfigScope) group() string {
+	if s.Tenant == "" {
+		return UserKubeconfigGroup
+	}
+	return userKubeconfigPrefix + s.Tenant
+}
+
+// UserKubeconfigName is the common name of the client certificate of a user, it is prefixed with the tenant of the
+// user so that it never matches the users and groups of the workload cluster, system:masters among them
+func UserKubeconfigName(scope KubeconfigScope, user string) string {
+	tenant := scope.Tenant
+	if tenant == "" {
+		tenant = "admins"
+	}
+	return userKubeconfigPrefix + tenant + ":" + user
+}
+
+// IssueUserKubeconfig returns a Kubeconfig for user on the workload cluster and when it expires. The client
+// certificate is signed by the cluster CA and its group is bound to UserKubeconfigClusterRole, in the tenant
+// namespace only for a namespaced scope.
+func IssueUserKubeconfig(ctx context.Context, c client.Client, cluster client.ObjectKey, user string, scope KubeconfigScope, ttl time.Duration) ([]byte, time.Time, error) {
+	if ttl == 0 {
+		ttl = secrets.DefaultUserKubeconfigTTL
+	}
+	if scope.Namespaced && scope.Tenant == "" {
+		return nil, time.Time{}, errors.New("a namespaced kubeconfig requires a tenant")
+	}
+
+	cfg, err := secrets.GenerateUserKubeconfig(ctx, c, cluster, UserKubeconfigName(scope, user), []string{scope.group()}, ttl)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	if err := ensureUserBinding(ctx, c, cluster, scope); err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "failed to bind the user group on the workload cluster")
+	}
+
+	cert, err := certs.DecodeCertPEM(cfg.AuthInfos[cfg.Contexts[cfg.CurrentContext].AuthInfo].ClientCertificateData)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	out, err := clientcmd.Write(*cfg)
+	if err != nil {
+		return nil, time.Time{}, errors.Wrap(err, "failed to serialize config to yaml")
+	}
+
+	return out, cert.NotAfter, nil
+}
+
+// ensureUserBinding gives the group of the scope the UserKubeconfigClusterRole on the workload cluster, with a
+// RoleBinding in the tenant namespace for a namespaced scope
+func ensureUserBinding(ctx context.Context, c client.Client, cluster client.ObjectKey, scope KubeconfigScope) error {
+	restcfg, err := remote.RESTConfig(ctx, c, cluster)
+	if err != nil {
+		return err
+	}
+	remoteClient, err := client.New(restcfg, client.Options{})
+	if err != nil {
+		return err
+	}
+
+	return createUserBinding(ctx, remoteClient, scope)
+}
+
+func createUserBinding(ctx context.Context, remoteClient client.Client, scope KubeconfigScope) error {
+	roleRef := rbacv1.RoleRef{
+		APIGroup: rbacv1.GroupName,
+		Kind:     "ClusterRole",
+		Name:     UserKubeconfigClusterRole,
+	}
+	subjects := []rbacv1.Subject{{
+		APIGroup: rbacv1.GroupName,
+		Kind:     rbacv1.GroupKind,
+		Name:     scope.group(),
+	}}
+
+	var binding client.Object
+	switch {
+	case scope.Tenant == "":
+		binding = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: userKubeconfigBindingName},
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		}
+	case scope.Namespaced:
+		// the databases of the tenant land in its namespace, which may not exist yet
+		namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: scope.Tenant}}
+		if err := remoteClient.Create(ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+			return err
+		}
+		binding = &rbacv1.RoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: userKubeconfigBindingName, Namespace: scope.Tenant},
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		}
+	default:
+		binding = &rbacv1.ClusterRoleBinding{
+			ObjectMeta: metav1.ObjectMeta{Name: "aerostation-" + scope.Tenant + "-view"},
+			RoleRef:    roleRef,
+			Subjects:   subjects,
+		}
+	}
+
+	if err := remoteClient.Create(ctx, binding); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestUserKubeconfigName(t *testing.T) {
+	g := NewWithT(t)
+
+	g.Expect(UserKubeconfigName(KubeconfigScope{}, "admin")).To(Equal("aerostation:admins:admin"))
+	g.Expect(UserKubeconfigName(KubeconfigScope{Tenant: "tenant-dev-1234abcd"}, "system:masters")).
+		To(Equal("aerostation:tenant-dev-1234abcd:system:masters"))
+}
+
+func TestCreateUserBinding(t *testing.T) {
+	g := NewWithT(t)
+	ctx := context.Background()
+	scheme := runtime.NewScheme()
+	g.Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(rbacv1.AddToScheme(scheme)).To(Succeed())
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	// the platform admins view the whole cluster
+	g.Expect(createUserBinding(ctx, c, KubeconfigScope{})).To(Succeed())
+	crb := &rbacv1.ClusterRoleBinding{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: userKubeconfigBindingName}, crb)).To(Succeed())
+	g.Expect(crb.Subjects[0].Name).To(Equal(UserKubeconfigGroup))
+
+	// a tenant views its namespace on a shared cluster
+	shared := KubeconfigScope{Tenant: "tenant-dev-1234abcd", Namespaced: true}
+	g.Expect(createUserBinding(ctx, c, shared)).To(Succeed())
+	g.Expect(createUserBinding(ctx, c, shared)).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "tenant-dev-1234abcd"}, &corev1.Namespace{})).To(Succeed())
+	rb := &rbacv1.RoleBinding{}
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: userKubeconfigBindingName, Namespace: "tenant-dev-1234abcd"}, rb)).To(Succeed())
+	g.Expect(rb.RoleRef.Name).To(Equal(UserKubeconfigClusterRole))
+	g.Expect(rb.Subjects[0].Name).To(Equal("aerostation:tenant-dev-1234abcd"))
+	crbs := &rbacv1.ClusterRoleBindingList{}
+	g.Expect(c.List(ctx, crbs)).To(Succeed())
+	g.Expect(crbs.Items).To(HaveLen(1))
+
+	// and the whole cluster on its dedicated clusters
+	g.Expect(createUserBinding(ctx, c, KubeconfigScope{Tenant: "tenant-dev-1234abcd"})).To(Succeed())
+	g.Expect(c.Get(ctx, client.ObjectKey{Name: "aerostation-tenant-dev-1234abcd-view"}, crb)).To(Succeed())
+	g.Expect(crb.Subjects[0].Name).To(Equal("aerostation:tenant-dev-1234abcd"))
+}
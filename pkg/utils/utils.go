@@ -4,12 +4,9 @@ import (
 	"bufio"
 	"bytes"
 	"context"
-	"encoding/json"
+	"fmt"
 	"io"
-	"io/ioutil"
 	"log"
-	"os"
-	"path"
 
 	v1 "github.com/aerospike/aerostation/api/v1"
 
@@ -23,20 +20,16 @@ import (
 	"github.com/rancher/wrangler/pkg/kubeconfig"
 	"sigs.k8s.io/cluster-api/util/patch"
 
+	"github.com/aerospike/aerostation/pkg/aerostation/common"
+	"github.com/aerospike/aerostation/pkg/bundle"
 	"github.com/aerospike/aerostation/pkg/remote"
-	"github.com/aerospike/aerostation/pkg/utils/ako"
 	"gopkg.in/yaml.v2"
-	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
-	"k8s.io/client-go/discovery"
-	memory "k8s.io/client-go/discovery/cached"
-	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/rest"
-	"k8s.io/client-go/restmapper"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	rntyaml "k8s.io/apimachinery/pkg/runtime/serializer/yaml"
@@ -72,6 +65,15 @@ func GetKubeClient(kconfig string, scheme *runtime.Scheme) (client.Client, error
 	return client.New(cfg, client.Options{Scheme: scheme})
 }
 
+// GetKubeWatchClient returns a client that can also watch objects
+func GetKubeWatchClient(kconfig string, scheme *runtime.Scheme) (client.WithWatch, error) {
+	cfg, err := getRestClient(kconfig)
+	if err != nil {
+		return nil, err
+	}
+	return client.NewWithWatch(cfg, client.Options{Scheme: scheme})
+}
+
 func getRestClient(kconfig string) (*rest.Config, error) {
 	return kubeconfig.GetNonInteractiveClientConfig(kconfig).ClientConfig()
 }
@@ -97,8 +99,9 @@ func RemoveString(slice []string, s string) (result []string) {
 }
 
 // DecodeYAML unmarshals a YAML document or multidoc YAML as unstructured
-// objects, placing each decoded object into a channel.
-func DecodeYAML(data []byte) (<-chan *unstructured.Unstructured, <-chan error) {
+// objects, placing each decoded object into a channel. The decoding stops
+// once ctx is done, callers that return early have to cancel it.
+func DecodeYAML(ctx context.Context, data []byte) (<-chan *unstructured.Unstructured, <-chan error) {
 	var (
 		chanErr        = make(chan error)
 		chanObj        = make(chan *unstructured.Unstructured)
@@ -117,7 +120,10 @@ func DecodeYAML(data []byte) (<-chan *unstructured.Unstructured, <-chan error) {
 				if err == io.EOF {
 					return
 				}
-				chanErr <- errors.Wrap(err, "failed to read yaml data")
+				select {
+				case chanErr <- errors.Wrap(err, "failed to read yaml data"):
+				case <-ctx.Done():
+				}
 				return
 			}
 
@@ -140,7 +146,10 @@ func DecodeYAML(data []byte) (<-chan *unstructured.Unstructured, <-chan error) {
 			_, _, err = dec.Decode(buf, nil, obj)
 
 			if err != nil {
-				chanErr <- errors.Wrap(err, "failed to unmarshal yaml data")
+				select {
+				case chanErr <- errors.Wrap(err, "failed to unmarshal yaml data"):
+				case <-ctx.Done():
+				}
 				return
 			}
 			/*
@@ -151,7 +160,11 @@ func DecodeYAML(data []byte) (<-chan *unstructured.Unstructured, <-chan error) {
 				}
 			*/
 			// Place the unstructured object into the channel.
-			chanObj <- obj
+			select {
+			case chanObj <- obj:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}()
 
@@ -168,7 +181,10 @@ type ForEachObjectInYAMLActionFunc func(ctx context.Context, unstruct *unstructu
 // If an error is returned then no further objects are processed.
 // The data may be a single YAML document or multidoc YAML.
 func ForEachObjectInYAML(ctx context.Context, restConfig *rest.Config, data []byte, namespace string, actionFn ForEachObjectInYAMLActionFunc) error {
-	chanObj, chanErr := DecodeYAML(data)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	chanObj, chanErr := DecodeYAML(ctx, data)
 	for {
 		select {
 		case obj := <-chanObj:
@@ -201,69 +217,69 @@ func Apply(ctx context.Context, obj client.Object, c client.Client, cluster clie
 	return ApplyObject(ctx, obj, restcfg)
 }
 
+// ApplyObject applies an object generated whole from a spec, like the cluster api objects of the management cluster,
+// with force: aerostation owns every field of it.
 func ApplyObject(ctx context.Context, obj client.Object, restcfg *rest.Config) error {
 	unstructuredObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
 	if err != nil {
 		return err
 	}
 
-	return ApplyResource(ctx, &unstructured.Unstructured{Object: unstructuredObj}, restcfg)
+	return applyResource(ctx, &unstructured.Unstructured{Object: unstructuredObj}, restcfg, ApplyOptions{Force: true})
 }
 
-// ApplyResource should probably patch using a dynamic client.
+// ApplyResource applies an object with server side apply under FieldManager, without an inventory
 func ApplyResource(ctx context.Context, unstruct *unstructured.Unstructured, cfg *rest.Config) error {
-	log.Println("===Applying===")
+	return applyResource(ctx, unstruct, cfg, ApplyOptions{})
+}
 
-	// 1. Prepare a RESTMapper to find GVR
-	dc, err := discovery.NewDiscoveryClientForConfig(cfg)
+func applyResource(ctx context.Context, unstruct *unstructured.Unstructured, cfg *rest.Config, options ApplyOptions) error {
+	resources, err := NewDynamicResources(cfg)
 	if err != nil {
 		return err
 	}
-	mapper := restmapper.NewDeferredDiscoveryRESTMapper(memory.NewMemCacheClient(dc))
 
-	// 2. Prepare the dynamic client
-	dyn, err := dynamic.NewForConfig(cfg)
-	if err != nil {
-		return err
+	a := &Applier{Resources: resources}
+	if result := a.apply(ctx, unstruct, options); result.Code == common.ResultCodeSyncFailed {
+		return fmt.Errorf("apply %s: %s", result.Object, result.Message)
 	}
+	return nil
+}
 
-	mapping, err := mapper.RESTMapping(unstruct.GroupVersionKind().GroupKind(), unstruct.GroupVersionKind().Version)
-	if err != nil {
-		return err
-	}
+// ApplyAddon applies the default version of an add-on of the bundle to a workload cluster, rendered with data if it
+// is a template. It takes a client to the management cluster!
+func ApplyAddon(ctx context.Context, c client.Client, addons *bundle.Bundle, cluster client.ObjectKey, name, namespace string, data interface{}) error {
+	_, err := SyncAddon(ctx, c, addons, cluster, name, data, ApplyOptions{Namespace: namespace})
+	return err
+}
 
-	var dr dynamic.ResourceInterface
-	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
-		// namespaced resources should specify the namespace
-		dr = dyn.Resource(mapping.Resource).Namespace(unstruct.GetNamespace())
-	} else {
-		// for cluster-wide resources
-		dr = dyn.Resource(mapping.Resource)
+// SyncAddon applies the default version of an add-on like ApplyAddon and prunes the objects dropped from it since the
+// last sync. The inventory is AddonInventory unless options name one.
+func SyncAddon(ctx context.Context, c client.Client, addons *bundle.Bundle, cluster client.ObjectKey, name string, data interface{}, options ApplyOptions) ([]ApplyResult, error) {
+	manifest, err := addons.Get(ctx, name, "")
+	if err != nil {
+		return nil, err
 	}
-
-	data, err := json.Marshal(unstruct)
+	rendered, err := manifest.Render(data)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	_, err = dr.Patch(ctx, unstruct.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
-		Force:        func() *bool { b := true; return &b }(),
-		FieldManager: "aerostation-controller",
-	})
-
-	return err
-}
+	if options.Inventory == "" {
+		options.Inventory = AddonInventory(name, options.Namespace)
+	}
 
-// ApplyOperatorator takes a client to the management cluster!
-func ApplyOperatorator(ctx context.Context, c client.Client, cluster client.ObjectKey) error {
-	restcfg, err := remote.RESTConfig(ctx, c, cluster)
+	a, err := NewApplier(ctx, c, cluster)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return ForEachObjectInYAML(ctx, restcfg, []byte(ako.AkoDeployFile), "", ApplyResource)
+	log.Printf("applying %s %s (%s) to %s\n", manifest.Addon, manifest.Version, manifest.Source, cluster)
+	results, err := a.Apply(ctx, rendered, options)
+	logResults(cluster, results)
+	return results, err
 }
 
-func ApplyDatabase(ctx context.Context, c client.Client, db *v1.AeroDatabase, customResourceBytes []byte) error {
+func ApplyDatabase(ctx context.Context, c client.Client, addons *bundle.Bundle, db *v1.AeroDatabase, customResourceBytes []byte) error {
 
 	restcfg, err := remote.RESTConfig(ctx, c, db.Spec.Cluster.ToObjectKey())
 	if err != nil {
@@ -294,20 +310,7 @@ func ApplyDatabase(ctx context.Context, c client.Client, db *v1.AeroDatabase, cu
 	}
 
 	// create the aerospike feature secret, required for the database
-	wd, err := os.Getwd()
-	if err != nil {
-		log.Println(err)
-		return err
-	}
-
-	var secretBytes []byte
-	secretBytes, err = ioutil.ReadFile(path.Join(wd, "/manifests/feature-secret.yaml"))
-	if err != nil {
-		log.Println(err)
-		return err
-	}
-
-	err = ForEachObjectInYAML(ctx, restcfg, secretBytes, db.Spec.TargetNamespace, ApplyResource)
+	err = ApplyAddon(ctx, c, addons, db.Spec.Cluster.ToObjectKey(), bundle.AddonFeatures, db.Spec.TargetNamespace, nil)
 	if err != nil {
 		log.Println(err)
 		return err
@@ -322,8 +325,8 @@ func ApplyDatabase(ctx context.Context, c client.Client, db *v1.AeroDatabase, cu
 
 	// apply the aerospike database rest client in the targetNamespace
 	if db.Spec.DeployClient {
-		restClientBytes := ako.GetAKORESTClient(db.Spec.TargetNamespace, db.Spec.Name)
-		err = ForEachObjectInYAML(ctx, restcfg, restClientBytes, db.Spec.TargetNamespace, ApplyResource)
+		err = ApplyAddon(ctx, c, addons, db.Spec.Cluster.ToObjectKey(), bundle.AddonRESTClient, db.Spec.TargetNamespace,
+			bundle.RESTClient{Namespace: db.Spec.TargetNamespace, Hostname: db.Spec.Name})
 		if err != nil {
 			log.Println(err)
 			return err
@@ -333,26 +336,6 @@ func ApplyDatabase(ctx context.Context, c client.Client, db *v1.AeroDatabase, cu
 	return nil
 }
 
-// ApplyCertManager takes a client to the management cluster!
-func ApplyCertManager(ctx context.Context, c client.Client, cluster client.ObjectKey) error {
-	wd, err := os.Getwd()
-	if err != nil {
-		log.Println(err)
-	}
-	dat, err := ioutil.ReadFile(path.Join(wd, "/manifests/cert-manager.yaml"))
-	if err != nil {
-		log.Println("Failed to read cert-manager file!!!!")
-		return err
-	}
-
-	restcfg, err := remote.RESTConfig(ctx, c, cluster)
-	if err != nil {
-		return err
-	}
-
-	return ForEachObjectInYAML(ctx, restcfg, dat, "", ApplyResource)
-}
-
 func createNamespace(ctx context.Context, clientset *kubernetes.Clientset, targetNamespace string) error {
 	//check for target namespace, create if necessary
 	labels := map[string]string{
@@ -0,0 +1,24 @@
+package utils
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+)
+
+func TestDecodeYAMLCancel(t *testing.T) {
+	g := NewWithT(t)
+
+	data := []byte("kind: ConfigMap\nmetadata:\n  name: a\n---\nkind: ConfigMap\nmetadata:\n  name: b\n---\nkind: ConfigMap\nmetadata:\n  name: c\n")
+	ctx, cancel := context.WithCancel(context.Background())
+	chanObj, chanErr := DecodeYAML(ctx, data)
+
+	obj := <-chanObj
+	g.Expect(obj.GetName()).To(Equal("a"))
+
+	// the caller stops reading, the decoding ends instead of blocking on the next object
+	cancel()
+	g.Eventually(chanObj).Should(BeClosed())
+	g.Eventually(chanErr).Should(BeClosed())
+}
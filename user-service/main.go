@@ -7,6 +7,8 @@ import (
 
 	"github.com/aerospike/aerostation/pkg/auth"
 	"github.com/gorilla/mux"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 func main() {
@@ -15,17 +17,32 @@ func main() {
 	if err != nil {
 		panic(err.Error())
 	}
-	r := Router(cognitoClient)
+	// the tenant namespaces are created on the management cluster the service runs in
+	namespaces, err := inClusterClient()
+	if err != nil {
+		log.Println("[WARN] not creating tenant namespaces on sign up:", err)
+	}
+
+	r := Router(cognitoClient, namespaces)
 	fmt.Println("Starting user-service service server on the port 8081 ...")
 	log.Fatal(http.ListenAndServe(":8081", r))
 }
 
-func Router(client *auth.CognitoClient) *mux.Router {
+func Router(client *auth.CognitoClient, namespaces client.Client) *mux.Router {
 	router := mux.NewRouter()
 	cognitoRouter := auth.NewCognitoRouter(*client)
+	cognitoRouter.Namespaces = namespaces
 
 	router.HandleFunc("/api/v1/user/signup", cognitoRouter.SignUp).Methods("POST")
 	router.HandleFunc("/api/v1/user/signin", cognitoRouter.SignIn).Methods("POST")
 	router.Use(auth.LoggingMiddleware)
 	return router
 }
+
+func inClusterClient() (client.Client, error) {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{})
+}